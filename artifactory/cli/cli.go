@@ -4,6 +4,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	ioutils "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/buildinfo"
@@ -42,16 +43,38 @@ import (
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationcreate"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationdelete"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationtemplate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoarchivebrowsing"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoaudit"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoblackout"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repocheckpaths"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repochecksupport"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repocleanupreport"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repocreate"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repodelete"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repodescriptions"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoenvironment"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoexport"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoexportterraform"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repolayout"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repolayouts"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repopackagetypes"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repopriorityresolution"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/reporename"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repostorageusage"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repotemplate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repotemplategenerate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repotemplateschema"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repounblackout"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoupdate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repousedby"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoxrayindex"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/search"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/setprops"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/upload"
 	artifactoryUtils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/commandWrappers"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/flagkit"
+	"github.com/jfrog/jfrog-cli-artifactory/commonutils"
 	coregeneric "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/generic"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	containerutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/container"
@@ -349,22 +372,211 @@ func GetCommands() []components.Command {
 			Action:      repoTemplateCmd,
 			Category:    repoCategory,
 		},
+		{
+			Name:        "repo-template-schema",
+			Aliases:     []string{"rts"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoTemplateSchema),
+			Description: repotemplateschema.GetDescription(),
+			Arguments:   repotemplateschema.GetArguments(),
+			Action:      repoTemplateSchemaCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-template-generate",
+			Aliases:     []string{"rtg"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoTemplateGenerate),
+			Description: repotemplategenerate.GetDescription(),
+			Arguments:   repotemplategenerate.GetArguments(),
+			Action:      repoTemplateGenerateCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-package-types",
+			Aliases:     []string{"rpkg"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoPackageTypes),
+			Description: repopackagetypes.GetDescription(),
+			Arguments:   repopackagetypes.GetArguments(),
+			Action:      repoPackageTypesCmd,
+			Category:    repoCategory,
+		},
 		{
 			Name:        "repo-create",
 			Aliases:     []string{"rc"},
-			Flags:       flagkit.GetCommandFlags(flagkit.TemplateConsumer),
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoCreate),
 			Description: repocreate.GetDescription(),
 			Arguments:   repocreate.GetArguments(),
-			Action:      repoCreateCmd,
+			Action:      commonutils.WithErrorFormat(repoCreateCmd),
 			Category:    repoCategory,
 		},
 		{
 			Name:        "repo-update",
 			Aliases:     []string{"ru"},
-			Flags:       flagkit.GetCommandFlags(flagkit.TemplateConsumer),
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoUpdate),
 			Description: repoupdate.GetDescription(),
 			Arguments:   repoupdate.GetArguments(),
-			Action:      repoUpdateCmd,
+			Action:      commonutils.WithErrorFormat(repoUpdateCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-check-paths",
+			Aliases:     []string{"rcp"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoCheckPaths),
+			Description: repocheckpaths.GetDescription(),
+			Arguments:   repocheckpaths.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoCheckPathsCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-export-terraform",
+			Aliases:     []string{"rtf"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoExportTerraform),
+			Description: repoexportterraform.GetDescription(),
+			Arguments:   repoexportterraform.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoExportTerraformCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-export",
+			Aliases:     []string{"rexp"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoExport),
+			Description: repoexport.GetDescription(),
+			Arguments:   repoexport.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoExportCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-blackout",
+			Aliases:     []string{"rbo"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoBlackout),
+			Description: repoblackout.GetDescription(),
+			Arguments:   repoblackout.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoBlackoutCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-unblackout",
+			Aliases:     []string{"rubo"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoBlackout),
+			Description: repounblackout.GetDescription(),
+			Arguments:   repounblackout.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoUnblackoutCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-archive-browsing",
+			Aliases:     []string{"rab"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoArchiveBrowsing),
+			Description: repoarchivebrowsing.GetDescription(),
+			Arguments:   repoarchivebrowsing.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoArchiveBrowsingCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-xray-index",
+			Aliases:     []string{"rxi"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoXrayIndex),
+			Description: repoxrayindex.GetDescription(),
+			Arguments:   repoxrayindex.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoXrayIndexCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-audit",
+			Aliases:     []string{"raud"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoAudit),
+			Description: repoaudit.GetDescription(),
+			Arguments:   repoaudit.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoAuditCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-check-support",
+			Aliases:     []string{"rcsu"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoCheckSupport),
+			Description: repochecksupport.GetDescription(),
+			Arguments:   repochecksupport.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoCheckSupportCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-used-by",
+			Aliases:     []string{"rub"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoUsedBy),
+			Description: repousedby.GetDescription(),
+			Arguments:   repousedby.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoUsedByCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-storage-usage",
+			Aliases:     []string{"rsu"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoStorageUsage),
+			Description: repostorageusage.GetDescription(),
+			Arguments:   repostorageusage.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoStorageUsageCmd, flagkit.Project),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-cleanup-report",
+			Aliases:     []string{"rclr"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoCleanupReport),
+			Description: repocleanupreport.GetDescription(),
+			Arguments:   repocleanupreport.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoCleanupReportCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-layout",
+			Aliases:     []string{"rly"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoLayout),
+			Description: repolayout.GetDescription(),
+			Arguments:   repolayout.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoLayoutCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-layouts",
+			Aliases:     []string{"rlys"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoLayouts),
+			Description: repolayouts.GetDescription(),
+			Arguments:   repolayouts.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoLayoutsCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-rename",
+			Aliases:     []string{"rren"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoRename),
+			Description: reporename.GetDescription(),
+			Arguments:   reporename.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoRenameCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-priority-resolution",
+			Aliases:     []string{"rpr"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoPriorityResolution),
+			Description: repopriorityresolution.GetDescription(),
+			Arguments:   repopriorityresolution.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoPriorityResolutionCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-descriptions",
+			Aliases:     []string{"rdesc"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoDescriptions),
+			Description: repodescriptions.GetDescription(),
+			Arguments:   repodescriptions.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoDescriptionsCmd),
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-environment",
+			Aliases:     []string{"renv"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoEnvironment),
+			Description: repoenvironment.GetDescription(),
+			Arguments:   repoenvironment.GetArguments(),
+			Action:      commonutils.WithErrorFormat(repoEnvironmentCmd),
 			Category:    repoCategory,
 		},
 		{
@@ -373,7 +585,7 @@ func GetCommands() []components.Command {
 			Flags:       flagkit.GetCommandFlags(flagkit.RepoDelete),
 			Description: repodelete.GetDescription(),
 			Arguments:   repodelete.GetArguments(),
-			Action:      repoDeleteCmd,
+			Action:      commonutils.WithErrorFormat(repoDeleteCmd),
 			Category:    repoCategory,
 		},
 		{
@@ -1378,6 +1590,41 @@ func repoTemplateCmd(c *components.Context) error {
 	return commands.Exec(repoTemplateCmd)
 }
 
+func repoTemplateSchemaCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	// Run command.
+	repoTemplateSchemaCmd := repository.NewRepoTemplateSchemaCommand().SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(repoTemplateSchemaCmd)
+}
+
+func repoTemplateGenerateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	// Run command.
+	repoTemplateGenerateCmd := repository.NewRepoTemplateGenerateCommand().SetRclass(c.GetArgumentAt(0)).
+		SetPackageType(c.GetArgumentAt(1)).SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(repoTemplateGenerateCmd)
+}
+
+func repoPackageTypesCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	var rclass string
+	if c.GetNumberOfArgs() == 1 {
+		rclass = c.GetArgumentAt(0)
+	}
+
+	// Run command.
+	repoPackageTypesCmd := repository.NewRepoPackageTypesCommand().SetRclass(rclass).SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(repoPackageTypesCmd)
+}
+
 func repoCreateCmd(c *components.Context) error {
 	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
@@ -1390,7 +1637,16 @@ func repoCreateCmd(c *components.Context) error {
 
 	// Run command.
 	repoCreateCmd := repository.NewRepoCreateCommand()
-	repoCreateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
+	repoCreateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars")).
+		SetValidate(c.GetBoolFlagValue("validate")).SetValidateOnly(c.GetBoolFlagValue("validate-only")).
+		SetStrictPatterns(c.GetBoolFlagValue("strict-patterns")).
+		SetStrict(c.GetBoolFlagValue("strict")).
+		SetRollbackOnFailure(c.GetBoolFlagValue("rollback-on-failure")).SetAnnotate(c.GetBoolFlagValue("annotate")).
+		SetVerifyMembers(c.GetBoolFlagValue("verify-members")).SetIncludeKeys(c.GetStringFlagValue("include-keys")).
+		SetProgress(shouldReportRepoProgress(c)).SetCheckStorage(c.GetBoolFlagValue("check-storage")).
+		SetCheckLayouts(c.GetBoolFlagValue("check-layouts")).SetDockerHardening(c.GetBoolFlagValue("docker-hardening")).
+		SetQuiet(c.GetBoolFlagValue("quiet")).SetSummaryFormat(c.GetStringFlagValue("format")).
+		SetOnSuccess(c.GetStringFlagValue("on-success")).SetFailOnHookError(c.GetBoolFlagValue("fail-on-hook-error"))
 	return commands.Exec(repoCreateCmd)
 }
 
@@ -1406,10 +1662,346 @@ func repoUpdateCmd(c *components.Context) error {
 
 	// Run command.
 	repoUpdateCmd := repository.NewRepoUpdateCommand()
-	repoUpdateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
+	repoUpdateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars")).
+		SetValidate(c.GetBoolFlagValue("validate")).SetValidateOnly(c.GetBoolFlagValue("validate-only")).
+		SetStrictPatterns(c.GetBoolFlagValue("strict-patterns")).
+		SetStrict(c.GetBoolFlagValue("strict")).
+		SetAnnotate(c.GetBoolFlagValue("annotate")).SetVerifyMembers(c.GetBoolFlagValue("verify-members")).SetIncludeKeys(c.GetStringFlagValue("include-keys")).
+		SetProgress(shouldReportRepoProgress(c)).SetCheckStorage(c.GetBoolFlagValue("check-storage")).
+		SetCheckLayouts(c.GetBoolFlagValue("check-layouts")).SetDockerHardening(c.GetBoolFlagValue("docker-hardening")).
+		SetQuiet(c.GetBoolFlagValue("quiet")).SetSummaryFormat(c.GetStringFlagValue("format")).
+		SetOnSuccess(c.GetStringFlagValue("on-success")).SetFailOnHookError(c.GetBoolFlagValue("fail-on-hook-error"))
 	return commands.Exec(repoUpdateCmd)
 }
 
+// shouldReportRepoProgress returns whether the progress of creating or updating repositories one by one is reported.
+// It is reported when the standard error is a terminal, unless --quiet is set, or when it is forced with --progress.
+func shouldReportRepoProgress(c *components.Context) bool {
+	if c.GetBoolFlagValue("quiet") {
+		return false
+	}
+	return c.GetBoolFlagValue("progress") || log.IsStdErrTerminal()
+}
+
+func repoExportTerraformCmd(c *components.Context) error {
+	repoKeys := c.GetStringFlagValue("repo-keys")
+	if (repoKeys == "" && c.GetNumberOfArgs() != 1) || (repoKeys != "" && c.GetNumberOfArgs() != 0) {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	// Run command.
+	repoExportTerraformCmd := repository.NewRepoTerraformExportCommand().SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
+	if repoKeys != "" {
+		repoExportTerraformCmd.SetRepoKeys(strings.Split(repoKeys, ","))
+	} else {
+		repoExportTerraformCmd.SetTemplatePath(c.GetArgumentAt(0))
+	}
+	return commands.Exec(repoExportTerraformCmd)
+}
+
+func repoExportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() < 1 || c.GetNumberOfArgs() > 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	var repoPattern string
+	if c.GetNumberOfArgs() == 2 {
+		repoPattern = c.GetArgumentAt(1)
+	}
+	chunkSize := repository.DefaultRepoExportChunkSize
+	if c.GetStringFlagValue("chunk-size") != "" {
+		var err error
+		chunkSize, err = strconv.Atoi(c.GetStringFlagValue("chunk-size"))
+		if err != nil || chunkSize <= 0 {
+			return errorutils.CheckErrorf("the value of --chunk-size must be a positive number, got '%s'", c.GetStringFlagValue("chunk-size"))
+		}
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoExportCmd := repository.NewRepoExportCommand()
+	repoExportCmd.SetOutputDir(c.GetArgumentAt(0)).SetRepoPattern(repoPattern).SetChunkSize(chunkSize).SetServerDetails(rtDetails)
+	return commands.Exec(repoExportCmd)
+}
+
+func repoCheckPathsCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() < 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoCheckPathsCmd := repository.NewRepoCheckPathsCommand()
+	repoCheckPathsCmd.SetRepoKey(c.GetArgumentAt(0)).SetPaths(c.Arguments[1:]).SetServerDetails(rtDetails)
+	return commands.Exec(repoCheckPathsCmd)
+}
+
+func repoBlackoutCmd(c *components.Context) error {
+	return setReposBlackedOut(c, true)
+}
+
+func repoUnblackoutCmd(c *components.Context) error {
+	return setReposBlackedOut(c, false)
+}
+
+func setReposBlackedOut(c *components.Context, blackedOut bool) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoBlackoutCmd := repository.NewRepoBlackoutCommand()
+	repoBlackoutCmd.SetRepoPattern(c.GetArgumentAt(0)).SetBlackedOut(blackedOut).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails)
+	return commands.Exec(repoBlackoutCmd)
+}
+
+func repoArchiveBrowsingCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	enabled, err := strconv.ParseBool(c.GetArgumentAt(0))
+	if err != nil {
+		return errorutils.CheckErrorf("invalid value '%s' for archive browsing. Acceptable values are: true and false", c.GetArgumentAt(0))
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoArchiveBrowsingCmd := repository.NewRepoArchiveBrowsingCommand()
+	repoArchiveBrowsingCmd.SetRepoPatterns(strings.Split(c.GetArgumentAt(1), ",")).SetEnabled(enabled).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails)
+	return commands.Exec(repoArchiveBrowsingCmd)
+}
+
+func repoXrayIndexCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	enabled, err := strconv.ParseBool(c.GetArgumentAt(0))
+	if err != nil {
+		return errorutils.CheckErrorf("invalid value '%s' for Xray indexing. Acceptable values are: true and false", c.GetArgumentAt(0))
+	}
+	repoXrayIndexCmd := repository.NewRepoXrayIndexCommand()
+	if c.IsFlagSet("max-wait-minutes") {
+		maxWaitMinutes, err := strconv.Atoi(c.GetStringFlagValue("max-wait-minutes"))
+		if err != nil || maxWaitMinutes <= 0 {
+			return errorutils.CheckErrorf("invalid value '%s' for --max-wait-minutes. The value must be a positive number of minutes", c.GetStringFlagValue("max-wait-minutes"))
+		}
+		repoXrayIndexCmd.SetMaxWait(time.Duration(maxWaitMinutes) * time.Minute)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoXrayIndexCmd.SetRepoPatterns(strings.Split(c.GetArgumentAt(1), ",")).SetEnabled(enabled).SetDryRun(c.GetBoolFlagValue("dry-run")).
+		SetWait(c.GetBoolFlagValue("wait")).SetServerDetails(rtDetails)
+	return commands.Exec(repoXrayIndexCmd)
+}
+
+func repoAuditCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	var ignoreFields []string
+	if value := c.GetStringFlagValue("ignore-fields"); value != "" {
+		for _, field := range strings.Split(value, ",") {
+			ignoreFields = append(ignoreFields, strings.TrimSpace(field))
+		}
+	}
+	repoAuditCmd := repository.NewRepoAuditCommand()
+	repoAuditCmd.SetTemplatePath(c.GetArgumentAt(0)).SetVars(c.GetStringFlagValue("vars")).SetIgnoreFields(ignoreFields).SetServerDetails(rtDetails)
+	return commands.Exec(repoAuditCmd)
+}
+
+func repoCheckSupportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoCheckSupportCmd := repository.NewRepoCheckSupportCommand()
+	repoCheckSupportCmd.SetTemplatePath(c.GetArgumentAt(0)).SetVars(c.GetStringFlagValue("vars")).SetServerDetails(rtDetails)
+	return commands.Exec(repoCheckSupportCmd)
+}
+
+func repoUsedByCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoUsedByCmd := repository.NewRepoUsedByCommand()
+	repoUsedByCmd.SetRepoKey(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(repoUsedByCmd)
+}
+
+func repoStorageUsageCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	var repoPattern string
+	if c.GetNumberOfArgs() == 1 {
+		repoPattern = c.GetArgumentAt(0)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoStorageUsageCmd := repository.NewRepoStorageUsageCommand()
+	repoStorageUsageCmd.SetRepoPattern(repoPattern).SetProject(c.GetStringFlagValue("project")).SetFormat(c.GetStringFlagValue("format")).
+		SetRefresh(c.GetBoolFlagValue("refresh")).SetServerDetails(rtDetails)
+	return commands.Exec(repoStorageUsageCmd)
+}
+
+func repoCleanupReportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	var repoPattern string
+	if c.GetNumberOfArgs() == 1 {
+		repoPattern = c.GetArgumentAt(0)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoCleanupReportCmd := repository.NewRepoCleanupReportCommand()
+	repoCleanupReportCmd.SetRepoPattern(repoPattern).SetFormat(c.GetStringFlagValue("format")).
+		SetEstimate(c.GetBoolFlagValue("estimate")).SetServerDetails(rtDetails)
+	return commands.Exec(repoCleanupReportCmd)
+}
+
+func repoLayoutCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoLayoutCmd := repository.NewRepoLayoutCommand()
+	repoLayoutCmd.SetRepoKey(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(repoLayoutCmd)
+}
+
+func repoLayoutsCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoLayoutsCmd := repository.NewRepoLayoutsCommand()
+	repoLayoutsCmd.SetFormat(c.GetStringFlagValue("format")).SetServerDetails(rtDetails)
+	return commands.Exec(repoLayoutsCmd)
+}
+
+func repoPriorityResolutionCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoPriorityResolutionCmd := repository.NewRepoPriorityResolutionCommand()
+	repoPriorityResolutionCmd.SetRepoPattern(c.GetArgumentAt(0)).SetPriorityResolution(!c.GetBoolFlagValue("clear")).
+		SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails)
+	return commands.Exec(repoPriorityResolutionCmd)
+}
+
+func repoDescriptionsCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoDescriptionsCmd := repository.NewRepoDescriptionsCommand()
+	repoDescriptionsCmd.SetCsvPath(c.GetArgumentAt(0)).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails)
+	return commands.Exec(repoDescriptionsCmd)
+}
+
+func repoEnvironmentCmd(c *components.Context) error {
+	clearEnvironment := c.GetBoolFlagValue("clear")
+	if (clearEnvironment && c.GetNumberOfArgs() != 1) || (!clearEnvironment && c.GetNumberOfArgs() != 2) {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoEnvironmentCmd := repository.NewRepoEnvironmentCommand()
+	repoEnvironmentCmd.SetRepoPattern(c.GetArgumentAt(0)).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails)
+	if !clearEnvironment {
+		repoEnvironmentCmd.SetEnvironment(c.GetArgumentAt(1))
+	}
+	return commands.Exec(repoEnvironmentCmd)
+}
+
+func repoRenameCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoRenameCmd := repository.NewRepoRenameCommand()
+	repoRenameCmd.SetSourceRepoKey(c.GetArgumentAt(0)).SetTargetRepoKey(c.GetArgumentAt(1)).SetContent(c.GetStringFlagValue("content")).
+		SetRepoPassword(c.GetStringFlagValue("repo-password")).SetDryRun(c.GetBoolFlagValue("dry-run")).SetQuiet(common.GetQuietValue(c)).SetServerDetails(rtDetails)
+	return commands.Exec(repoRenameCmd)
+}
+
 func repoDeleteCmd(c *components.Context) error {
 	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
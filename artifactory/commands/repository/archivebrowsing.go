@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// RepoArchiveBrowsingCommand sets the archiveBrowsingEnabled field of the repositories matching a list of keys and
+// patterns, by fetching the configuration of each repository, modifying it and updating it back. Repositories which have
+// no archive browsing setting, such as virtual repositories, are skipped.
+type RepoArchiveBrowsingCommand struct {
+	serverDetails *config.ServerDetails
+	repoPatterns  []string
+	enabled       bool
+	dryRun        bool
+}
+
+func NewRepoArchiveBrowsingCommand() *RepoArchiveBrowsingCommand {
+	return &RepoArchiveBrowsingCommand{}
+}
+
+// SetRepoPatterns sets the keys of the repositories to update. Keys containing the * wildcard are patterns.
+func (rabc *RepoArchiveBrowsingCommand) SetRepoPatterns(repoPatterns []string) *RepoArchiveBrowsingCommand {
+	rabc.repoPatterns = repoPatterns
+	return rabc
+}
+
+func (rabc *RepoArchiveBrowsingCommand) SetEnabled(enabled bool) *RepoArchiveBrowsingCommand {
+	rabc.enabled = enabled
+	return rabc
+}
+
+func (rabc *RepoArchiveBrowsingCommand) SetDryRun(dryRun bool) *RepoArchiveBrowsingCommand {
+	rabc.dryRun = dryRun
+	return rabc
+}
+
+func (rabc *RepoArchiveBrowsingCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoArchiveBrowsingCommand {
+	rabc.serverDetails = serverDetails
+	return rabc
+}
+
+func (rabc *RepoArchiveBrowsingCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rabc.serverDetails, nil
+}
+
+func (rabc *RepoArchiveBrowsingCommand) CommandName() string {
+	return "rt_repo_archive_browsing"
+}
+
+func (rabc *RepoArchiveBrowsingCommand) Run() error {
+	servicesManager, err := rtUtils.CreateServiceManager(rabc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repoKeys, err := getMatchingRepoKeys(servicesManager, rabc.repoPatterns)
+	if err != nil {
+		return err
+	}
+	if len(repoKeys) == 0 {
+		log.Info("No repositories match the patterns:", strings.Join(rabc.repoPatterns, ", "))
+		return nil
+	}
+	changed, unchanged, skipped := 0, 0, 0
+	for _, repoKey := range repoKeys {
+		isChanged, isApplicable, err := rabc.setArchiveBrowsing(servicesManager, repoKey)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !isApplicable:
+			skipped++
+		case isChanged:
+			changed++
+		default:
+			unchanged++
+		}
+	}
+	changedVerb := "changed"
+	if rabc.dryRun {
+		changedVerb = "would change"
+	}
+	log.Info(fmt.Sprintf("%d repositories %s, %d unchanged, %d skipped.", changed, changedVerb, unchanged, skipped))
+	return nil
+}
+
+// setArchiveBrowsing returns whether the setting of the repository was changed, or would be changed on dry run, and whether
+// the setting is applicable to the repository at all.
+func (rabc *RepoArchiveBrowsingCommand) setArchiveBrowsing(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (changed, applicable bool, err error) {
+	repoConfigMap := make(map[string]interface{})
+	if err = servicesManager.GetRepository(repoKey, &repoConfigMap); err != nil {
+		return
+	}
+	rclass, pkgType := fmt.Sprint(repoConfigMap[Rclass]), fmt.Sprint(repoConfigMap[PackageType])
+	if !slices.Contains(getRclassTemplateKeys(rclass, pkgType, Update), ArchiveBrowsingEnabled) {
+		log.Info("Repository", repoKey, "- skipped, as archive browsing isn't applicable to", rclass, "repositories")
+		return false, false, nil
+	}
+	if current, _ := repoConfigMap[ArchiveBrowsingEnabled].(bool); current == rabc.enabled {
+		log.Info("Repository", repoKey, "-", ArchiveBrowsingEnabled, "is already", rabc.enabled, "(no change)")
+		return false, true, nil
+	}
+	if rabc.dryRun {
+		log.Info("[Dry run] Repository", repoKey, "-", ArchiveBrowsingEnabled, "would change from", !rabc.enabled, "to", rabc.enabled)
+		return true, true, nil
+	}
+	if err = updateRepoConfig(servicesManager, repoKey, rclass, map[string]interface{}{ArchiveBrowsingEnabled: rabc.enabled}); err != nil {
+		return
+	}
+	log.Info("Repository", repoKey, "-", ArchiveBrowsingEnabled, "changed from", !rabc.enabled, "to", rabc.enabled)
+	return true, true, nil
+}
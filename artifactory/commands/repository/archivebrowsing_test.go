@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoArchiveBrowsingCommand(t *testing.T) {
+	tests := []struct {
+		name            string
+		repoPatterns    []string
+		enabled         bool
+		dryRun          bool
+		expectedUpdated map[string]bool
+	}{
+		{
+			name:            "Enable on repositories matching a pattern",
+			repoPatterns:    []string{"team-*"},
+			enabled:         true,
+			expectedUpdated: map[string]bool{"team-maven": true},
+		},
+		{
+			name:            "Disable on a list of keys and patterns",
+			repoPatterns:    []string{"team-npm", " other-*", "team-npm"},
+			enabled:         false,
+			expectedUpdated: map[string]bool{"team-npm": false},
+		},
+		{
+			name:            "Dry run doesn't update repositories",
+			repoPatterns:    []string{"team-*"},
+			enabled:         true,
+			dryRun:          true,
+			expectedUpdated: map[string]bool{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Archive browsing is already enabled on team-npm, and isn't applicable to the virtual repositories.
+			repos := map[string]map[string]interface{}{
+				"team-maven":    {"rclass": "local", "packageType": "maven", "archiveBrowsingEnabled": false},
+				"team-npm":      {"rclass": "federated", "packageType": "npm", "archiveBrowsingEnabled": true},
+				"team-virtual":  {"rclass": "virtual", "packageType": "maven"},
+				"other-virtual": {"rclass": "virtual", "packageType": "npm"},
+			}
+			updated := map[string]bool{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/repositories" && r.Method == http.MethodGet:
+					_, err := w.Write([]byte(`[{"key":"team-maven"},{"key":"team-npm"},{"key":"team-virtual"},{"key":"other-virtual"}]`))
+					require.NoError(t, err)
+				case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+					repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+					if r.Method == http.MethodPost {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						var repoConfigMap map[string]interface{}
+						require.NoError(t, json.Unmarshal(body, &repoConfigMap))
+						assert.Equal(t, map[string]interface{}{"key": repoKey, "rclass": repos[repoKey]["rclass"], "archiveBrowsingEnabled": tt.enabled}, repoConfigMap)
+						updated[repoKey] = repoConfigMap["archiveBrowsingEnabled"].(bool)
+						return
+					}
+					repoConfigMap := map[string]interface{}{"key": repoKey}
+					for key, value := range repos[repoKey] {
+						repoConfigMap[key] = value
+					}
+					content, err := json.Marshal(repoConfigMap)
+					require.NoError(t, err)
+					_, err = w.Write(content)
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			archiveBrowsingCmd := NewRepoArchiveBrowsingCommand().SetRepoPatterns(tt.repoPatterns).SetEnabled(tt.enabled).SetDryRun(tt.dryRun).
+				SetServerDetails(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"})
+			assert.NoError(t, archiveBrowsingCmd.Run())
+			assert.Equal(t, tt.expectedUpdated, updated)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The exit code of repo-audit when the configuration of any repository drifted from the template. Other failures exit
+// with the general error exit code, so compliance jobs can tell drift apart from errors.
+var ExitCodeDrift = coreutils.ExitCode{Code: 4}
+
+// Fields which are never compared, as Artifactory doesn't return their values.
+var defaultAuditIgnoredFields = []string{Key, Password}
+
+// repoDrift is a difference between the template and the live configuration of a repository.
+type repoDrift struct {
+	repoKey string
+	// Empty when the repository doesn't exist.
+	field            string
+	expected, actual string
+}
+
+func (drift repoDrift) String() string {
+	if drift.field == "" {
+		return fmt.Sprintf("%s: repository doesn't exist", drift.repoKey)
+	}
+	return fmt.Sprintf("%s: %s is %s, expected %s", drift.repoKey, drift.field, drift.actual, drift.expected)
+}
+
+// RepoAuditCommand checks that the live configuration of each repository in a golden template matches the template.
+// Only the fields set by the template are compared, so server-managed defaults the template omits never drift.
+type RepoAuditCommand struct {
+	RepoCommand
+	ignoreFields []string
+}
+
+func NewRepoAuditCommand() *RepoAuditCommand {
+	return &RepoAuditCommand{}
+}
+
+func (rac *RepoAuditCommand) SetTemplatePath(path string) *RepoAuditCommand {
+	rac.templatePath = path
+	return rac
+}
+
+func (rac *RepoAuditCommand) SetVars(vars string) *RepoAuditCommand {
+	rac.vars = vars
+	return rac
+}
+
+// SetIgnoreFields sets template fields which are not compared, in addition to the fields Artifactory never returns.
+func (rac *RepoAuditCommand) SetIgnoreFields(ignoreFields []string) *RepoAuditCommand {
+	rac.ignoreFields = ignoreFields
+	return rac
+}
+
+func (rac *RepoAuditCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoAuditCommand {
+	rac.serverDetails = serverDetails
+	return rac
+}
+
+func (rac *RepoAuditCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rac.serverDetails, nil
+}
+
+func (rac *RepoAuditCommand) CommandName() string {
+	return "rt_repo_audit"
+}
+
+func (rac *RepoAuditCommand) Run() error {
+	batches, err := rac.getRepoConfigsBatches()
+	if err != nil {
+		return err
+	}
+	repoConfigMaps := flattenRepoConfigsBatches(batches)
+	if err = normalizeRepoConfigsClasses(repoConfigMaps); err != nil {
+		return err
+	}
+	if err = expandExternalDependencies(repoConfigMaps); err != nil {
+		return err
+	}
+	if err = expandPatternFiles(repoConfigMaps); err != nil {
+		return err
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rac.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	var drifts []repoDrift
+	for _, repoConfigMap := range repoConfigMaps {
+		repoDrifts, err := rac.auditRepo(servicesManager, repoConfigMap)
+		if err != nil {
+			return err
+		}
+		drifts = append(drifts, repoDrifts...)
+	}
+	if len(drifts) == 0 {
+		log.Info(fmt.Sprintf("No drift detected in %d repositories.", len(repoConfigMaps)))
+		return nil
+	}
+	report := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		report = append(report, drift.String())
+	}
+	return coreutils.CliError{
+		ExitCode: ExitCodeDrift,
+		ErrorMsg: "configuration drift detected:\n" + strings.Join(report, "\n"),
+	}
+}
+
+func (rac *RepoAuditCommand) auditRepo(servicesManager artifactory.ArtifactoryServicesManager, repoConfigMap map[string]interface{}) ([]repoDrift, error) {
+	repoKey := fmt.Sprint(repoConfigMap[Key])
+	exists, err := isRepoExistsWithRetries(servicesManager, repoKey)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []repoDrift{{repoKey: repoKey}}, nil
+	}
+	liveConfigMap := make(map[string]interface{})
+	if err = servicesManager.GetRepository(repoKey, &liveConfigMap); err != nil {
+		return nil, err
+	}
+	return getRepoDrifts(repoKey, repoConfigMap, liveConfigMap, append(slices.Clone(defaultAuditIgnoredFields), rac.ignoreFields...)), nil
+}
+
+// getRepoDrifts compares the fields set by the template to the live configuration, sorted by field name.
+func getRepoDrifts(repoKey string, repoConfigMap, liveConfigMap map[string]interface{}, ignoreFields []string) (drifts []repoDrift) {
+	fields := make([]string, 0, len(repoConfigMap))
+	for field := range repoConfigMap {
+		if !slices.Contains(ignoreFields, field) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		liveValue := liveConfigMap[field]
+		expected := normalizeAuditValue(repoConfigMap[field], liveValue)
+		actual := normalizeAuditValue(liveValue, liveValue)
+		if expected != actual {
+			if isSensitiveField(field) {
+				expected, actual = redactedValue, redactedValue
+			}
+			drifts = append(drifts, repoDrift{repoKey: repoKey, field: field, expected: expected, actual: actual})
+		}
+	}
+	return
+}
+
+// normalizeAuditValue formats a template or live value so that equal values are formatted the same. Template values
+// are usually strings, such as "true" or "a,b", so they are formatted by the type of the live value.
+func normalizeAuditValue(value, liveValue interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	if _, isList := liveValue.([]interface{}); isList {
+		var items []string
+		switch typedValue := value.(type) {
+		case []interface{}:
+			for _, item := range typedValue {
+				items = append(items, normalizeAuditValue(item, nil))
+			}
+		case string:
+			for _, item := range strings.Split(typedValue, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+		default:
+			items = append(items, fmt.Sprint(value))
+		}
+		return "[" + strings.Join(items, ",") + "]"
+	}
+	switch typedValue := value.(type) {
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	case map[string]interface{}:
+		content, err := marshalCanonicalJson(typedValue, "")
+		if err != nil {
+			return fmt.Sprint(value)
+		}
+		return string(content)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRepoDrifts(t *testing.T) {
+	repoConfigMap := map[string]interface{}{
+		Key: "npm-virtual", Rclass: Virtual, PackageType: Npm, Description: "golden", Repositories: "npm-local, npm-remote",
+		ExternalDependenciesEnabled: "true", MaxUniqueSnapshots: "5", Password: "secret",
+	}
+	liveConfigMap := map[string]interface{}{
+		Key: "npm-virtual", Rclass: Virtual, PackageType: Npm, Description: "changed", Repositories: []interface{}{"npm-local", "npm-remote"},
+		ExternalDependenciesEnabled: true, MaxUniqueSnapshots: float64(5), Password: "",
+	}
+	assert.Equal(t, []repoDrift{{repoKey: "npm-virtual", field: Description, expected: "golden", actual: "changed"}},
+		getRepoDrifts("npm-virtual", repoConfigMap, liveConfigMap, defaultAuditIgnoredFields))
+	assert.Empty(t, getRepoDrifts("npm-virtual", repoConfigMap, liveConfigMap, append(defaultAuditIgnoredFields, Description)))
+
+	liveConfigMap[Repositories] = []interface{}{"npm-local"}
+	assert.Equal(t, []repoDrift{
+		{repoKey: "npm-virtual", field: Description, expected: "golden", actual: "changed"},
+		{repoKey: "npm-virtual", field: Repositories, expected: "[npm-local,npm-remote]", actual: "[npm-local]"},
+	}, getRepoDrifts("npm-virtual", repoConfigMap, liveConfigMap, defaultAuditIgnoredFields))
+}
+
+func Test_RepoAuditCommand(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, "/api/repositories/") {
+		case "generic-local":
+			_, err := w.Write([]byte(`{"key":"generic-local","rclass":"local","packageType":"generic","description":"live","xrayIndex":true}`))
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
+
+	writeTemplate := func(content string) string {
+		templatePath := filepath.Join(t.TempDir(), "template.json")
+		require.NoError(t, os.WriteFile(templatePath, []byte(content), 0600))
+		return templatePath
+	}
+
+	t.Run("No drift", func(t *testing.T) {
+		templatePath := writeTemplate(`{"key":"generic-local","rclass":"local","packageType":"generic","xrayIndex":"true"}`)
+		assert.NoError(t, NewRepoAuditCommand().SetTemplatePath(templatePath).SetServerDetails(serverDetails).Run())
+	})
+
+	t.Run("Drift", func(t *testing.T) {
+		templatePath := writeTemplate(`[{"key":"generic-local","rclass":"local","packageType":"generic","description":"golden"},{"key":"missing-local","rclass":"local","packageType":"generic"}]`)
+		err := NewRepoAuditCommand().SetTemplatePath(templatePath).SetServerDetails(serverDetails).Run()
+		var cliError coreutils.CliError
+		require.ErrorAs(t, err, &cliError)
+		assert.Equal(t, ExitCodeDrift, cliError.ExitCode)
+		assert.Equal(t, "configuration drift detected:\ngeneric-local: description is live, expected golden\nmissing-local: repository doesn't exist", cliError.Error())
+	})
+
+	t.Run("Ignored fields", func(t *testing.T) {
+		templatePath := writeTemplate(`{"key":"generic-local","rclass":"local","packageType":"generic","description":"golden"}`)
+		assert.NoError(t, NewRepoAuditCommand().SetTemplatePath(templatePath).SetIgnoreFields([]string{Description}).SetServerDetails(serverDetails).Run())
+	})
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const repositoriesApi = "api/repositories/"
+
+// RepoBlackoutCommand sets the blackedOut field of the repositories matching a pattern, by fetching the configuration
+// of each repository, modifying it and updating it back. It's used both to black out and to restore repositories.
+type RepoBlackoutCommand struct {
+	serverDetails *config.ServerDetails
+	repoPattern   string
+	blackedOut    bool
+	dryRun        bool
+}
+
+func NewRepoBlackoutCommand() *RepoBlackoutCommand {
+	return &RepoBlackoutCommand{}
+}
+
+func (rbc *RepoBlackoutCommand) SetRepoPattern(repoPattern string) *RepoBlackoutCommand {
+	rbc.repoPattern = repoPattern
+	return rbc
+}
+
+func (rbc *RepoBlackoutCommand) SetBlackedOut(blackedOut bool) *RepoBlackoutCommand {
+	rbc.blackedOut = blackedOut
+	return rbc
+}
+
+func (rbc *RepoBlackoutCommand) SetDryRun(dryRun bool) *RepoBlackoutCommand {
+	rbc.dryRun = dryRun
+	return rbc
+}
+
+func (rbc *RepoBlackoutCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoBlackoutCommand {
+	rbc.serverDetails = serverDetails
+	return rbc
+}
+
+func (rbc *RepoBlackoutCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rbc.serverDetails, nil
+}
+
+func (rbc *RepoBlackoutCommand) CommandName() string {
+	if rbc.blackedOut {
+		return "rt_repo_blackout"
+	}
+	return "rt_repo_unblackout"
+}
+
+func (rbc *RepoBlackoutCommand) Run() error {
+	servicesManager, err := rtUtils.CreateServiceManager(rbc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repoKeys, err := getMatchingRepoKeys(servicesManager, []string{rbc.repoPattern})
+	if err != nil {
+		return err
+	}
+	if len(repoKeys) == 0 {
+		log.Info("No repositories match the pattern:", rbc.repoPattern)
+		return nil
+	}
+	for _, repoKey := range repoKeys {
+		if err = rbc.setBlackedOut(servicesManager, repoKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rbc *RepoBlackoutCommand) setBlackedOut(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) error {
+	repoConfigMap := make(map[string]interface{})
+	if err := servicesManager.GetRepository(repoKey, &repoConfigMap); err != nil {
+		return err
+	}
+	if current, _ := repoConfigMap[BlackedOut].(bool); current == rbc.blackedOut {
+		log.Info("Repository", repoKey, "- blackedOut is already", rbc.blackedOut, "(no change)")
+		return nil
+	}
+	if rbc.dryRun {
+		log.Info("[Dry run] Repository", repoKey, "- blackedOut would change from", !rbc.blackedOut, "to", rbc.blackedOut)
+		return nil
+	}
+	if err := updateRepoConfig(servicesManager, repoKey, repoConfigMap[Rclass], map[string]interface{}{BlackedOut: rbc.blackedOut}); err != nil {
+		return err
+	}
+	log.Info("Repository", repoKey, "- blackedOut changed from", !rbc.blackedOut, "to", rbc.blackedOut)
+	return nil
+}
+
+// updateRepoConfig updates the given fields of a repository of the given class, leaving the rest of its configuration as
+// is. Only the changed fields are sent, as the fetched configuration masks the secrets, such as the password of a remote
+// repository, which sending it back would overwrite.
+func updateRepoConfig(servicesManager artifactory.ArtifactoryServicesManager, repoKey string, rclass interface{}, changes map[string]interface{}) error {
+	repoConfigMap := map[string]interface{}{Key: repoKey, Rclass: rclass}
+	for field, value := range changes {
+		repoConfigMap[field] = value
+	}
+	content, err := json.Marshal(repoConfigMap)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	rtDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	resp, body, err := servicesManager.Client().SendPost(rtDetails.GetUrl()+repositoriesApi+url.PathEscape(repoKey), content, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
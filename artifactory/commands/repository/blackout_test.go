@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RepoBlackoutCommand(t *testing.T) {
+	tests := []struct {
+		name            string
+		repoPattern     string
+		blackedOut      bool
+		dryRun          bool
+		expectedUpdated map[string]bool
+	}{
+		{
+			name:            "Black out repositories matching a pattern",
+			repoPattern:     "team-*",
+			blackedOut:      true,
+			expectedUpdated: map[string]bool{"team-maven": true},
+		},
+		{
+			name:            "Restore a single repository",
+			repoPattern:     "team-npm",
+			blackedOut:      false,
+			expectedUpdated: map[string]bool{"team-npm": false},
+		},
+		{
+			name:            "Dry run doesn't update repositories",
+			repoPattern:     "team-*",
+			blackedOut:      true,
+			dryRun:          true,
+			expectedUpdated: map[string]bool{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// team-npm is already blacked out.
+			repos := map[string]bool{"team-maven": false, "team-npm": true, "other-maven": false}
+			updated := map[string]bool{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/repositories" && r.Method == http.MethodGet:
+					_, err := w.Write([]byte(`[{"key":"team-maven"},{"key":"team-npm"},{"key":"other-maven"}]`))
+					require.NoError(t, err)
+				case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+					repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+					if r.Method == http.MethodPost {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						var repoConfigMap map[string]interface{}
+						require.NoError(t, json.Unmarshal(body, &repoConfigMap))
+						// Only the changed field is sent, along with the key and class of the repository
+						assert.Equal(t, map[string]interface{}{"key": repoKey, "rclass": "local", "blackedOut": tt.blackedOut}, repoConfigMap)
+						updated[repoKey] = repoConfigMap["blackedOut"].(bool)
+						return
+					}
+					content, err := json.Marshal(map[string]interface{}{"key": repoKey, "rclass": "local", "description": "Team repository", "blackedOut": repos[repoKey]})
+					require.NoError(t, err)
+					_, err = w.Write(content)
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			blackoutCmd := NewRepoBlackoutCommand().SetRepoPattern(tt.repoPattern).SetBlackedOut(tt.blackedOut).SetDryRun(tt.dryRun).
+				SetServerDetails(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"})
+			assert.NoError(t, blackoutCmd.Run())
+			assert.Equal(t, tt.expectedUpdated, updated)
+		})
+	}
+}
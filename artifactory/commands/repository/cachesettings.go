@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Cache periods of remote repositories, which are expected to be at most as long as the retrieval cache period.
+var remoteCachePeriodKeys = []string{MissedRetrievalCachePeriodSecs, FailedRetrievalCachePeriodSecs}
+
+// validateRemoteCacheSettings detects timeout and cache period settings of remote repositories which are likely to cause
+// surprising caching behavior. Problems are reported as warnings, unless strict is set, in which case they are fatal.
+func validateRemoteCacheSettings(repoConfigMaps []map[string]interface{}, strict bool) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		if repoConfigMap[Rclass] == Remote {
+			problems = append(problems, getRemoteCacheSettingsProblems(repoConfigMap)...)
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if strict {
+		return errorutils.CheckErrorf("remote repository cache settings check failed:\n%s", strings.Join(problems, "\n"))
+	}
+	for _, problem := range problems {
+		log.Warn(problem)
+	}
+	return nil
+}
+
+func getRemoteCacheSettingsProblems(repoConfigMap map[string]interface{}) (problems []string) {
+	repoKey := repoConfigMap[Key]
+	for _, key := range []string{SocketTimeoutMillis, RetrievalCachePeriodSecs, MissedRetrievalCachePeriodSecs, FailedRetrievalCachePeriodSecs, AssumedOfflinePeriodSecs} {
+		if value, ok := getNumericSetting(repoConfigMap, key); ok && value < 0 {
+			problems = append(problems, fmt.Sprintf("repository '%v': '%s' is negative (%s)", repoKey, key, formatNumericSetting(value)))
+		}
+	}
+	if socketTimeout, ok := getNumericSetting(repoConfigMap, SocketTimeoutMillis); ok && socketTimeout == 0 {
+		problems = append(problems, fmt.Sprintf("repository '%v': '%s' is 0, so requests to the remote URL never time out", repoKey, SocketTimeoutMillis))
+	}
+	retrievalPeriod, ok := getNumericSetting(repoConfigMap, RetrievalCachePeriodSecs)
+	if !ok {
+		return
+	}
+	for _, key := range remoteCachePeriodKeys {
+		if period, ok := getNumericSetting(repoConfigMap, key); ok && period > retrievalPeriod {
+			problems = append(problems, fmt.Sprintf("repository '%v': '%s' (%s) is longer than '%s' (%s), so a missing or failed artifact would be cached longer than an existing one",
+				repoKey, key, formatNumericSetting(period), RetrievalCachePeriodSecs, formatNumericSetting(retrievalPeriod)))
+		}
+	}
+	return
+}
+
+// getNumericSetting returns the value of a numeric setting, which may be provided either as a number or as a string.
+func getNumericSetting(repoConfigMap map[string]interface{}, key string) (float64, bool) {
+	value, ok := repoConfigMap[key]
+	if !ok {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(strings.TrimSpace(fmt.Sprint(value)), 64)
+	return number, err == nil
+}
+
+func formatNumericSetting(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
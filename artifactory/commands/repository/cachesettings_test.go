@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidateRemoteCacheSettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		repoConfigMap  map[string]interface{}
+		expectedErrors []string
+	}{
+		{
+			name: "Consistent settings",
+			repoConfigMap: map[string]interface{}{Key: "npm-remote", Rclass: Remote, SocketTimeoutMillis: float64(15000), RetrievalCachePeriodSecs: "7200",
+				MissedRetrievalCachePeriodSecs: float64(1800), FailedRetrievalCachePeriodSecs: "7200", AssumedOfflinePeriodSecs: float64(300)},
+		},
+		{
+			name:          "Partial settings",
+			repoConfigMap: map[string]interface{}{Key: "npm-remote", Rclass: Remote, MissedRetrievalCachePeriodSecs: float64(86400)},
+		},
+		{
+			name:          "Local repositories are ignored",
+			repoConfigMap: map[string]interface{}{Key: "npm-local", Rclass: Local, SocketTimeoutMillis: float64(0)},
+		},
+		{
+			name: "Inconsistent settings",
+			repoConfigMap: map[string]interface{}{Key: "npm-remote", Rclass: Remote, SocketTimeoutMillis: "0", RetrievalCachePeriodSecs: float64(600),
+				MissedRetrievalCachePeriodSecs: "3600", FailedRetrievalCachePeriodSecs: float64(1e6), AssumedOfflinePeriodSecs: float64(-1)},
+			expectedErrors: []string{
+				"repository 'npm-remote': 'socketTimeoutMillis' is 0, so requests to the remote URL never time out",
+				"repository 'npm-remote': 'missedRetrievalCachePeriodSecs' (3600) is longer than 'retrievalCachePeriodSecs' (600)",
+				"repository 'npm-remote': 'failedRetrievalCachePeriodSecs' (1000000) is longer than 'retrievalCachePeriodSecs' (600)",
+				"repository 'npm-remote': 'assumedOfflinePeriodSecs' is negative (-1)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoConfigMaps := []map[string]interface{}{tt.repoConfigMap}
+			// Problems are only warnings, unless strict is set
+			assert.NoError(t, validateRemoteCacheSettings(repoConfigMaps, false))
+			err := validateRemoteCacheSettings(repoConfigMaps, true)
+			if len(tt.expectedErrors) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, expectedError := range tt.expectedErrors {
+				assert.ErrorContains(t, err, expectedError)
+			}
+		})
+	}
+}
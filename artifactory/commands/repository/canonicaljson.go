@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// marshalCanonicalJson marshals a repository configuration for humans to read, diff and audit, so that the same configuration
+// always produces the same bytes: object keys are sorted at every level, HTML characters such as '&' in URLs are not escaped,
+// and the output is indented by indent, or compact if indent is empty. Payloads sent to Artifactory keep using json.Marshal.
+func marshalCanonicalJson(value interface{}, indent string) ([]byte, error) {
+	// Round trip through generic values, so that struct fields are sorted as well as map keys
+	content, err := json.Marshal(value)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var generic interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	// Numbers are kept as written, rather than converted to float64 and reformatted
+	decoder.UseNumber()
+	if err = decoder.Decode(&generic); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indent)
+	if err = encoder.Encode(generic); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
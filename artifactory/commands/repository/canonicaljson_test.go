@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MarshalCanonicalJson(t *testing.T) {
+	repoConfigMap := map[string]interface{}{
+		Rclass:              Remote,
+		Key:                 "npm-remote",
+		Url:                 "https://registry.npmjs.org/?a=1&b=2",
+		SocketTimeoutMillis: float64(15000000),
+		ContentSynchronisation: map[string]interface{}{
+			"statistics": map[string]interface{}{"enabled": true},
+			"enabled":    false,
+		},
+		Environment: []interface{}{"PROD", "DEV"},
+	}
+	expected := `{
+  "contentSynchronisation": {
+    "enabled": false,
+    "statistics": {
+      "enabled": true
+    }
+  },
+  "environment": [
+    "PROD",
+    "DEV"
+  ],
+  "key": "npm-remote",
+  "rclass": "remote",
+  "socketTimeoutMillis": 15000000,
+  "url": "https://registry.npmjs.org/?a=1&b=2"
+}`
+	for i := 0; i < 10; i++ {
+		content, err := marshalCanonicalJson(repoConfigMap, "  ")
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+
+	content, err := marshalCanonicalJson(repoConfigMap, "")
+	require.NoError(t, err)
+	assert.Equal(t, `{"contentSynchronisation":{"enabled":false,"statistics":{"enabled":true}},"environment":["PROD","DEV"],"key":"npm-remote","rclass":"remote","socketTimeoutMillis":15000000,"url":"https://registry.npmjs.org/?a=1&b=2"}`, string(content))
+
+	// Struct fields are sorted like map keys
+	content, err = marshalCanonicalJson(struct {
+		B string          `json:"b"`
+		A json.RawMessage `json:"a"`
+	}{B: "b", A: json.RawMessage(`1.50`)}, "")
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1.50,"b":"b"}`, string(content))
+}
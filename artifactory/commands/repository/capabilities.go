@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// repoCapability describes the repositories on which a setting has an effect.
+type repoCapability struct {
+	rclasses []string
+	// The package types supporting the setting. An empty list means all the package types are supported.
+	packageTypes []string
+}
+
+func (capability repoCapability) isSupported(rclass, packageType string) bool {
+	if !slices.Contains(capability.rclasses, rclass) {
+		return false
+	}
+	return len(capability.packageTypes) == 0 || slices.Contains(capability.packageTypes, packageType)
+}
+
+func isSupportedByAny(capabilities []repoCapability, rclass, packageType string) bool {
+	for _, capability := range capabilities {
+		if capability.isSupported(rclass, packageType) {
+			return true
+		}
+	}
+	return false
+}
+
+// Settings which are accepted for every repository, but are ignored by Artifactory unless the repository supports them.
+// The authentication settings apply only to the package type whose clients they authenticate.
+// Both redirect settings are also effective only on instances whose filestore is a cloud storage provider.
+var repoCapabilities = map[string][]repoCapability{
+	DownloadRedirect: {{
+		rclasses: []string{Local, Remote, Federated},
+	}},
+	CdnRedirect: {{
+		rclasses:     []string{Local, Remote, Federated},
+		packageTypes: []string{Generic, Maven, Gradle, Ivy, Sbt, Npm, Docker, Pypi, Go},
+	}},
+	PrimaryKeyPairRef:   keyPairRefCapabilities,
+	SecondaryKeyPairRef: keyPairRefCapabilities,
+	ForceNugetAuthentication: {{
+		rclasses:     []string{Local, Remote, Virtual, Federated},
+		packageTypes: []string{Nuget},
+	}},
+	// Token authentication is negotiated with remote Docker registries only.
+	EnableTokenAuthentication: {{
+		rclasses:     []string{Remote},
+		packageTypes: []string{Docker},
+	}},
+}
+
+// Key pairs are used to sign the metadata of the package types that support signing. Virtual Docker repositories sign
+// on the fly, while their local repositories have nothing to sign.
+var keyPairRefCapabilities = []repoCapability{
+	{
+		rclasses:     []string{Local, Federated},
+		packageTypes: []string{Alpine, Debian, Rpm},
+	},
+	{
+		rclasses:     []string{Virtual},
+		packageTypes: []string{Alpine, Debian, Docker, Rpm},
+	},
+}
+
+// validateRepoCapabilities detects settings which are enabled on repositories that don't support them, and would be
+// silently ignored by Artifactory. Problems are reported as warnings, unless strict is set, in which case they are fatal.
+func validateRepoCapabilities(repoConfigMaps []map[string]interface{}, strict bool) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		problems = append(problems, getUnsupportedSettings(repoConfigMap)...)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if strict {
+		return errorutils.CheckErrorf("repository settings capability check failed:\n%s", strings.Join(problems, "\n"))
+	}
+	for _, problem := range problems {
+		log.Warn(problem)
+	}
+	return nil
+}
+
+func getUnsupportedSettings(repoConfigMap map[string]interface{}) (problems []string) {
+	rclass := fmt.Sprint(repoConfigMap[Rclass])
+	packageType := fmt.Sprint(repoConfigMap[PackageType])
+	for _, setting := range []string{DownloadRedirect, CdnRedirect, PrimaryKeyPairRef, SecondaryKeyPairRef, ForceNugetAuthentication, EnableTokenAuthentication} {
+		value, ok := repoConfigMap[setting]
+		if !ok || !isSettingEnabled(setting, value) {
+			continue
+		}
+		if !isSupportedByAny(repoCapabilities[setting], rclass, packageType) {
+			problems = append(problems, fmt.Sprintf("repository '%v': '%s' has no effect on %s %s repositories", repoConfigMap[Key], setting, rclass, packageType))
+		}
+	}
+	return
+}
+
+// isSettingEnabled returns whether a setting has a value which takes effect. Disabling a setting, or leaving a key pair
+// reference empty, is always harmless.
+func isSettingEnabled(setting string, value interface{}) bool {
+	if setting == PrimaryKeyPairRef || setting == SecondaryKeyPairRef {
+		return fmt.Sprint(value) != ""
+	}
+	enabled, err := strconv.ParseBool(fmt.Sprint(value))
+	return err == nil && enabled
+}
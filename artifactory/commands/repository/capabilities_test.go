@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRepoCapabilities(t *testing.T) {
+	tests := []struct {
+		name          string
+		repoConfigMap map[string]interface{}
+		expectProblem bool
+	}{
+		{
+			name:          "Download redirect on local repository",
+			repoConfigMap: map[string]interface{}{Key: "npm-local", Rclass: Local, PackageType: Npm, DownloadRedirect: "true"},
+		},
+		{
+			name:          "Download redirect on virtual repository",
+			repoConfigMap: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm, DownloadRedirect: true},
+			expectProblem: true,
+		},
+		{
+			name:          "Disabled download redirect on virtual repository",
+			repoConfigMap: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm, DownloadRedirect: "false"},
+		},
+		{
+			name:          "CDN redirect on supported package type",
+			repoConfigMap: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker, CdnRedirect: "true"},
+		},
+		{
+			name:          "CDN redirect on unsupported package type",
+			repoConfigMap: map[string]interface{}{Key: "rpm-local", Rclass: Local, PackageType: Rpm, CdnRedirect: "true"},
+			expectProblem: true,
+		},
+		{
+			name:          "Key pair reference on virtual Debian repository",
+			repoConfigMap: map[string]interface{}{Key: "debian-virtual", Rclass: Virtual, PackageType: Debian, PrimaryKeyPairRef: "primary-key"},
+		},
+		{
+			name:          "Key pair reference on virtual Docker repository",
+			repoConfigMap: map[string]interface{}{Key: "docker-virtual", Rclass: Virtual, PackageType: Docker, SecondaryKeyPairRef: "secondary-key"},
+		},
+		{
+			name:          "Key pair reference on local Docker repository",
+			repoConfigMap: map[string]interface{}{Key: "docker-local", Rclass: Local, PackageType: Docker, PrimaryKeyPairRef: "primary-key"},
+			expectProblem: true,
+		},
+		{
+			name:          "Key pair reference on virtual npm repository",
+			repoConfigMap: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm, PrimaryKeyPairRef: "primary-key"},
+			expectProblem: true,
+		},
+		{
+			name:          "Empty key pair reference on virtual npm repository",
+			repoConfigMap: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm, PrimaryKeyPairRef: ""},
+		},
+		{
+			name:          "Force NuGet authentication on NuGet repository",
+			repoConfigMap: map[string]interface{}{Key: "nuget-virtual", Rclass: Virtual, PackageType: Nuget, ForceNugetAuthentication: "true"},
+		},
+		{
+			name:          "Force NuGet authentication on Maven repository",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", Rclass: Local, PackageType: Maven, ForceNugetAuthentication: true},
+			expectProblem: true,
+		},
+		{
+			name:          "Disabled force NuGet authentication on Maven repository",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", Rclass: Local, PackageType: Maven, ForceNugetAuthentication: "false"},
+		},
+		{
+			name:          "Token authentication on remote Docker repository",
+			repoConfigMap: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker, EnableTokenAuthentication: "true"},
+		},
+		{
+			name:          "Token authentication on remote Maven repository",
+			repoConfigMap: map[string]interface{}{Key: "maven-remote", Rclass: Remote, PackageType: Maven, EnableTokenAuthentication: "true"},
+			expectProblem: true,
+		},
+		{
+			name:          "No redirect settings",
+			repoConfigMap: map[string]interface{}{Key: "rpm-virtual", Rclass: Virtual, PackageType: Rpm},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoConfigMaps := []map[string]interface{}{tt.repoConfigMap}
+			assert.NoError(t, validateRepoCapabilities(repoConfigMaps, false))
+			err := validateRepoCapabilities(repoConfigMaps, true)
+			if tt.expectProblem {
+				assert.ErrorContains(t, err, "has no effect on")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+type RepoCheckPathsCommand struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+	paths         []string
+}
+
+func NewRepoCheckPathsCommand() *RepoCheckPathsCommand {
+	return &RepoCheckPathsCommand{}
+}
+
+func (rcpc *RepoCheckPathsCommand) SetRepoKey(repoKey string) *RepoCheckPathsCommand {
+	rcpc.repoKey = repoKey
+	return rcpc
+}
+
+func (rcpc *RepoCheckPathsCommand) SetPaths(paths []string) *RepoCheckPathsCommand {
+	rcpc.paths = paths
+	return rcpc
+}
+
+func (rcpc *RepoCheckPathsCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoCheckPathsCommand {
+	rcpc.serverDetails = serverDetails
+	return rcpc
+}
+
+func (rcpc *RepoCheckPathsCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rcpc.serverDetails, nil
+}
+
+func (rcpc *RepoCheckPathsCommand) CommandName() string {
+	return "rt_repo_check_paths"
+}
+
+func (rcpc *RepoCheckPathsCommand) Run() error {
+	servicesManager, err := rtUtils.CreateServiceManager(rcpc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repoConfigMap := make(map[string]interface{})
+	if err = servicesManager.GetRepository(rcpc.repoKey, &repoConfigMap); err != nil {
+		return err
+	}
+	includePatterns := getRepoPatterns(repoConfigMap, IncludePatterns)
+	excludePatterns := getRepoPatterns(repoConfigMap, ExcludePatterns)
+	var results []PathPatternsResult
+	for _, repoPath := range rcpc.paths {
+		results = append(results, EvaluatePathPatterns(repoPath, includePatterns, excludePatterns))
+	}
+	return printPathPatternsResults(results)
+}
+
+func getRepoPatterns(repoConfigMap map[string]interface{}, patternsKey string) []string {
+	patterns, ok := repoConfigMap[patternsKey].(string)
+	if !ok {
+		return nil
+	}
+	return SplitPatterns(patterns)
+}
+
+func printPathPatternsResults(results []PathPatternsResult) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(writer, "PATH\tRESULT\tREASON"); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, result := range results {
+		status, reason := formatPathPatternsResult(result)
+		if _, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", result.Path, status, reason); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return errorutils.CheckError(writer.Flush())
+}
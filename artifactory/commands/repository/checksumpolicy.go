@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The checksum policy of local and federated repositories is set by checksumPolicyType, while the checksum policy of remote
+// repositories is set by remoteRepoChecksumPolicyType. Artifactory silently ignores the field which doesn't apply.
+var checksumPolicyFields = map[string]struct {
+	rclasses []string
+	values   []string
+}{
+	ChecksumPolicyType: {
+		rclasses: []string{Local, Federated},
+		values:   []string{ClientChecksumPolicy, ServerGeneratedChecksumsPolicy},
+	},
+	RemoteRepoChecksumPolicyType: {
+		rclasses: []string{Remote},
+		values:   []string{GenerateIfAbsentPolicy, FailPolicy, IgnoreAndGeneratePolicy, PassThruPolicy},
+	},
+}
+
+// validateChecksumPolicy rejects a checksum policy field set on a repository class it doesn't apply to, and checksum
+// policy values which aren't supported.
+func validateChecksumPolicy(repoConfigMap map[string]interface{}) error {
+	rclass := fmt.Sprint(repoConfigMap[Rclass])
+	for _, field := range []string{ChecksumPolicyType, RemoteRepoChecksumPolicyType} {
+		value, ok := repoConfigMap[field]
+		if !ok {
+			continue
+		}
+		policyField := checksumPolicyFields[field]
+		if !slices.Contains(policyField.rclasses, rclass) {
+			return errorutils.CheckErrorf("repository '%v': '%s' doesn't apply to %s repositories%s", repoConfigMap[Key], field, rclass, getApplicableChecksumPolicyField(rclass))
+		}
+		if !slices.Contains(policyField.values, fmt.Sprint(value)) {
+			return errorutils.CheckErrorf("repository '%v': invalid value '%v' for '%s'. Acceptable values are: %s", repoConfigMap[Key], value, field, strings.Join(policyField.values, ", "))
+		}
+	}
+	return nil
+}
+
+// getApplicableChecksumPolicyField returns a hint naming the checksum policy field of the repository class, if it has one.
+func getApplicableChecksumPolicyField(rclass string) string {
+	for _, field := range []string{ChecksumPolicyType, RemoteRepoChecksumPolicyType} {
+		if slices.Contains(checksumPolicyFields[field].rclasses, rclass) {
+			return fmt.Sprintf(", use '%s' instead", field)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidateChecksumPolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		repoConfigMap map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "Local policy on local",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", Rclass: Local, ChecksumPolicyType: ClientChecksumPolicy},
+		},
+		{
+			name:          "Local policy on federated",
+			repoConfigMap: map[string]interface{}{Key: "maven-federated", Rclass: Federated, ChecksumPolicyType: ServerGeneratedChecksumsPolicy},
+		},
+		{
+			name:          "Remote policy on remote",
+			repoConfigMap: map[string]interface{}{Key: "maven-remote", Rclass: Remote, RemoteRepoChecksumPolicyType: PassThruPolicy},
+		},
+		{
+			name:          "No policy",
+			repoConfigMap: map[string]interface{}{Key: "maven-virtual", Rclass: Virtual},
+		},
+		{
+			name:          "Local policy on remote",
+			repoConfigMap: map[string]interface{}{Key: "maven-remote", Rclass: Remote, ChecksumPolicyType: ClientChecksumPolicy},
+			expectedError: "repository 'maven-remote': 'checksumPolicyType' doesn't apply to remote repositories, use 'remoteRepoChecksumPolicyType' instead",
+		},
+		{
+			name:          "Remote policy on local",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", Rclass: Local, RemoteRepoChecksumPolicyType: FailPolicy},
+			expectedError: "repository 'maven-local': 'remoteRepoChecksumPolicyType' doesn't apply to local repositories, use 'checksumPolicyType' instead",
+		},
+		{
+			name:          "Remote policy on virtual",
+			repoConfigMap: map[string]interface{}{Key: "maven-virtual", Rclass: Virtual, RemoteRepoChecksumPolicyType: FailPolicy},
+			expectedError: "'remoteRepoChecksumPolicyType' doesn't apply to virtual repositories",
+		},
+		{
+			name:          "Invalid local policy",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", Rclass: Local, ChecksumPolicyType: PassThruPolicy},
+			expectedError: "repository 'maven-local': invalid value 'pass-thru' for 'checksumPolicyType'. Acceptable values are: client-checksums, server-generated-checksums",
+		},
+		{
+			name:          "Invalid remote policy",
+			repoConfigMap: map[string]interface{}{Key: "maven-remote", Rclass: Remote, RemoteRepoChecksumPolicyType: "ignore"},
+			expectedError: "invalid value 'ignore' for 'remoteRepoChecksumPolicyType'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateChecksumPolicy(tt.repoConfigMap)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_RepositoryHandlers_ChecksumPolicyMisuse(t *testing.T) {
+	repoConfigMaps := []map[string]interface{}{
+		{Key: "maven-remote", Rclass: Remote, PackageType: Maven, Url: "https://repo.maven.apache.org/maven2", ChecksumPolicyType: ClientChecksumPolicy},
+	}
+	err := (&MultipleRepositoryHandler{}).Execute(repoConfigMaps, nil, false)
+	assert.ErrorContains(t, err, "use 'remoteRepoChecksumPolicyType' instead")
+
+	repoConfigMaps = []map[string]interface{}{
+		{Key: "maven-local", Rclass: Local, PackageType: Maven, RemoteRepoChecksumPolicyType: GenerateIfAbsentPolicy},
+	}
+	err = (&SingleRepositoryHandler{}).Execute(repoConfigMaps, nil, false)
+	assert.ErrorContains(t, err, "use 'checksumPolicyType' instead")
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	ioutils "github.com/jfrog/gofrog/io"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The cached artifacts of a remote repository are stored in a repository with this suffix
+const remoteCacheRepoSuffix = "-cache"
+
+// The time from which the unused period of the cached artifacts is counted back. Replaced by tests.
+var cleanupReportNow = time.Now
+
+// RepoCleanupReport is the unused artifacts cleanup configuration of a remote repository, and an estimate of the cached
+// artifacts which are eligible for the cleanup.
+type RepoCleanupReport struct {
+	RepoKey     string `json:"repoKey"`
+	PackageType string `json:"packageType"`
+	Enabled     bool   `json:"enabled"`
+	PeriodHours int64  `json:"periodHours"`
+	// The estimate is omitted when the cleanup is disabled, or when it couldn't be queried.
+	EligibleFilesCount   *int64 `json:"eligibleFilesCount,omitempty"`
+	EligibleSpaceInBytes *int64 `json:"eligibleSpaceInBytes,omitempty"`
+	EligibleSpace        string `json:"eligibleSpace,omitempty"`
+}
+
+// RepoCleanupReportCommand reports the unused artifacts cleanup configuration of the remote repositories matching a
+// pattern. Optionally, the cached artifacts which weren't downloaded within the cleanup period are queried, as an
+// estimate of the artifacts the cleanup would remove.
+type RepoCleanupReportCommand struct {
+	serverDetails *config.ServerDetails
+	repoPattern   string
+	format        string
+	estimate      bool
+}
+
+func NewRepoCleanupReportCommand() *RepoCleanupReportCommand {
+	return &RepoCleanupReportCommand{}
+}
+
+func (rcrc *RepoCleanupReportCommand) SetRepoPattern(repoPattern string) *RepoCleanupReportCommand {
+	rcrc.repoPattern = repoPattern
+	return rcrc
+}
+
+func (rcrc *RepoCleanupReportCommand) SetFormat(format string) *RepoCleanupReportCommand {
+	rcrc.format = format
+	return rcrc
+}
+
+// SetEstimate sets whether to query the cached artifacts eligible for the cleanup. The query scans the cache of each
+// repository with the cleanup enabled, so it may take a while on large caches.
+func (rcrc *RepoCleanupReportCommand) SetEstimate(estimate bool) *RepoCleanupReportCommand {
+	rcrc.estimate = estimate
+	return rcrc
+}
+
+func (rcrc *RepoCleanupReportCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoCleanupReportCommand {
+	rcrc.serverDetails = serverDetails
+	return rcrc
+}
+
+func (rcrc *RepoCleanupReportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rcrc.serverDetails, nil
+}
+
+func (rcrc *RepoCleanupReportCommand) CommandName() string {
+	return "rt_repo_cleanup_report"
+}
+
+func (rcrc *RepoCleanupReportCommand) Run() error {
+	if rcrc.format != "" && rcrc.format != "table" && rcrc.format != "json" {
+		return errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'table', 'json'", rcrc.format)
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rcrc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	reports, err := rcrc.createReports(servicesManager)
+	if err != nil {
+		return err
+	}
+	if rcrc.format == "json" {
+		content, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+		return nil
+	}
+	if len(reports) == 0 {
+		log.Info("No remote repositories match the pattern:", rcrc.getRepoPattern())
+		return nil
+	}
+	return rcrc.printReports(reports)
+}
+
+func (rcrc *RepoCleanupReportCommand) getRepoPattern() string {
+	if rcrc.repoPattern == "" {
+		return "*"
+	}
+	return rcrc.repoPattern
+}
+
+// createReports returns the reports of the remote repositories matching the pattern, sorted by their keys.
+func (rcrc *RepoCleanupReportCommand) createReports(servicesManager artifactory.ArtifactoryServicesManager) ([]RepoCleanupReport, error) {
+	repos, err := servicesManager.GetAllRepositoriesFiltered(services.RepositoriesFilterParams{RepoType: Remote})
+	if err != nil {
+		return nil, err
+	}
+	matchesKey, err := newRepoKeyMatcher(rcrc.getRepoPattern())
+	if err != nil {
+		return nil, err
+	}
+	reports := []RepoCleanupReport{}
+	for _, repo := range *repos {
+		if !matchesKey(repo.Key) {
+			continue
+		}
+		repoConfigMap := make(map[string]interface{})
+		if err = servicesManager.GetRepository(repo.Key, &repoConfigMap); err != nil {
+			return nil, err
+		}
+		report := toRepoCleanupReport(repo.Key, repoConfigMap)
+		if rcrc.estimate && report.Enabled && report.PeriodHours > 0 {
+			estimateCleanup(servicesManager, &report)
+		}
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].RepoKey < reports[j].RepoKey
+	})
+	return reports, nil
+}
+
+func toRepoCleanupReport(repoKey string, repoConfigMap map[string]interface{}) RepoCleanupReport {
+	report := RepoCleanupReport{RepoKey: repoKey, PackageType: fmt.Sprint(repoConfigMap[PackageType])}
+	// The settings may be returned either as their JSON types or as strings
+	report.Enabled, _ = parseBoolAnswer(fmt.Sprint(repoConfigMap[UnusedArtifactsCleanupEnabled]))
+	if periodHours, ok := getNumericSetting(repoConfigMap, UnusedArtifactsCleanupPeriodHours); ok {
+		report.PeriodHours = int64(periodHours)
+	}
+	return report
+}
+
+// estimateCleanup sets the count and size of the cached artifacts which were last downloaded before the cleanup period,
+// or never downloaded and cached before it. The estimate is skipped with a warning when it can't be queried, such as
+// when the user isn't permitted to search the cache.
+func estimateCleanup(servicesManager artifactory.ArtifactoryServicesManager, report *RepoCleanupReport) {
+	filesCount, spaceInBytes, err := queryCleanupEligibleFiles(servicesManager, report.RepoKey+remoteCacheRepoSuffix, report.PeriodHours)
+	if err != nil {
+		log.Warn(fmt.Sprintf("Couldn't estimate the artifacts eligible for cleanup in repository '%s': %s", report.RepoKey, err.Error()))
+		return
+	}
+	report.EligibleFilesCount = &filesCount
+	report.EligibleSpaceInBytes = &spaceInBytes
+	report.EligibleSpace = utils.ConvertIntToStorageSizeString(spaceInBytes)
+}
+
+func queryCleanupEligibleFiles(servicesManager artifactory.ArtifactoryServicesManager, cacheRepoKey string, periodHours int64) (filesCount, spaceInBytes int64, err error) {
+	reader, err := servicesManager.Aql(createCleanupEligibleFilesQuery(cacheRepoKey, periodHours))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer ioutils.Close(reader, &err)
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return 0, 0, errorutils.CheckError(err)
+	}
+	result := struct {
+		Results []struct {
+			Size int64 `json:"size"`
+		} `json:"results"`
+	}{}
+	if err = json.Unmarshal(content, &result); err != nil {
+		return 0, 0, errorutils.CheckErrorf("failed to parse the files of repository '%s': %s", cacheRepoKey, err.Error())
+	}
+	for _, file := range result.Results {
+		spaceInBytes += file.Size
+	}
+	return int64(len(result.Results)), spaceInBytes, nil
+}
+
+func createCleanupEligibleFilesQuery(cacheRepoKey string, periodHours int64) string {
+	cutoff := cleanupReportNow().Add(-time.Duration(periodHours) * time.Hour).UTC().Format("2006-01-02T15:04:05.000Z")
+	return fmt.Sprintf(`items.find({"repo": %q, "type": "file", "$or": [{"stat.downloaded": {"$lt": %q}}, {"$and": [{"stat.downloads": {"$eq": null}}, {"created": {"$lt": %q}}]}]}).include("size")`,
+		cacheRepoKey, cutoff, cutoff)
+}
+
+func (rcrc *RepoCleanupReportCommand) printReports(reports []RepoCleanupReport) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := "REPOSITORY\tPACKAGE TYPE\tCLEANUP ENABLED\tPERIOD HOURS"
+	if rcrc.estimate {
+		header += "\tELIGIBLE FILES\tELIGIBLE SPACE"
+	}
+	if _, err := fmt.Fprintln(writer, header); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, report := range reports {
+		line := fmt.Sprintf("%s\t%s\t%t\t%d", report.RepoKey, report.PackageType, report.Enabled, report.PeriodHours)
+		if rcrc.estimate {
+			eligibleFiles, eligibleSpace := "-", "-"
+			if report.EligibleFilesCount != nil {
+				eligibleFiles, eligibleSpace = strconv.FormatInt(*report.EligibleFilesCount, 10), report.EligibleSpace
+			}
+			line += "\t" + eligibleFiles + "\t" + eligibleSpace
+		}
+		if _, err := fmt.Fprintln(writer, line); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return errorutils.CheckError(writer.Flush())
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCleanupReportTestServer serves the remote repositories and the cleanup eligible files of their caches. The caches
+// of the repositories without files can't be searched.
+func newCleanupReportTestServer(t *testing.T, eligibleFiles map[string]string) *httptest.Server {
+	repos := map[string]string{
+		"npm-remote":    `{"key": "npm-remote", "rclass": "remote", "packageType": "npm", "unusedArtifactsCleanupPeriodHours": 720}`,
+		"maven-remote":  `{"key": "maven-remote", "rclass": "remote", "packageType": "maven", "unusedArtifactsCleanupEnabled": true, "unusedArtifactsCleanupPeriodHours": 48}`,
+		"docker-remote": `{"key": "docker-remote", "rclass": "remote", "packageType": "docker", "unusedArtifactsCleanupEnabled": "true", "unusedArtifactsCleanupPeriodHours": "24"}`,
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/repositories":
+			assert.Equal(t, Remote, r.URL.Query().Get("type"))
+			_, _ = w.Write([]byte(`[{"key": "npm-remote"}, {"key": "maven-remote"}, {"key": "docker-remote"}]`))
+		case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+			_, _ = w.Write([]byte(repos[strings.TrimPrefix(r.URL.Path, "/api/repositories/")]))
+		case r.URL.Path == "/api/search/aql":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			for cacheRepoKey, results := range eligibleFiles {
+				if strings.Contains(string(body), `"repo": "`+cacheRepoKey+`"`) {
+					_, _ = w.Write([]byte(`{"results": [` + results + `]}`))
+					return
+				}
+			}
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func Test_RepoCleanupReportCreateReports(t *testing.T) {
+	server := newCleanupReportTestServer(t, map[string]string{"maven-remote-cache": `{"size": 1024}, {"size": 2048}`})
+	defer server.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, -1, 0, false)
+	require.NoError(t, err)
+
+	int64Ptr := func(value int64) *int64 { return &value }
+	tests := []struct {
+		name            string
+		repoPattern     string
+		estimate        bool
+		expectedReports []RepoCleanupReport
+	}{
+		{
+			name: "Configuration only",
+			expectedReports: []RepoCleanupReport{
+				{RepoKey: "docker-remote", PackageType: "docker", Enabled: true, PeriodHours: 24},
+				{RepoKey: "maven-remote", PackageType: "maven", Enabled: true, PeriodHours: 48},
+				{RepoKey: "npm-remote", PackageType: "npm", PeriodHours: 720},
+			},
+		},
+		{
+			name:        "Estimate",
+			repoPattern: "*-remote",
+			estimate:    true,
+			expectedReports: []RepoCleanupReport{
+				// The cache of docker-remote can't be searched, so it has no estimate
+				{RepoKey: "docker-remote", PackageType: "docker", Enabled: true, PeriodHours: 24},
+				{RepoKey: "maven-remote", PackageType: "maven", Enabled: true, PeriodHours: 48,
+					EligibleFilesCount: int64Ptr(2), EligibleSpaceInBytes: int64Ptr(3072), EligibleSpace: "3.0KB"},
+				{RepoKey: "npm-remote", PackageType: "npm", PeriodHours: 720},
+			},
+		},
+		{
+			name:            "Pattern",
+			repoPattern:     "npm-*",
+			expectedReports: []RepoCleanupReport{{RepoKey: "npm-remote", PackageType: "npm", PeriodHours: 720}},
+		},
+		{
+			name:            "No match",
+			repoPattern:     "go-*",
+			expectedReports: []RepoCleanupReport{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reports, err := NewRepoCleanupReportCommand().SetRepoPattern(tt.repoPattern).SetEstimate(tt.estimate).createReports(servicesManager)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReports, reports)
+		})
+	}
+}
+
+func Test_CreateCleanupEligibleFilesQuery(t *testing.T) {
+	previousNow := cleanupReportNow
+	cleanupReportNow = func() time.Time { return time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC) }
+	defer func() {
+		cleanupReportNow = previousNow
+	}()
+
+	assert.Equal(t, `items.find({"repo": "npm-remote-cache", "type": "file", "$or": [{"stat.downloaded": {"$lt": "2025-03-08T12:00:00.000Z"}}, `+
+		`{"$and": [{"stat.downloads": {"$eq": null}}, {"created": {"$lt": "2025-03-08T12:00:00.000Z"}}]}]}).include("size")`,
+		createCleanupEligibleFilesQuery("npm-remote-cache", 48))
+}
+
+func Test_RepoCleanupReportUnsupportedFormat(t *testing.T) {
+	err := NewRepoCleanupReportCommand().SetFormat("csv").Run()
+	assert.EqualError(t, err, "unsupported format 'csv'. Supported formats: 'table', 'json'")
+}
@@ -22,6 +22,95 @@ func (rcc *RepoCreateCommand) SetVars(vars string) *RepoCreateCommand {
 	return rcc
 }
 
+func (rcc *RepoCreateCommand) SetValidate(validate bool) *RepoCreateCommand {
+	rcc.validate = validate
+	return rcc
+}
+
+func (rcc *RepoCreateCommand) SetValidateOnly(validateOnly bool) *RepoCreateCommand {
+	rcc.validateOnly = validateOnly
+	return rcc
+}
+
+// SetCheckStorage sets whether the storage provider of the instance is checked to support cdnRedirect.
+func (rcc *RepoCreateCommand) SetCheckStorage(checkStorage bool) *RepoCreateCommand {
+	rcc.checkStorage = checkStorage
+	return rcc
+}
+
+// SetCheckLayouts sets whether the repository layouts the repositories refer to are checked to exist on the instance.
+func (rcc *RepoCreateCommand) SetCheckLayouts(checkLayouts bool) *RepoCreateCommand {
+	rcc.checkLayouts = checkLayouts
+	return rcc
+}
+
+// SetDockerHardening sets whether the recommended security settings, such as blocking schema 1 manifests, are applied to
+// the Docker repositories which don't set them.
+func (rcc *RepoCreateCommand) SetDockerHardening(dockerHardening bool) *RepoCreateCommand {
+	rcc.dockerHardening = dockerHardening
+	return rcc
+}
+
+// SetProgress sets whether the progress of creating or updating the repositories one by one is reported.
+func (rcc *RepoCreateCommand) SetProgress(progress bool) *RepoCreateCommand {
+	rcc.progress = progress
+	return rcc
+}
+
+// SetQuiet sets whether the progress and the summary of the run are reported.
+func (rcc *RepoCreateCommand) SetQuiet(quiet bool) *RepoCreateCommand {
+	rcc.quiet = quiet
+	return rcc
+}
+
+// SetSummaryFormat sets the format of the summary of the run: text or json.
+func (rcc *RepoCreateCommand) SetSummaryFormat(summaryFormat string) *RepoCreateCommand {
+	rcc.summaryFormat = summaryFormat
+	return rcc
+}
+
+// SetOnSuccess sets the hook invoked after each repository is created or updated: an HTTP(S) URL or a shell command.
+func (rcc *RepoCreateCommand) SetOnSuccess(onSuccess string) *RepoCreateCommand {
+	rcc.onSuccess = onSuccess
+	return rcc
+}
+
+// SetFailOnHookError sets whether a failed hook fails the command, instead of being reported as a warning.
+func (rcc *RepoCreateCommand) SetFailOnHookError(failOnHookError bool) *RepoCreateCommand {
+	rcc.failOnHookError = failOnHookError
+	return rcc
+}
+
+func (rcc *RepoCreateCommand) SetStrictPatterns(strictPatterns bool) *RepoCreateCommand {
+	rcc.strictPatterns = strictPatterns
+	return rcc
+}
+
+func (rcc *RepoCreateCommand) SetStrict(strict bool) *RepoCreateCommand {
+	rcc.strict = strict
+	return rcc
+}
+
+func (rcc *RepoCreateCommand) SetRollbackOnFailure(rollbackOnFailure bool) *RepoCreateCommand {
+	rcc.rollbackOnFailure = rollbackOnFailure
+	return rcc
+}
+
+func (rcc *RepoCreateCommand) SetAnnotate(annotate bool) *RepoCreateCommand {
+	rcc.annotate = annotate
+	return rcc
+}
+
+func (rcc *RepoCreateCommand) SetVerifyMembers(verifyMembers bool) *RepoCreateCommand {
+	rcc.verifyMembers = verifyMembers
+	return rcc
+}
+
+func (rcc *RepoCreateCommand) SetIncludeKeys(includeKeys string) *RepoCreateCommand {
+	rcc.includeKeys = includeKeys
+	return rcc
+}
+
 func (rcc *RepoCreateCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoCreateCommand {
 	rcc.serverDetails = serverDetails
 	return rcc
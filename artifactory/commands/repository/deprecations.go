@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// deprecation describes a field, or a single value of a field, which Artifactory deprecated.
+type deprecation struct {
+	field string
+	// The deprecated value of the field. An empty value means the field itself is deprecated.
+	value       string
+	replacement string
+}
+
+// Deprecated fields and values which templates may still use, with the suggested replacement.
+var deprecations = []deprecation{
+	{field: KeyPair, replacement: "use '" + PrimaryKeyPairRef + "' instead"},
+	{field: EnableTokenAuthentication, replacement: "token authentication is always enabled for Docker repositories, remove the field"},
+	{field: DockerApiVersion, value: DockerApiV1, replacement: "use '" + DockerApiV2 + "' instead, as Docker V1 images are no longer supported"},
+	{field: VcsGitProvider, value: OldstashVcsProvider, replacement: "use '" + StashVcsProvider + "' instead"},
+}
+
+// validateDeprecatedFields detects deprecated fields and values in the configurations. Problems are reported as warnings,
+// unless strict is set, in which case they are fatal.
+func validateDeprecatedFields(repoConfigMaps []map[string]interface{}, strict bool) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		problems = append(problems, getDeprecatedFields(repoConfigMap)...)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if strict {
+		return errorutils.CheckErrorf("deprecated repository settings check failed:\n%s", strings.Join(problems, "\n"))
+	}
+	for _, problem := range problems {
+		log.Warn(problem)
+	}
+	return nil
+}
+
+func getDeprecatedFields(repoConfigMap map[string]interface{}) (problems []string) {
+	for _, dep := range deprecations {
+		value, ok := repoConfigMap[dep.field]
+		if !ok {
+			continue
+		}
+		if dep.value == "" {
+			problems = append(problems, fmt.Sprintf("repository '%v': '%s' is deprecated, %s", repoConfigMap[Key], dep.field, dep.replacement))
+			continue
+		}
+		if strings.EqualFold(fmt.Sprint(value), dep.value) {
+			problems = append(problems, fmt.Sprintf("repository '%v': value '%v' of '%s' is deprecated, %s", repoConfigMap[Key], value, dep.field, dep.replacement))
+		}
+	}
+	return
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateDeprecatedFields(t *testing.T) {
+	tests := []struct {
+		name             string
+		repoConfigMap    map[string]interface{}
+		expectedProblems []string
+	}{
+		{
+			name:             "Deprecated key pair field",
+			repoConfigMap:    map[string]interface{}{Key: "debian-virtual", Rclass: Virtual, PackageType: Debian, KeyPair: "old-key"},
+			expectedProblems: []string{"repository 'debian-virtual': 'keyPair' is deprecated, use 'primaryKeyPairRef' instead"},
+		},
+		{
+			name:             "Deprecated Docker API version",
+			repoConfigMap:    map[string]interface{}{Key: "docker-local", Rclass: Local, PackageType: Docker, DockerApiVersion: "v1", EnableTokenAuthentication: "true"},
+			expectedProblems: []string{"repository 'docker-local': 'enableTokenAuthentication' is deprecated, token authentication is always enabled for Docker repositories, remove the field", "repository 'docker-local': value 'v1' of 'dockerApiVersion' is deprecated, use 'V2' instead, as Docker V1 images are no longer supported"},
+		},
+		{
+			name:          "Supported values",
+			repoConfigMap: map[string]interface{}{Key: "go-remote", Rclass: Remote, PackageType: Go, VcsGitProvider: GithubVcsProvider, DockerApiVersion: DockerApiV2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedProblems, getDeprecatedFields(tt.repoConfigMap))
+			assert.NoError(t, validateDeprecatedFields([]map[string]interface{}{tt.repoConfigMap}, false))
+			err := validateDeprecatedFields([]map[string]interface{}{tt.repoConfigMap}, true)
+			if len(tt.expectedProblems) > 0 {
+				assert.ErrorContains(t, err, "deprecated repository settings check failed")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
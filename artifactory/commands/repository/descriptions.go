@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	ioutils "github.com/jfrog/gofrog/io"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// repoDescriptionRow is a row of the descriptions CSV file. The notes are changed only if the row has a notes column.
+type repoDescriptionRow struct {
+	line        int
+	repoKey     string
+	description string
+	notes       *string
+}
+
+// RepoDescriptionsCommand updates the description, and optionally the notes, of existing repositories from a CSV file with
+// the 'key,description[,notes]' columns. Only the changed fields are sent to Artifactory.
+type RepoDescriptionsCommand struct {
+	serverDetails *config.ServerDetails
+	csvPath       string
+	dryRun        bool
+}
+
+func NewRepoDescriptionsCommand() *RepoDescriptionsCommand {
+	return &RepoDescriptionsCommand{}
+}
+
+func (rdc *RepoDescriptionsCommand) SetCsvPath(csvPath string) *RepoDescriptionsCommand {
+	rdc.csvPath = csvPath
+	return rdc
+}
+
+func (rdc *RepoDescriptionsCommand) SetDryRun(dryRun bool) *RepoDescriptionsCommand {
+	rdc.dryRun = dryRun
+	return rdc
+}
+
+func (rdc *RepoDescriptionsCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoDescriptionsCommand {
+	rdc.serverDetails = serverDetails
+	return rdc
+}
+
+func (rdc *RepoDescriptionsCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rdc.serverDetails, nil
+}
+
+func (rdc *RepoDescriptionsCommand) CommandName() string {
+	return "rt_repo_descriptions"
+}
+
+func (rdc *RepoDescriptionsCommand) Run() (err error) {
+	csvFile, err := os.Open(rdc.csvPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer ioutils.Close(csvFile, &err)
+	rows, err := parseRepoDescriptionsCsv(csvFile)
+	if err != nil {
+		return errorutils.CheckErrorf("failed to parse '%s': %s", rdc.csvPath, err.Error())
+	}
+
+	servicesManager, err := rtUtils.CreateServiceManager(rdc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repos, err := servicesManager.GetAllRepositories()
+	if err != nil {
+		return err
+	}
+	existingRepoKeys := make(map[string]bool, len(*repos))
+	for _, repo := range *repos {
+		existingRepoKeys[repo.Key] = true
+	}
+
+	var changed, unchanged int
+	var unmatchedRows []string
+	for _, row := range rows {
+		if !existingRepoKeys[row.repoKey] {
+			unmatchedRows = append(unmatchedRows, fmt.Sprintf("line %d: '%s'", row.line, row.repoKey))
+			continue
+		}
+		rowChanged, err := rdc.applyRow(servicesManager, row)
+		if err != nil {
+			return err
+		}
+		if rowChanged {
+			changed++
+		} else {
+			unchanged++
+		}
+	}
+	summary := fmt.Sprintf("Repository descriptions: %d changed, %d unchanged, %d unmatched", changed, unchanged, len(unmatchedRows))
+	if rdc.dryRun {
+		summary = "[Dry run] " + summary
+	}
+	log.Info(summary)
+	if len(unmatchedRows) > 0 {
+		return errorutils.CheckErrorf("the following rows don't match any repository:\n%s", strings.Join(unmatchedRows, "\n"))
+	}
+	return nil
+}
+
+// applyRow updates the fields of a repository which differ from the row, and reports whether the repository was, or would
+// be in a dry run, changed.
+func (rdc *RepoDescriptionsCommand) applyRow(servicesManager artifactory.ArtifactoryServicesManager, row repoDescriptionRow) (bool, error) {
+	repoConfigMap := make(map[string]interface{})
+	if err := servicesManager.GetRepository(row.repoKey, &repoConfigMap); err != nil {
+		return false, err
+	}
+	changes := map[string]interface{}{}
+	var changeLogs []string
+	addChange := func(field, value string) {
+		current, _ := repoConfigMap[field].(string)
+		if current == value {
+			return
+		}
+		changes[field] = value
+		changeLogs = append(changeLogs, fmt.Sprintf("%s from '%s' to '%s'", field, current, value))
+	}
+	addChange(Description, row.description)
+	if row.notes != nil {
+		addChange(Notes, *row.notes)
+	}
+	if len(changes) == 0 {
+		log.Info("Repository", row.repoKey, "- no change")
+		return false, nil
+	}
+	if rdc.dryRun {
+		log.Info("[Dry run] Repository", row.repoKey, "- would change", strings.Join(changeLogs, ", "))
+		return true, nil
+	}
+	if err := updateRepoConfig(servicesManager, row.repoKey, repoConfigMap[Rclass], changes); err != nil {
+		return false, err
+	}
+	log.Info("Repository", row.repoKey, "- changed", strings.Join(changeLogs, ", "))
+	return true, nil
+}
+
+// parseRepoDescriptionsCsv parses the 'key,description[,notes]' rows of a descriptions CSV file. A first row whose first
+// column is 'key' is a header, and is skipped.
+func parseRepoDescriptionsCsv(reader io.Reader) ([]repoDescriptionRow, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+	var rows []repoDescriptionRow
+	rowLines := make(map[string]int)
+	for isFirstRecord := true; ; isFirstRecord = false {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := csvReader.FieldPos(0)
+		if isFirstRecord && strings.EqualFold(strings.TrimSpace(record[0]), Key) {
+			continue
+		}
+		if len(record) != 2 && len(record) != 3 {
+			return nil, fmt.Errorf("line %d: expected 2 or 3 columns (key,description[,notes]), found %d", line, len(record))
+		}
+		row := repoDescriptionRow{line: line, repoKey: strings.TrimSpace(record[0]), description: record[1]}
+		if row.repoKey == "" {
+			return nil, fmt.Errorf("line %d: the repository key is empty", line)
+		}
+		if previousLine, ok := rowLines[row.repoKey]; ok {
+			return nil, fmt.Errorf("line %d: repository '%s' already appears in line %d", line, row.repoKey, previousLine)
+		}
+		if len(record) == 3 {
+			row.notes = &record[2]
+		}
+		rowLines[row.repoKey] = line
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("no repositories were found")
+	}
+	return rows, nil
+}
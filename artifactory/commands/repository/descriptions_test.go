@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseRepoDescriptionsCsv(t *testing.T) {
+	notes := "Owned by the platform team"
+	rows, err := parseRepoDescriptionsCsv(strings.NewReader("key,description,notes\nmaven-local,Maven releases\n\" npm-remote \",\"npm, proxied\"," + notes + "\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []repoDescriptionRow{
+		{line: 2, repoKey: "maven-local", description: "Maven releases"},
+		{line: 3, repoKey: "npm-remote", description: "npm, proxied", notes: &notes},
+	}, rows)
+
+	tests := []struct {
+		name          string
+		content       string
+		expectedError string
+	}{
+		{name: "Empty file", content: "", expectedError: "no repositories were found"},
+		{name: "Header only", content: "key,description\n", expectedError: "no repositories were found"},
+		{name: "Missing description", content: "maven-local\n", expectedError: "line 1: expected 2 or 3 columns (key,description[,notes]), found 1"},
+		{name: "Too many columns", content: "maven-local,a,b,c\n", expectedError: "line 1: expected 2 or 3 columns"},
+		{name: "Empty key", content: "maven-local,a\n ,b\n", expectedError: "line 2: the repository key is empty"},
+		{name: "Duplicate key", content: "maven-local,a\nmaven-local,b\n", expectedError: "line 2: repository 'maven-local' already appears in line 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseRepoDescriptionsCsv(strings.NewReader(tt.content))
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func Test_RepoDescriptionsCommand(t *testing.T) {
+	const csvContent = "maven-local,Maven releases\nnpm-remote,npm proxy,Owned by the web team\ngeneric-local,Generic files,\nmissing-local,Missing\n"
+	tests := []struct {
+		name            string
+		dryRun          bool
+		expectedUpdates map[string]map[string]interface{}
+	}{
+		{
+			name: "Update changed repositories",
+			expectedUpdates: map[string]map[string]interface{}{
+				"npm-remote":    {"key": "npm-remote", "rclass": "remote", "description": "npm proxy", "notes": "Owned by the web team"},
+				"generic-local": {"key": "generic-local", "rclass": "local", "notes": ""},
+			},
+		},
+		{
+			name:            "Dry run doesn't update repositories",
+			dryRun:          true,
+			expectedUpdates: map[string]map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// maven-local already has the description, and generic-local has notes which the empty notes column clears.
+			repos := map[string]map[string]interface{}{
+				"maven-local":   {"key": "maven-local", "rclass": "local", "description": "Maven releases", "notes": "Kept"},
+				"npm-remote":    {"key": "npm-remote", "rclass": "remote", "description": "npm"},
+				"generic-local": {"key": "generic-local", "rclass": "local", "description": "Generic files", "notes": "Old notes"},
+			}
+			updates := map[string]map[string]interface{}{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/repositories" && r.Method == http.MethodGet:
+					_, err := w.Write([]byte(`[{"key":"maven-local","type":"LOCAL"},{"key":"npm-remote","type":"REMOTE"},{"key":"generic-local","type":"LOCAL"}]`))
+					require.NoError(t, err)
+				case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+					repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+					if r.Method == http.MethodPost {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						var repoConfigMap map[string]interface{}
+						require.NoError(t, json.Unmarshal(body, &repoConfigMap))
+						updates[repoKey] = repoConfigMap
+						return
+					}
+					content, err := json.Marshal(repos[repoKey])
+					require.NoError(t, err)
+					_, err = w.Write(content)
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			csvPath := filepath.Join(t.TempDir(), "descriptions.csv")
+			require.NoError(t, os.WriteFile(csvPath, []byte(csvContent), 0600))
+			descriptionsCmd := NewRepoDescriptionsCommand().SetCsvPath(csvPath).SetDryRun(tt.dryRun).
+				SetServerDetails(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"})
+			err := descriptionsCmd.Run()
+			assert.EqualError(t, err, "the following rows don't match any repository:\nline 4: 'missing-local'")
+			assert.Equal(t, tt.expectedUpdates, updates)
+		})
+	}
+}
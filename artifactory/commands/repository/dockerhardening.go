@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// dockerHardeningDefault is a recommended security setting of Docker repositories, applied when the template doesn't set it.
+type dockerHardeningDefault struct {
+	key   string
+	value bool
+	// Why the setting is recommended, reported along with the applied default.
+	reason string
+}
+
+var dockerHardeningDefaults = []dockerHardeningDefault{
+	{
+		key:    BlockPushingSchema1,
+		value:  true,
+		reason: "image manifest schema 1 is deprecated and its images can't be verified by content digest",
+	},
+	{
+		key:    BlockMismatchingMimeTypes,
+		value:  true,
+		reason: "responses of the remote registry whose MIME type doesn't match the requested artifact aren't cached",
+	},
+}
+
+// applyDockerHardening sets the recommended security settings of the Docker repositories, unless the template sets them
+// explicitly. Settings which aren't applicable to the rclass of a repository, such as blocking mismatching MIME types on a
+// local repository, are skipped. Each applied default is reported.
+func applyDockerHardening(repoConfigMaps []map[string]interface{}, isUpdate bool) {
+	templateType := Create
+	if isUpdate {
+		templateType = Update
+	}
+	for _, repoConfigMap := range repoConfigMaps {
+		if repoConfigMap[PackageType] != Docker {
+			continue
+		}
+		applicableKeys := getRclassTemplateKeys(fmt.Sprint(repoConfigMap[Rclass]), Docker, templateType)
+		for _, hardeningDefault := range dockerHardeningDefaults {
+			if _, isSet := repoConfigMap[hardeningDefault.key]; isSet || !slices.Contains(applicableKeys, hardeningDefault.key) {
+				continue
+			}
+			repoConfigMap[hardeningDefault.key] = hardeningDefault.value
+			log.Info(fmt.Sprintf("Repository '%v': setting '%s' to %t, as %s (Docker hardening).", repoConfigMap[Key], hardeningDefault.key, hardeningDefault.value, hardeningDefault.reason))
+		}
+	}
+}
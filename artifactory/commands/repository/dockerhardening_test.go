@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ApplyDockerHardening(t *testing.T) {
+	tests := []struct {
+		name           string
+		repoConfigMap  map[string]interface{}
+		isUpdate       bool
+		expectedConfig map[string]interface{}
+	}{
+		{
+			name:           "Local",
+			repoConfigMap:  map[string]interface{}{Key: "docker-local", Rclass: Local, PackageType: Docker},
+			expectedConfig: map[string]interface{}{Key: "docker-local", Rclass: Local, PackageType: Docker, BlockPushingSchema1: true},
+		},
+		{
+			name:          "Remote",
+			repoConfigMap: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker, Url: "https://registry-1.docker.io"},
+			expectedConfig: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker, Url: "https://registry-1.docker.io",
+				BlockPushingSchema1: true, BlockMismatchingMimeTypes: true},
+		},
+		{
+			name:          "Update",
+			repoConfigMap: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker},
+			isUpdate:      true,
+			expectedConfig: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker,
+				BlockPushingSchema1: true, BlockMismatchingMimeTypes: true},
+		},
+		{
+			name:           "Explicit values are kept",
+			repoConfigMap:  map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker, BlockPushingSchema1: false, BlockMismatchingMimeTypes: "false"},
+			expectedConfig: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker, BlockPushingSchema1: false, BlockMismatchingMimeTypes: "false"},
+		},
+		{
+			name:           "Other package types are ignored",
+			repoConfigMap:  map[string]interface{}{Key: "npm-local", Rclass: Local, PackageType: Npm},
+			expectedConfig: map[string]interface{}{Key: "npm-local", Rclass: Local, PackageType: Npm},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyDockerHardening([]map[string]interface{}{tt.repoConfigMap}, tt.isUpdate)
+			assert.Equal(t, tt.expectedConfig, tt.repoConfigMap)
+		})
+	}
+}
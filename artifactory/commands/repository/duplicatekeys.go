@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// validateUniqueRepoKeys fails if several configurations share a repository key, regardless of their rclasses. Such a
+// template, e.g. a local and a federated repository copied under the same key, would otherwise fail midway with a
+// conflict reported by Artifactory.
+func validateUniqueRepoKeys(repoConfigMaps []map[string]interface{}) error {
+	var repoKeys []string
+	entriesByKey := make(map[string][]string)
+	for i, repoConfigMap := range repoConfigMaps {
+		repoKey := fmt.Sprint(repoConfigMap[Key])
+		if _, ok := entriesByKey[repoKey]; !ok {
+			repoKeys = append(repoKeys, repoKey)
+		}
+		entriesByKey[repoKey] = append(entriesByKey[repoKey], fmt.Sprintf("#%d (%v)", i+1, repoConfigMap[Rclass]))
+	}
+	var collisions []string
+	for _, repoKey := range repoKeys {
+		if entries := entriesByKey[repoKey]; len(entries) > 1 {
+			collisions = append(collisions, fmt.Sprintf("repository '%s' is configured by entries %s", repoKey, strings.Join(entries, ", ")))
+		}
+	}
+	if len(collisions) > 0 {
+		return errorutils.CheckErrorf("the template configures repositories with duplicate keys:\n%s", strings.Join(collisions, "\n"))
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUniqueRepoKeys(t *testing.T) {
+	repoConfigMaps := []map[string]interface{}{
+		{Key: "maven-local", Rclass: Local, PackageType: Maven},
+		{Key: "npm-local", Rclass: Local, PackageType: Npm},
+	}
+	assert.NoError(t, validateUniqueRepoKeys(repoConfigMaps))
+
+	repoConfigMaps = append(repoConfigMaps, map[string]interface{}{Key: "maven-local", Rclass: Federated, PackageType: Maven})
+	err := validateUniqueRepoKeys(repoConfigMaps)
+	assert.ErrorContains(t, err, "repository 'maven-local' is configured by entries #1 (local), #3 (federated)")
+	assert.NotContains(t, err.Error(), "npm-local")
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const systemLicenseApi = "api/system/license"
+
+// artifactoryEdition is the edition of the Artifactory instance, as derived from its license type.
+type artifactoryEdition string
+
+const (
+	editionUnknown        artifactoryEdition = ""
+	editionOss            artifactoryEdition = "OSS"
+	editionJcr            artifactoryEdition = "JFrog Container Registry"
+	editionPro            artifactoryEdition = "Pro"
+	editionEnterprise     artifactoryEdition = "Enterprise"
+	editionEnterprisePlus artifactoryEdition = "Enterprise+"
+)
+
+// The package types available in the OSS and JFrog Container Registry editions. The other editions provide all of them.
+var editionPackageTypes = map[artifactoryEdition][]string{
+	editionOss: {Maven, Gradle, Ivy, Sbt, Generic},
+	editionJcr: {Docker, Helm, Oci, Generic},
+}
+
+// The editions in which federated repositories are available.
+var federatedEditions = []artifactoryEdition{editionEnterprise, editionEnterprisePlus}
+
+// editionProbe detects the edition of the Artifactory instance. The license is fetched on first use only, and the edition
+// is reused for the rest of the run.
+type editionProbe struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+	edition         *artifactoryEdition
+}
+
+func newEditionProbe(servicesManager artifactory.ArtifactoryServicesManager) *editionProbe {
+	return &editionProbe{servicesManager: servicesManager}
+}
+
+// getEdition returns the edition of the instance, or editionUnknown if the license couldn't be read or its type isn't
+// recognized, in which case the edition checks are skipped and the server decides.
+func (ep *editionProbe) getEdition() artifactoryEdition {
+	if ep.edition != nil {
+		return *ep.edition
+	}
+	edition, err := ep.fetchEdition()
+	if err != nil {
+		log.Debug("Couldn't detect the Artifactory edition, skipping the edition checks:", err.Error())
+	}
+	ep.edition = &edition
+	return edition
+}
+
+func (ep *editionProbe) fetchEdition() (artifactoryEdition, error) {
+	serviceDetails := ep.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := ep.servicesManager.Client().SendGet(serviceDetails.GetUrl()+systemLicenseApi, true, &httpClientDetails)
+	if err != nil {
+		return editionUnknown, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return editionUnknown, fmt.Errorf("the license request returned status %d", resp.StatusCode)
+	}
+	license := struct {
+		Type string `json:"type"`
+	}{}
+	if err = json.Unmarshal(body, &license); err != nil {
+		return editionUnknown, err
+	}
+	edition := parseEdition(license.Type)
+	if edition == editionUnknown {
+		return editionUnknown, fmt.Errorf("unrecognized license type '%s'", license.Type)
+	}
+	log.Debug(fmt.Sprintf("Detected the Artifactory edition '%s' from the license type '%s'", edition, license.Type))
+	return edition, nil
+}
+
+// parseEdition maps the type of an Artifactory license to its edition. Trial licenses include all the features.
+func parseEdition(licenseType string) artifactoryEdition {
+	lowerLicenseType := strings.ToLower(licenseType)
+	switch {
+	case strings.Contains(lowerLicenseType, "enterprise plus"), strings.Contains(lowerLicenseType, "enterprise+"), strings.Contains(lowerLicenseType, "trial"):
+		return editionEnterprisePlus
+	case strings.Contains(lowerLicenseType, "enterprise"):
+		return editionEnterprise
+	case strings.Contains(lowerLicenseType, "commercial"), strings.Contains(lowerLicenseType, "pro"):
+		return editionPro
+	case strings.Contains(lowerLicenseType, "jcr"), strings.Contains(lowerLicenseType, "container registry"):
+		return editionJcr
+	case strings.Contains(lowerLicenseType, "oss"), strings.Contains(lowerLicenseType, "community"):
+		return editionOss
+	default:
+		return editionUnknown
+	}
+}
+
+// getRequiredEdition describes the lowest edition providing the features requested by the repository configuration, or
+// returns an empty string if they are available in the given edition.
+func getRequiredEdition(repoConfigMap map[string]interface{}, edition artifactoryEdition) string {
+	if repoConfigMap[Rclass] == Federated && !slices.Contains(federatedEditions, edition) {
+		return fmt.Sprintf("federated repositories require Artifactory %s or above", editionEnterprise)
+	}
+	packageTypes, ok := editionPackageTypes[edition]
+	if ok && !slices.Contains(packageTypes, fmt.Sprint(repoConfigMap[PackageType])) {
+		return fmt.Sprintf("%s repositories require Artifactory %s or above", repoConfigMap[PackageType], editionPro)
+	}
+	return ""
+}
+
+// requestsEditionGatedFeature returns whether the repository configuration requests a feature which isn't available in
+// all the editions, so the edition is detected only when it matters.
+func requestsEditionGatedFeature(repoConfigMap map[string]interface{}) bool {
+	for _, edition := range []artifactoryEdition{editionOss, editionJcr} {
+		if getRequiredEdition(repoConfigMap, edition) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRepoEdition fails if a repository requests a package type or rclass which the edition of the Artifactory instance
+// doesn't provide, naming the required edition rather than leaving the server to reject the repository.
+func validateRepoEdition(repoConfigMaps []map[string]interface{}, probe *editionProbe) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		if !requestsEditionGatedFeature(repoConfigMap) {
+			continue
+		}
+		edition := probe.getEdition()
+		if edition == editionUnknown {
+			return nil
+		}
+		if problem := getRequiredEdition(repoConfigMap, edition); problem != "" {
+			problems = append(problems, fmt.Sprintf("repository '%v': %s", repoConfigMap[Key], problem))
+		}
+	}
+	if len(problems) > 0 {
+		return errorutils.CheckErrorf("the following repositories aren't supported by this Artifactory edition (%s):\n%s", probe.getEdition(), strings.Join(problems, "\n"))
+	}
+	return nil
+}
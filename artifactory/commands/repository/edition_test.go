@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveTestLicense answers the license request of the edition check with a license providing all the features, so the
+// handlers of the tests not concerned with the edition only get the repository requests. Returns whether it was answered.
+func serveTestLicense(t *testing.T, w http.ResponseWriter, r *http.Request) bool {
+	if r.URL.Path != "/"+systemLicenseApi {
+		return false
+	}
+	_, err := w.Write([]byte(`{"type":"Enterprise Plus"}`))
+	require.NoError(t, err)
+	return true
+}
+
+func TestParseEdition(t *testing.T) {
+	tests := map[string]artifactoryEdition{
+		"Enterprise Plus":          editionEnterprisePlus,
+		"Enterprise Plus Trial":    editionEnterprisePlus,
+		"Trial":                    editionEnterprisePlus,
+		"Enterprise":               editionEnterprise,
+		"Commercial":               editionPro,
+		"JCR Edition":              editionJcr,
+		"JFrog Container Registry": editionJcr,
+		"OSS":                      editionOss,
+		"Edge":                     editionUnknown,
+		"":                         editionUnknown,
+	}
+	for licenseType, expected := range tests {
+		assert.Equal(t, expected, parseEdition(licenseType), licenseType)
+	}
+}
+
+func TestValidateRepoEdition(t *testing.T) {
+	tests := []struct {
+		name            string
+		licenseStatus   int
+		licenseType     string
+		repoConfigMaps  []map[string]interface{}
+		expectedError   string
+		expectedQueries int
+	}{
+		{
+			name:        "Npm on OSS",
+			licenseType: "OSS",
+			repoConfigMaps: []map[string]interface{}{
+				{Key: "maven-local", Rclass: Local, PackageType: Maven},
+				{Key: "npm-local", Rclass: Local, PackageType: Npm},
+				{Key: "docker-remote", Rclass: Remote, PackageType: Docker},
+			},
+			expectedError: "the following repositories aren't supported by this Artifactory edition (OSS):\n" +
+				"repository 'npm-local': npm repositories require Artifactory Pro or above\n" +
+				"repository 'docker-remote': docker repositories require Artifactory Pro or above",
+			expectedQueries: 1,
+		},
+		{
+			name:            "Docker on JFrog Container Registry",
+			licenseType:     "JCR Edition",
+			repoConfigMaps:  []map[string]interface{}{{Key: "docker-local", Rclass: Local, PackageType: Docker}, {Key: "helm-local", Rclass: Local, PackageType: Helm}},
+			expectedQueries: 1,
+		},
+		{
+			name:            "Federated on Pro",
+			licenseType:     "Commercial",
+			repoConfigMaps:  []map[string]interface{}{{Key: "maven-federated", Rclass: Federated, PackageType: Maven}},
+			expectedError:   "repository 'maven-federated': federated repositories require Artifactory Enterprise or above",
+			expectedQueries: 1,
+		},
+		{
+			name:            "Federated on Enterprise",
+			licenseType:     "Enterprise",
+			repoConfigMaps:  []map[string]interface{}{{Key: "npm-federated", Rclass: Federated, PackageType: Npm}},
+			expectedQueries: 1,
+		},
+		{
+			name:           "No edition gated feature",
+			licenseType:    "OSS",
+			repoConfigMaps: []map[string]interface{}{{Key: "generic-local", Rclass: Local, PackageType: Generic}},
+		},
+		{
+			name:            "License not readable",
+			licenseStatus:   http.StatusForbidden,
+			repoConfigMaps:  []map[string]interface{}{{Key: "npm-local", Rclass: Local, PackageType: Npm}, {Key: "pypi-local", Rclass: Local, PackageType: Pypi}},
+			expectedQueries: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/"+systemLicenseApi {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				queries++
+				if tt.licenseStatus != 0 {
+					w.WriteHeader(tt.licenseStatus)
+					return
+				}
+				_, err := w.Write([]byte(`{"type":"` + tt.licenseType + `","validThrough":"Dec 31, 2030","licensedTo":"JFrog"}`))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, -1, 0, false)
+			require.NoError(t, err)
+			err = validateRepoEdition(tt.repoConfigMaps, newEditionProbe(servicesManager))
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedQueries, queries)
+		})
+	}
+}
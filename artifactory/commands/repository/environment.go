@@ -0,0 +1,216 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const environmentsApi = "access/api/v1/environments"
+
+// RepoEnvironmentCommand assigns an environment to the repositories matching a pattern, or clears their environment, by
+// fetching the configuration of each repository, modifying it and updating it back.
+type RepoEnvironmentCommand struct {
+	serverDetails *config.ServerDetails
+	repoPattern   string
+	// The environment to assign. When empty, the environment of the repositories is cleared.
+	environment string
+	dryRun      bool
+}
+
+func NewRepoEnvironmentCommand() *RepoEnvironmentCommand {
+	return &RepoEnvironmentCommand{}
+}
+
+func (rec *RepoEnvironmentCommand) SetRepoPattern(repoPattern string) *RepoEnvironmentCommand {
+	rec.repoPattern = repoPattern
+	return rec
+}
+
+func (rec *RepoEnvironmentCommand) SetEnvironment(environment string) *RepoEnvironmentCommand {
+	rec.environment = environment
+	return rec
+}
+
+func (rec *RepoEnvironmentCommand) SetDryRun(dryRun bool) *RepoEnvironmentCommand {
+	rec.dryRun = dryRun
+	return rec
+}
+
+func (rec *RepoEnvironmentCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoEnvironmentCommand {
+	rec.serverDetails = serverDetails
+	return rec
+}
+
+func (rec *RepoEnvironmentCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rec.serverDetails, nil
+}
+
+func (rec *RepoEnvironmentCommand) CommandName() string {
+	return "rt_repo_environment"
+}
+
+func (rec *RepoEnvironmentCommand) Run() error {
+	servicesManager, err := rtUtils.CreateServiceManager(rec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if rec.environment != "" {
+		if err = validateEnvironmentExists(servicesManager, getPlatformUrl(rec.serverDetails), rec.environment); err != nil {
+			return err
+		}
+	}
+	repos, err := servicesManager.GetAllRepositories()
+	if err != nil {
+		return err
+	}
+	repoKeys, skippedRepoKeys, err := rec.getApplicableRepoKeys(*repos)
+	if err != nil {
+		return err
+	}
+	for _, repoKey := range skippedRepoKeys {
+		log.Info("Repository", repoKey, "- skipped, environments can't be assigned to repositories of its class")
+	}
+	if len(repoKeys) == 0 {
+		log.Info("No applicable repositories match the pattern:", rec.repoPattern)
+		return nil
+	}
+
+	changed := 0
+	for _, repoKey := range repoKeys {
+		repoChanged, err := rec.setEnvironment(servicesManager, repoKey)
+		if err != nil {
+			return err
+		}
+		if repoChanged {
+			changed++
+		}
+	}
+	summary := fmt.Sprintf("%s: %d changed, %d unchanged, %d skipped", rec.describeChange(), changed, len(repoKeys)-changed, len(skippedRepoKeys))
+	if rec.dryRun {
+		summary = "[Dry run] " + summary
+	}
+	log.Info(summary)
+	return nil
+}
+
+func (rec *RepoEnvironmentCommand) describeChange() string {
+	if rec.environment == "" {
+		return "environment cleared"
+	}
+	return fmt.Sprintf("environment set to '%s'", rec.environment)
+}
+
+// getApplicableRepoKeys returns the keys of the repositories matching the pattern to which environments can be assigned,
+// and the keys of the other matching repositories. A repository requested by its key must exist and be applicable.
+func (rec *RepoEnvironmentCommand) getApplicableRepoKeys(repos []services.RepositoryDetails) (repoKeys, skippedRepoKeys []string, err error) {
+	isPattern := isRepoKeysPattern(rec.repoPattern)
+	matchesKey, err := newRepoKeyMatcher(rec.repoPattern)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, repo := range repos {
+		if !matchesKey(repo.Key) {
+			continue
+		}
+		rclass := strings.ToLower(repo.GetRepoType())
+		if slices.Contains(supportedRclasses, rclass) {
+			repoKeys = append(repoKeys, repo.Key)
+			continue
+		}
+		if !isPattern {
+			return nil, nil, errorutils.CheckErrorf("repository '%s' is a %s repository, to which environments can't be assigned", repo.Key, rclass)
+		}
+		skippedRepoKeys = append(skippedRepoKeys, repo.Key)
+	}
+	if !isPattern && len(repoKeys) == 0 {
+		return nil, nil, errorutils.CheckErrorf("repository '%s' doesn't exist", rec.repoPattern)
+	}
+	return repoKeys, skippedRepoKeys, nil
+}
+
+// setEnvironment updates the environment of a repository, and reports whether it was, or would be in a dry run, changed.
+func (rec *RepoEnvironmentCommand) setEnvironment(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (bool, error) {
+	repoConfigMap := make(map[string]interface{})
+	if err := servicesManager.GetRepository(repoKey, &repoConfigMap); err != nil {
+		return false, err
+	}
+	current := getRepoEnvironments(repoConfigMap)
+	// Only one environment is allowed in practice, so the environments are replaced rather than added to.
+	var requested []string
+	if rec.environment != "" {
+		requested = []string{rec.environment}
+	}
+	if slices.Equal(current, requested) {
+		log.Info("Repository", repoKey, "- environment is already", formatEnvironments(current), "(no change)")
+		return false, nil
+	}
+	if rec.dryRun {
+		log.Info("[Dry run] Repository", repoKey, "- environment would change from", formatEnvironments(current), "to", formatEnvironments(requested))
+		return true, nil
+	}
+	if err := updateRepoConfig(servicesManager, repoKey, repoConfigMap[Rclass], map[string]interface{}{environmentsKey: append([]string{}, requested...)}); err != nil {
+		return false, err
+	}
+	log.Info("Repository", repoKey, "- environment changed from", formatEnvironments(current), "to", formatEnvironments(requested))
+	return true, nil
+}
+
+func getRepoEnvironments(repoConfigMap map[string]interface{}) (environments []string) {
+	values, _ := repoConfigMap[environmentsKey].([]interface{})
+	for _, value := range values {
+		environments = append(environments, fmt.Sprint(value))
+	}
+	return
+}
+
+func formatEnvironments(environments []string) string {
+	if len(environments) == 0 {
+		return "(none)"
+	}
+	return "'" + strings.Join(environments, "', '") + "'"
+}
+
+// getPlatformUrl returns the URL of the JFrog Platform, derived from the Artifactory URL when it isn't configured.
+func getPlatformUrl(serverDetails *config.ServerDetails) string {
+	if serverDetails.GetUrl() != "" {
+		return clientUtils.AddTrailingSlashIfNeeded(serverDetails.GetUrl())
+	}
+	return strings.TrimSuffix(clientUtils.AddTrailingSlashIfNeeded(serverDetails.GetArtifactoryUrl()), "artifactory/")
+}
+
+// validateEnvironmentExists fails if the environment isn't defined on the platform, listing the defined environments.
+func validateEnvironmentExists(servicesManager artifactory.ArtifactoryServicesManager, platformUrl, environment string) error {
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(platformUrl+environmentsApi, true, &httpClientDetails)
+	if err != nil {
+		return errorutils.CheckErrorf("failed to get the environments of the platform: %s", err.Error())
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return errorutils.CheckErrorf("failed to get the environments of the platform: %s", err.Error())
+	}
+	var environments []struct {
+		Name string `json:"name"`
+	}
+	if err = json.Unmarshal(body, &environments); err != nil {
+		return errorutils.CheckErrorf("failed to parse the environments of the platform: %s", err.Error())
+	}
+	names := make([]string, 0, len(environments))
+	for _, env := range environments {
+		if env.Name == environment {
+			return nil
+		}
+		names = append(names, env.Name)
+	}
+	return errorutils.CheckErrorf("environment '%s' doesn't exist on the platform. The existing environments are: %s", environment, strings.Join(names, ", "))
+}
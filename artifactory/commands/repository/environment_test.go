@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetPlatformUrl(t *testing.T) {
+	assert.Equal(t, "https://acme.jfrog.io/", getPlatformUrl(&config.ServerDetails{Url: "https://acme.jfrog.io"}))
+	assert.Equal(t, "https://acme.jfrog.io/", getPlatformUrl(&config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory"}))
+}
+
+func Test_RepoEnvironmentCommand(t *testing.T) {
+	tests := []struct {
+		name            string
+		repoPattern     string
+		environment     string
+		dryRun          bool
+		expectedUpdated map[string][]interface{}
+		expectedError   string
+	}{
+		{
+			name:            "Assign to repositories matching a pattern",
+			repoPattern:     "maven-*",
+			environment:     "PROD",
+			expectedUpdated: map[string][]interface{}{"maven-remote": {"PROD"}, "maven-virtual": {"PROD"}},
+		},
+		{
+			name:            "Clear a single repository",
+			repoPattern:     "maven-local",
+			expectedUpdated: map[string][]interface{}{"maven-local": {}},
+		},
+		{
+			name:            "Dry run doesn't update repositories",
+			repoPattern:     "maven-*",
+			environment:     "DEV",
+			dryRun:          true,
+			expectedUpdated: map[string][]interface{}{},
+		},
+		{
+			name:            "Missing environment",
+			repoPattern:     "maven-*",
+			environment:     "QA",
+			expectedUpdated: map[string][]interface{}{},
+			expectedError:   "environment 'QA' doesn't exist on the platform. The existing environments are: DEV, PROD",
+		},
+		{
+			name:            "Inapplicable repository",
+			repoPattern:     "maven-distribution",
+			environment:     "PROD",
+			expectedUpdated: map[string][]interface{}{},
+			expectedError:   "repository 'maven-distribution' is a distribution repository, to which environments can't be assigned",
+		},
+		{
+			name:            "Missing repository",
+			repoPattern:     "maven-missing",
+			environment:     "PROD",
+			expectedUpdated: map[string][]interface{}{},
+			expectedError:   "repository 'maven-missing' doesn't exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// maven-local is already assigned to PROD.
+			repos := map[string]map[string]interface{}{
+				"maven-local":   {"key": "maven-local", "rclass": "local", "environments": []string{"PROD"}},
+				"maven-remote":  {"key": "maven-remote", "rclass": "remote", "environments": []string{"DEV"}},
+				"maven-virtual": {"key": "maven-virtual", "rclass": "virtual"},
+			}
+			updated := map[string][]interface{}{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/"+environmentsApi:
+					_, err := w.Write([]byte(`[{"name":"DEV"},{"name":"PROD"}]`))
+					require.NoError(t, err)
+				case r.URL.Path == "/api/repositories" && r.Method == http.MethodGet:
+					_, err := w.Write([]byte(`[{"key":"maven-local","type":"LOCAL"},{"key":"maven-remote","type":"REMOTE"},{"key":"maven-virtual","type":"VIRTUAL"},{"key":"maven-distribution","type":"DISTRIBUTION"}]`))
+					require.NoError(t, err)
+				case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+					repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+					if r.Method == http.MethodPost {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						var repoConfigMap map[string]interface{}
+						require.NoError(t, json.Unmarshal(body, &repoConfigMap))
+						assert.Equal(t, repoKey, repoConfigMap["key"])
+						updated[repoKey] = repoConfigMap["environments"].([]interface{})
+						return
+					}
+					content, err := json.Marshal(repos[repoKey])
+					require.NoError(t, err)
+					_, err = w.Write(content)
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			environmentCmd := NewRepoEnvironmentCommand().SetRepoPattern(tt.repoPattern).SetEnvironment(tt.environment).
+				SetDryRun(tt.dryRun).SetServerDetails(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"})
+			err := environmentCmd.Run()
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUpdated, updated)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The progress of an interrupted export, kept in the output directory. It has no template extension, so the
+	// directory can be used as a template directory even before the export completes.
+	repoExportStateFileName = ".repo-export.state"
+	// The number of repositories exported between saves of the progress.
+	DefaultRepoExportChunkSize = 50
+)
+
+// repoExportState is the progress of an export, the keys of the repositories which were already exported.
+type repoExportState struct {
+	ExportedRepos []string `json:"exportedRepos"`
+}
+
+// RepoExportCommand exports the configurations of the repositories matching a pattern to a directory, as a single
+// repository template per repository, which repo-create and repo-update accept as a template directory. The repositories
+// are exported in chunks, and the progress is saved after each chunk, so an interrupted export resumes where it stopped
+// when run again with the same output directory. The exported templates are the same whether the export completed in a
+// single run or was resumed.
+type RepoExportCommand struct {
+	serverDetails *config.ServerDetails
+	outputDir     string
+	repoPattern   string
+	chunkSize     int
+}
+
+func NewRepoExportCommand() *RepoExportCommand {
+	return &RepoExportCommand{chunkSize: DefaultRepoExportChunkSize}
+}
+
+func (rec *RepoExportCommand) SetOutputDir(outputDir string) *RepoExportCommand {
+	rec.outputDir = outputDir
+	return rec
+}
+
+// SetRepoPattern sets the pattern of the keys of the repositories to export, a glob or a regular expression prefixed
+// with 'regex:'. All the repositories are exported when it's empty.
+func (rec *RepoExportCommand) SetRepoPattern(repoPattern string) *RepoExportCommand {
+	rec.repoPattern = repoPattern
+	return rec
+}
+
+func (rec *RepoExportCommand) SetChunkSize(chunkSize int) *RepoExportCommand {
+	rec.chunkSize = chunkSize
+	return rec
+}
+
+func (rec *RepoExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoExportCommand {
+	rec.serverDetails = serverDetails
+	return rec
+}
+
+func (rec *RepoExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rec.serverDetails, nil
+}
+
+func (rec *RepoExportCommand) CommandName() string {
+	return "rt_repo_export"
+}
+
+func (rec *RepoExportCommand) Run() error {
+	if rec.chunkSize <= 0 {
+		return errorutils.CheckErrorf("the chunk size must be a positive number, got %d", rec.chunkSize)
+	}
+	if err := os.MkdirAll(rec.outputDir, 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	return rec.export(servicesManager)
+}
+
+func (rec *RepoExportCommand) export(servicesManager artifactory.ArtifactoryServicesManager) error {
+	state, err := readRepoExportState(rec.outputDir)
+	if err != nil {
+		return err
+	}
+	repoKeys, err := rec.getRepoKeys(servicesManager)
+	if err != nil {
+		return err
+	}
+	exported := make(map[string]bool, len(state.ExportedRepos))
+	for _, repoKey := range state.ExportedRepos {
+		exported[repoKey] = true
+	}
+	var pendingRepoKeys []string
+	for _, repoKey := range repoKeys {
+		if !exported[repoKey] {
+			pendingRepoKeys = append(pendingRepoKeys, repoKey)
+		}
+	}
+	if len(state.ExportedRepos) > 0 {
+		log.Info(fmt.Sprintf("Resuming the export: %d repositories were already exported, %d remain.", len(repoKeys)-len(pendingRepoKeys), len(pendingRepoKeys)))
+	}
+
+	alreadyExportedCount := len(repoKeys) - len(pendingRepoKeys)
+	for i, repoKey := range pendingRepoKeys {
+		if err = rec.exportRepo(servicesManager, repoKey); err != nil {
+			// Keep the progress of the current chunk, so the next run doesn't export its repositories again
+			if stateErr := writeRepoExportState(rec.outputDir, state); stateErr != nil {
+				return errors.Join(err, stateErr)
+			}
+			return errorutils.CheckErrorf("failed to export repository '%s': %s\nThe export was interrupted with %d repositories remaining. Run the command again with the same output directory to resume it.", repoKey, err.Error(), len(pendingRepoKeys)-i)
+		}
+		state.ExportedRepos = append(state.ExportedRepos, repoKey)
+		if exportedCount := i + 1; exportedCount%rec.chunkSize == 0 || exportedCount == len(pendingRepoKeys) {
+			if err = writeRepoExportState(rec.outputDir, state); err != nil {
+				return err
+			}
+			log.Info(fmt.Sprintf("Exported %d of %d repositories, %d remaining.", alreadyExportedCount+exportedCount, len(repoKeys), len(pendingRepoKeys)-exportedCount))
+		}
+	}
+
+	if err = os.Remove(filepath.Join(rec.outputDir, repoExportStateFileName)); err != nil && !os.IsNotExist(err) {
+		return errorutils.CheckError(err)
+	}
+	log.Info(fmt.Sprintf("Exported the configurations of %d repositories to '%s'.", len(repoKeys), rec.outputDir))
+	return nil
+}
+
+// getRepoKeys returns the sorted keys of the repositories matching the pattern, so the repositories are always
+// exported in the same order.
+func (rec *RepoExportCommand) getRepoKeys(servicesManager artifactory.ArtifactoryServicesManager) ([]string, error) {
+	matchesKey := func(string) bool { return true }
+	if rec.repoPattern != "" {
+		var err error
+		if matchesKey, err = newRepoKeyMatcher(rec.repoPattern); err != nil {
+			return nil, err
+		}
+	}
+	repos, err := servicesManager.GetAllRepositories()
+	if err != nil {
+		return nil, err
+	}
+	var repoKeys []string
+	for _, repo := range *repos {
+		if matchesKey(repo.Key) {
+			repoKeys = append(repoKeys, repo.Key)
+		}
+	}
+	sort.Strings(repoKeys)
+	return repoKeys, nil
+}
+
+// exportRepo writes the configuration of the repository as a single repository template, named after its key.
+func (rec *RepoExportCommand) exportRepo(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) error {
+	repoConfigMap := make(map[string]interface{})
+	if err := servicesManager.GetRepository(repoKey, &repoConfigMap); err != nil {
+		return err
+	}
+	content, err := marshalCanonicalJson(repoConfigMap, "  ")
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckError(os.WriteFile(filepath.Join(rec.outputDir, repoKey+".json"), append(content, '\n'), 0644))
+}
+
+func readRepoExportState(outputDir string) (*repoExportState, error) {
+	state := &repoExportState{}
+	content, err := os.ReadFile(filepath.Join(outputDir, repoExportStateFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return state, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	if err = json.Unmarshal(content, state); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the export progress file '%s'. Delete it to export all the repositories again: %s", filepath.Join(outputDir, repoExportStateFileName), err.Error())
+	}
+	return state, nil
+}
+
+// writeRepoExportState replaces the progress file through a rename, so an interruption never leaves it partially written.
+func writeRepoExportState(outputDir string, state *repoExportState) error {
+	content, err := json.Marshal(state)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	statePath := filepath.Join(outputDir, repoExportStateFileName)
+	if err = os.WriteFile(statePath+".tmp", content, 0644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.Rename(statePath+".tmp", statePath))
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoExport(t *testing.T) {
+	repoConfigs := map[string]string{
+		"generic-local": `{"key": "generic-local", "rclass": "local", "packageType": "generic"}`,
+		"maven-remote":  `{"key": "maven-remote", "rclass": "remote", "packageType": "maven", "url": "https://repo1.maven.org/maven2?a=1&b=2"}`,
+		"npm-local":     `{"key": "npm-local", "rclass": "local", "packageType": "npm"}`,
+		"npm-virtual":   `{"key": "npm-virtual", "rclass": "virtual", "packageType": "npm", "repositories": ["npm-local"]}`,
+	}
+	var failingRepoKey string
+	var requestedRepoKeys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/repositories" {
+			_, _ = w.Write([]byte(`[{"key": "npm-virtual"}, {"key": "generic-local"}, {"key": "npm-local"}, {"key": "maven-remote"}]`))
+			return
+		}
+		repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+		requestedRepoKeys = append(requestedRepoKeys, repoKey)
+		if repoKey == failingRepoKey {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(repoConfigs[repoKey]))
+	}))
+	defer server.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, -1, 0, false)
+	require.NoError(t, err)
+
+	// A single pass
+	singlePassDir := t.TempDir()
+	require.NoError(t, NewRepoExportCommand().SetOutputDir(singlePassDir).SetChunkSize(2).export(servicesManager))
+	assert.Equal(t, []string{"generic-local", "maven-remote", "npm-local", "npm-virtual"}, requestedRepoKeys)
+	assert.NoFileExists(t, filepath.Join(singlePassDir, repoExportStateFileName))
+	content, err := os.ReadFile(filepath.Join(singlePassDir, "maven-remote.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"key\": \"maven-remote\",\n  \"packageType\": \"maven\",\n  \"rclass\": \"remote\",\n  \"url\": \"https://repo1.maven.org/maven2?a=1&b=2\"\n}\n", string(content))
+
+	// Interrupted on the second chunk, then resumed
+	resumedDir := t.TempDir()
+	failingRepoKey, requestedRepoKeys = "npm-virtual", nil
+	err = NewRepoExportCommand().SetOutputDir(resumedDir).SetChunkSize(2).export(servicesManager)
+	assert.ErrorContains(t, err, "The export was interrupted with 1 repositories remaining.")
+	state, err := readRepoExportState(resumedDir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"generic-local", "maven-remote", "npm-local"}, state.ExportedRepos)
+
+	failingRepoKey, requestedRepoKeys = "", nil
+	require.NoError(t, NewRepoExportCommand().SetOutputDir(resumedDir).SetChunkSize(2).export(servicesManager))
+	assert.Equal(t, []string{"npm-virtual"}, requestedRepoKeys)
+	assertSameDirContent(t, singlePassDir, resumedDir)
+}
+
+func TestRepoExportPattern(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/repositories" {
+			_, _ = w.Write([]byte(`[{"key": "npm-local"}, {"key": "maven-local"}]`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"key": "` + strings.TrimPrefix(r.URL.Path, "/api/repositories/") + `"}`))
+	}))
+	defer server.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, -1, 0, false)
+	require.NoError(t, err)
+
+	outputDir := t.TempDir()
+	require.NoError(t, NewRepoExportCommand().SetOutputDir(outputDir).SetRepoPattern("npm-*").export(servicesManager))
+	assertDirFiles(t, outputDir, "npm-local.json")
+}
+
+func TestRepoExportInvalidState(t *testing.T) {
+	outputDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(outputDir, repoExportStateFileName), []byte("not json"), 0644))
+	var servicesManager artifactory.ArtifactoryServicesManager
+	err := NewRepoExportCommand().SetOutputDir(outputDir).export(servicesManager)
+	assert.ErrorContains(t, err, "failed to parse the export progress file")
+}
+
+func assertSameDirContent(t *testing.T, expectedDir, actualDir string) {
+	expectedEntries, err := os.ReadDir(expectedDir)
+	require.NoError(t, err)
+	var expectedFiles []string
+	for _, entry := range expectedEntries {
+		expectedFiles = append(expectedFiles, entry.Name())
+		expectedContent, err := os.ReadFile(filepath.Join(expectedDir, entry.Name()))
+		require.NoError(t, err)
+		actualContent, err := os.ReadFile(filepath.Join(actualDir, entry.Name()))
+		require.NoError(t, err)
+		assert.Equal(t, string(expectedContent), string(actualContent), entry.Name())
+	}
+	assertDirFiles(t, actualDir, expectedFiles...)
+}
+
+func assertDirFiles(t *testing.T, dir string, expectedFiles ...string) {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var files []string
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	assert.Equal(t, expectedFiles, files)
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	// The template section grouping the external dependencies settings, which is expanded to the externalDependenciesEnabled,
+	// externalDependenciesPatterns and externalDependenciesRemoteRepo fields once its combination of values is validated.
+	// For example: {"enabled": true, "patterns": ["**/github.com/**"], "remoteRepo": "npm-remote"}. When the section is set,
+	// external dependencies are enabled unless "enabled" is false.
+	ExternalDependencies = "externalDependencies"
+
+	externalDependenciesEnabledField    = "enabled"
+	externalDependenciesPatternsField   = "patterns"
+	externalDependenciesRemoteRepoField = "remoteRepo"
+)
+
+var externalDependenciesFields = []string{externalDependenciesEnabledField, externalDependenciesPatternsField, externalDependenciesRemoteRepoField}
+
+// The repositories supporting external dependencies.
+var externalDependenciesCapabilities = []repoCapability{
+	{
+		rclasses:     []string{Remote},
+		packageTypes: []string{Docker},
+	},
+	{
+		rclasses:     []string{Virtual},
+		packageTypes: []string{Npm, Bower, Go},
+	},
+}
+
+// The repositories on which external dependencies are rewritten to a remote repository, which must be set once they are enabled.
+var externalDependenciesRemoteRepoCapability = repoCapability{
+	rclasses:     []string{Virtual},
+	packageTypes: []string{Npm, Bower},
+}
+
+// expandExternalDependencies replaces the externalDependencies section of the repository configurations with the fields
+// it groups. The rclasses and package types are expected to be normalized.
+func expandExternalDependencies(repoConfigMaps []map[string]interface{}) error {
+	for _, repoConfigMap := range repoConfigMaps {
+		value, ok := repoConfigMap[ExternalDependencies]
+		if !ok {
+			continue
+		}
+		if err := expandExternalDependenciesSection(repoConfigMap, value); err != nil {
+			return errorutils.CheckErrorf("repository '%v': %s", repoConfigMap[Key], err.Error())
+		}
+		delete(repoConfigMap, ExternalDependencies)
+	}
+	return nil
+}
+
+func expandExternalDependenciesSection(repoConfigMap map[string]interface{}, value interface{}) error {
+	section, ok := value.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("'%s' must be an object with the fields: %s", ExternalDependencies, strings.Join(externalDependenciesFields, ", "))
+	}
+	if err := validateExternalDependenciesSectionFields(repoConfigMap, section); err != nil {
+		return err
+	}
+	rclass, packageType := fmt.Sprint(repoConfigMap[Rclass]), fmt.Sprint(repoConfigMap[PackageType])
+	if !isSupportedByAny(externalDependenciesCapabilities, rclass, packageType) {
+		return fmt.Errorf("'%s' is supported only by remote Docker and virtual npm, Bower and Go repositories", ExternalDependencies)
+	}
+	enabled := true
+	if enabledValue, ok := section[externalDependenciesEnabledField]; ok {
+		var err error
+		if enabled, err = strconv.ParseBool(fmt.Sprint(enabledValue)); err != nil {
+			return fmt.Errorf("'%s.%s' must be a boolean, got '%v'", ExternalDependencies, externalDependenciesEnabledField, enabledValue)
+		}
+	}
+	patterns, err := parseExternalDependenciesPatterns(section[externalDependenciesPatternsField])
+	if err != nil {
+		return err
+	}
+	remoteRepo := ""
+	if remoteRepoValue, ok := section[externalDependenciesRemoteRepoField]; ok {
+		remoteRepo = strings.TrimSpace(fmt.Sprint(remoteRepoValue))
+	}
+	supportsRemoteRepo := externalDependenciesRemoteRepoCapability.isSupported(rclass, packageType)
+	if remoteRepo != "" && !supportsRemoteRepo {
+		return fmt.Errorf("'%s.%s' is supported only by virtual npm and Bower repositories", ExternalDependencies, externalDependenciesRemoteRepoField)
+	}
+	if enabled {
+		if len(patterns) == 0 {
+			return fmt.Errorf("'%s.%s' must list at least one pattern when external dependencies are enabled", ExternalDependencies, externalDependenciesPatternsField)
+		}
+		if supportsRemoteRepo && remoteRepo == "" {
+			return fmt.Errorf("'%s.%s' must be set when external dependencies are enabled", ExternalDependencies, externalDependenciesRemoteRepoField)
+		}
+	}
+
+	// The expanded fields are strings like the rest of the template values, which the answer writers convert on submission.
+	repoConfigMap[ExternalDependenciesEnabled] = strconv.FormatBool(enabled)
+	if len(patterns) > 0 {
+		repoConfigMap[ExternalDependenciesPatterns] = strings.Join(patterns, ",")
+	}
+	if remoteRepo != "" {
+		repoConfigMap[ExternalDependenciesRemoteRepo] = remoteRepo
+	}
+	return nil
+}
+
+// validateExternalDependenciesSectionFields rejects unknown fields in the section, and the fields the section expands to
+// when they are also set directly, as it would be ambiguous which value applies.
+func validateExternalDependenciesSectionFields(repoConfigMap, section map[string]interface{}) error {
+	var unknownFields []string
+	for field := range section {
+		if !slices.Contains(externalDependenciesFields, field) {
+			unknownFields = append(unknownFields, field)
+		}
+	}
+	if len(unknownFields) > 0 {
+		sort.Strings(unknownFields)
+		return fmt.Errorf("unknown fields in '%s': %s. Supported fields are: %s", ExternalDependencies, strings.Join(unknownFields, ", "), strings.Join(externalDependenciesFields, ", "))
+	}
+	for _, field := range []string{ExternalDependenciesEnabled, ExternalDependenciesPatterns, ExternalDependenciesRemoteRepo} {
+		if _, ok := repoConfigMap[field]; ok {
+			return fmt.Errorf("'%s' can't be set together with the '%s' section", field, ExternalDependencies)
+		}
+	}
+	return nil
+}
+
+// parseExternalDependenciesPatterns accepts the patterns either as a list or as a comma separated string.
+func parseExternalDependenciesPatterns(value interface{}) ([]string, error) {
+	var rawPatterns []string
+	switch typedValue := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		rawPatterns = strings.Split(typedValue, ",")
+	case []interface{}:
+		for _, pattern := range typedValue {
+			stringPattern, ok := pattern.(string)
+			if !ok {
+				return nil, fmt.Errorf("'%s.%s' must be a list of strings", ExternalDependencies, externalDependenciesPatternsField)
+			}
+			rawPatterns = append(rawPatterns, stringPattern)
+		}
+	default:
+		return nil, fmt.Errorf("'%s.%s' must be a list of strings", ExternalDependencies, externalDependenciesPatternsField)
+	}
+	var patterns []string
+	for _, pattern := range rawPatterns {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns, nil
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandExternalDependencies(t *testing.T) {
+	tests := []struct {
+		name          string
+		repoConfigMap map[string]interface{}
+		expected      map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "Virtual npm",
+			repoConfigMap: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm,
+				ExternalDependencies: map[string]interface{}{"enabled": true, "patterns": []interface{}{"**/github.com/**", " **/gitlab.com/** "}, "remoteRepo": "npm-remote"}},
+			expected: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm,
+				ExternalDependenciesEnabled: "true", ExternalDependenciesPatterns: "**/github.com/**,**/gitlab.com/**", ExternalDependenciesRemoteRepo: "npm-remote"},
+		},
+		{
+			name: "Enabled by default",
+			repoConfigMap: map[string]interface{}{Key: "bower-virtual", Rclass: Virtual, PackageType: Bower,
+				ExternalDependencies: map[string]interface{}{"patterns": "**/github.com/**", "remoteRepo": "bower-remote"}},
+			expected: map[string]interface{}{Key: "bower-virtual", Rclass: Virtual, PackageType: Bower,
+				ExternalDependenciesEnabled: "true", ExternalDependenciesPatterns: "**/github.com/**", ExternalDependenciesRemoteRepo: "bower-remote"},
+		},
+		{
+			name: "Remote Docker without remote repository",
+			repoConfigMap: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker,
+				ExternalDependencies: map[string]interface{}{"enabled": "true", "patterns": []interface{}{"**"}}},
+			expected: map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker,
+				ExternalDependenciesEnabled: "true", ExternalDependenciesPatterns: "**"},
+		},
+		{
+			name: "Disabled",
+			repoConfigMap: map[string]interface{}{Key: "go-virtual", Rclass: Virtual, PackageType: Go,
+				ExternalDependencies: map[string]interface{}{"enabled": false}},
+			expected: map[string]interface{}{Key: "go-virtual", Rclass: Virtual, PackageType: Go, ExternalDependenciesEnabled: "false"},
+		},
+		{
+			name: "Enabled without remote repository",
+			repoConfigMap: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm,
+				ExternalDependencies: map[string]interface{}{"enabled": true, "patterns": []interface{}{"**"}}},
+			expectedError: "repository 'npm-virtual': 'externalDependencies.remoteRepo' must be set when external dependencies are enabled",
+		},
+		{
+			name: "Enabled without patterns",
+			repoConfigMap: map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm,
+				ExternalDependencies: map[string]interface{}{"enabled": true, "patterns": []interface{}{" "}, "remoteRepo": "npm-remote"}},
+			expectedError: "'externalDependencies.patterns' must list at least one pattern",
+		},
+		{
+			name: "Remote repository on Go",
+			repoConfigMap: map[string]interface{}{Key: "go-virtual", Rclass: Virtual, PackageType: Go,
+				ExternalDependencies: map[string]interface{}{"patterns": []interface{}{"**"}, "remoteRepo": "go-remote"}},
+			expectedError: "'externalDependencies.remoteRepo' is supported only by virtual npm and Bower repositories",
+		},
+		{
+			name: "Unsupported repository",
+			repoConfigMap: map[string]interface{}{Key: "npm-local", Rclass: Local, PackageType: Npm,
+				ExternalDependencies: map[string]interface{}{"patterns": []interface{}{"**"}}},
+			expectedError: "'externalDependencies' is supported only by remote Docker and virtual npm, Bower and Go repositories",
+		},
+		{
+			name: "Conflicting flat field",
+			repoConfigMap: map[string]interface{}{Key: "go-virtual", Rclass: Virtual, PackageType: Go, ExternalDependenciesPatterns: "**",
+				ExternalDependencies: map[string]interface{}{"patterns": []interface{}{"**"}}},
+			expectedError: "'externalDependenciesPatterns' can't be set together with the 'externalDependencies' section",
+		},
+		{
+			name: "Unknown field",
+			repoConfigMap: map[string]interface{}{Key: "go-virtual", Rclass: Virtual, PackageType: Go,
+				ExternalDependencies: map[string]interface{}{"patterns": []interface{}{"**"}, "remote": "go-remote"}},
+			expectedError: "unknown fields in 'externalDependencies': remote",
+		},
+		{
+			name: "Invalid enabled value",
+			repoConfigMap: map[string]interface{}{Key: "go-virtual", Rclass: Virtual, PackageType: Go,
+				ExternalDependencies: map[string]interface{}{"enabled": "yes please", "patterns": []interface{}{"**"}}},
+			expectedError: "'externalDependencies.enabled' must be a boolean, got 'yes please'",
+		},
+		{
+			name:          "Not an object",
+			repoConfigMap: map[string]interface{}{Key: "go-virtual", Rclass: Virtual, PackageType: Go, ExternalDependencies: "**"},
+			expectedError: "'externalDependencies' must be an object",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := expandExternalDependencies([]map[string]interface{}{tt.repoConfigMap})
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tt.repoConfigMap)
+		})
+	}
+}
+
+func Test_PerformRepoCmd_ExternalDependencies(t *testing.T) {
+	var actual map[string]interface{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestLicense(t, w, r) {
+			return
+		}
+		content, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(content, &actual))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	template := `{
+		"key": "npm-virtual",
+		"rclass": "virtual",
+		"packageType": "npm",
+		"externalDependencies": {"patterns": ["**/github.com/**"], "remoteRepo": "npm-remote"}
+	}`
+	repoCmd := &RepoCommand{
+		serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+		templatePath:  createTempTemplate(t, template),
+	}
+	require.NoError(t, repoCmd.PerformRepoCmd(false))
+	assert.Equal(t, true, actual[ExternalDependenciesEnabled])
+	assert.Equal(t, []interface{}{"**/github.com/**"}, actual[ExternalDependenciesPatterns])
+	assert.Equal(t, "npm-remote", actual[ExternalDependenciesRemoteRepo])
+	assert.NotContains(t, actual, ExternalDependencies)
+}
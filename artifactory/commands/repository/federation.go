@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/jfrog/gofrog/version"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	membersKey       = "members"
+	systemVersionApi = "api/system/version"
+	// Federated repositories were introduced in Artifactory 7.18.
+	minArtifactoryVersionForFederation = "7.18.0"
+)
+
+// federationMember is a member of a federated repository, as provided in the template.
+type federationMember struct {
+	repoKey string
+	url     string
+}
+
+// validateFederationMembers fails if the URL of a federated repository member is not a valid URL of an Artifactory repository,
+// i.e. an http(s) URL whose last path segment is the repository key.
+func validateFederationMembers(repoConfigMaps []map[string]interface{}) error {
+	var badMembers []string
+	for _, member := range getFederationMembers(repoConfigMaps, &badMembers) {
+		if _, err := parseMemberUrl(member.url); err != nil {
+			badMembers = append(badMembers, fmt.Sprintf("'%s' (member of repository '%s'): %s", member.url, member.repoKey, err.Error()))
+		}
+	}
+	if len(badMembers) > 0 {
+		return errorutils.CheckErrorf("the following federation members are invalid:\n%s", strings.Join(badMembers, "\n"))
+	}
+	return nil
+}
+
+// verifyFederationMembers fails if the Artifactory of a federated repository member is unreachable, or its version doesn't support
+// federated repositories. The member's Artifactory is accessed with the credentials of the server the repositories are created on.
+func verifyFederationMembers(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager) error {
+	var badMembers []string
+	// Members of several repositories often share the same Artifactory, which is checked once
+	checkedBaseUrls := make(map[string]string)
+	for _, member := range getFederationMembers(repoConfigMaps, &badMembers) {
+		memberUrl, err := parseMemberUrl(member.url)
+		if err != nil {
+			badMembers = append(badMembers, fmt.Sprintf("'%s' (member of repository '%s'): %s", member.url, member.repoKey, err.Error()))
+			continue
+		}
+		baseUrl := getMemberArtifactoryUrl(memberUrl)
+		problem, checked := checkedBaseUrls[baseUrl]
+		if !checked {
+			problem = checkMemberArtifactory(baseUrl, servicesManager)
+			checkedBaseUrls[baseUrl] = problem
+		}
+		if problem != "" {
+			badMembers = append(badMembers, fmt.Sprintf("'%s' (member of repository '%s'): %s", member.url, member.repoKey, problem))
+		}
+	}
+	if len(badMembers) > 0 {
+		return errorutils.CheckErrorf("the following federation members could not be verified:\n%s", strings.Join(badMembers, "\n"))
+	}
+	return nil
+}
+
+// getFederationMembers returns the members of the federated repositories. Members which are not provided as objects with a URL
+// are added to badMembers.
+func getFederationMembers(repoConfigMaps []map[string]interface{}, badMembers *[]string) (members []federationMember) {
+	for _, repoConfigMap := range repoConfigMaps {
+		if repoConfigMap[Rclass] != Federated {
+			continue
+		}
+		value, ok := repoConfigMap[membersKey]
+		if !ok {
+			continue
+		}
+		repoKey := fmt.Sprint(repoConfigMap[Key])
+		membersList, ok := value.([]interface{})
+		if !ok {
+			*badMembers = append(*badMembers, fmt.Sprintf("repository '%s': '%s' must be a list of members", repoKey, membersKey))
+			continue
+		}
+		for i, memberValue := range membersList {
+			member, ok := memberValue.(map[string]interface{})
+			memberUrl, hasUrl := member["url"].(string)
+			if !ok || !hasUrl || memberUrl == "" {
+				*badMembers = append(*badMembers, fmt.Sprintf("repository '%s': member %d must be an object with a 'url'", repoKey, i+1))
+				continue
+			}
+			members = append(members, federationMember{repoKey: repoKey, url: memberUrl})
+		}
+	}
+	return
+}
+
+func parseMemberUrl(memberUrl string) (*url.URL, error) {
+	parsedUrl, err := url.Parse(memberUrl)
+	if err != nil {
+		return nil, err
+	}
+	if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+		return nil, fmt.Errorf("the URL scheme must be http or https")
+	}
+	if parsedUrl.Host == "" {
+		return nil, fmt.Errorf("the URL has no host")
+	}
+	if strings.Trim(parsedUrl.Path, "/") == "" {
+		return nil, fmt.Errorf("the URL must end with the repository key, e.g. 'https://acme.jfrog.io/artifactory/<repository key>'")
+	}
+	return parsedUrl, nil
+}
+
+// getMemberArtifactoryUrl returns the URL of the Artifactory of a member, which is the member URL without the repository key.
+func getMemberArtifactoryUrl(memberUrl *url.URL) string {
+	artifactoryUrl := *memberUrl
+	artifactoryUrl.Path = path.Dir(strings.TrimSuffix(memberUrl.Path, "/"))
+	artifactoryUrl.RawQuery = ""
+	artifactoryUrl.Fragment = ""
+	return strings.TrimSuffix(artifactoryUrl.String(), "/") + "/"
+}
+
+// checkMemberArtifactory returns a description of the problem with the Artifactory at baseUrl, or an empty string if it is compatible.
+func checkMemberArtifactory(baseUrl string, servicesManager artifactory.ArtifactoryServicesManager) string {
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(baseUrl+systemVersionApi, true, &httpClientDetails)
+	if err != nil {
+		return fmt.Sprintf("the member is unreachable: %s", err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Sprintf("the member's Artifactory at '%s' responded with status %s", baseUrl, resp.Status)
+	}
+	versionResponse := struct {
+		Version string `json:"version"`
+	}{}
+	if err = json.Unmarshal(body, &versionResponse); err != nil || versionResponse.Version == "" {
+		return fmt.Sprintf("'%s' does not point to an Artifactory", baseUrl)
+	}
+	if !version.NewVersion(versionResponse.Version).AtLeast(minArtifactoryVersionForFederation) {
+		return fmt.Sprintf("the member's Artifactory version %s doesn't support federated repositories, version %s or above is required", versionResponse.Version, minArtifactoryVersionForFederation)
+	}
+	return ""
+}
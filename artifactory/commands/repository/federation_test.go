@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func federatedRepoConfig(key string, members interface{}) map[string]interface{} {
+	return map[string]interface{}{Key: key, Rclass: Federated, PackageType: Generic, membersKey: members}
+}
+
+func Test_ValidateFederationMembers(t *testing.T) {
+	tests := []struct {
+		name           string
+		repoConfigMaps []map[string]interface{}
+		expectedErrors []string
+	}{
+		{
+			name: "Valid members",
+			repoConfigMaps: []map[string]interface{}{
+				federatedRepoConfig("generic-fed", []interface{}{
+					map[string]interface{}{"url": "https://acme.jfrog.io/artifactory/generic-fed", "enabled": true},
+					map[string]interface{}{"url": "http://localhost:8082/artifactory/generic-fed/"},
+				}),
+				// Members of non federated repositories are ignored
+				{Key: "generic-local", Rclass: Local, membersKey: "not a list"},
+			},
+		},
+		{
+			name: "Invalid members",
+			repoConfigMaps: []map[string]interface{}{
+				federatedRepoConfig("generic-fed", []interface{}{
+					map[string]interface{}{"url": "ftp://acme.jfrog.io/artifactory/generic-fed"},
+					map[string]interface{}{"url": "https:///artifactory/generic-fed"},
+					map[string]interface{}{"url": "https://acme.jfrog.io/"},
+					map[string]interface{}{"enabled": true},
+					"https://acme.jfrog.io/artifactory/generic-fed",
+				}),
+				federatedRepoConfig("npm-fed", "https://acme.jfrog.io/artifactory/npm-fed"),
+			},
+			expectedErrors: []string{
+				"repository 'generic-fed': member 4 must be an object with a 'url'",
+				"repository 'generic-fed': member 5 must be an object with a 'url'",
+				"repository 'npm-fed': 'members' must be a list of members",
+				"'ftp://acme.jfrog.io/artifactory/generic-fed' (member of repository 'generic-fed'): the URL scheme must be http or https",
+				"'https:///artifactory/generic-fed' (member of repository 'generic-fed'): the URL has no host",
+				"'https://acme.jfrog.io/' (member of repository 'generic-fed'): the URL must end with the repository key",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateFederationMembers(tt.repoConfigMaps)
+			if len(tt.expectedErrors) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, expectedError := range tt.expectedErrors {
+				assert.ErrorContains(t, err, expectedError)
+			}
+		})
+	}
+}
+
+func Test_VerifyFederationMembers(t *testing.T) {
+	versionRequests := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/artifactory/api/system/version":
+			versionRequests++
+			_, _ = w.Write([]byte(`{"version": "7.111.4"}`))
+		case "/old/api/system/version":
+			_, _ = w.Write([]byte(`{"version": "7.17.5"}`))
+		case "/other/api/system/version":
+			_, _ = w.Write([]byte(`<html></html>`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/artifactory/"}, -1, 0, false)
+	require.NoError(t, err)
+
+	t.Run("Compatible members", func(t *testing.T) {
+		repoConfigMaps := []map[string]interface{}{
+			federatedRepoConfig("generic-fed", []interface{}{map[string]interface{}{"url": testServer.URL + "/artifactory/generic-fed"}}),
+			federatedRepoConfig("npm-fed", []interface{}{map[string]interface{}{"url": testServer.URL + "/artifactory/npm-fed"}}),
+		}
+		assert.NoError(t, verifyFederationMembers(repoConfigMaps, servicesManager))
+		// The members share the same Artifactory, which is checked once
+		assert.Equal(t, 1, versionRequests)
+	})
+
+	t.Run("Incompatible members", func(t *testing.T) {
+		repoConfigMaps := []map[string]interface{}{
+			federatedRepoConfig("generic-fed", []interface{}{
+				map[string]interface{}{"url": testServer.URL + "/old/generic-fed"},
+				map[string]interface{}{"url": testServer.URL + "/other/generic-fed"},
+				map[string]interface{}{"url": testServer.URL + "/missing/generic-fed"},
+			}),
+		}
+		err := verifyFederationMembers(repoConfigMaps, servicesManager)
+		assert.ErrorContains(t, err, "the member's Artifactory version 7.17.5 doesn't support federated repositories")
+		assert.ErrorContains(t, err, "'"+testServer.URL+"/other/' does not point to an Artifactory")
+		assert.ErrorContains(t, err, "'"+testServer.URL+"/missing/generic-fed' (member of repository 'generic-fed'): the member's Artifactory at '"+testServer.URL+"/missing/' responded with status 404")
+	})
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	yamlTemplateFormat = "yaml"
+	jsonTemplateFormat = "json"
+)
+
+// Guidance for the value of keys which the value type doesn't describe well enough.
+var templateKeysGuidance = map[string]string{
+	ContentSynchronisation: "comma-separated true/false values of enabled, statistics, properties and source origin absence detection",
+	MaxUniqueSnapshots:     "non-negative integer, 0 means unlimited",
+	MaxUniqueTags:          "non-negative integer, 0 means unlimited",
+}
+
+// RepoTemplateGenerateCommand prints a skeleton repository template for an rclass and package type, with all the keys
+// supported for them. The keys are derived from the same maps used by the repo-template questionnaire and the repo-create
+// and repo-update commands.
+type RepoTemplateGenerateCommand struct {
+	rclass      string
+	packageType string
+	format      string
+}
+
+func NewRepoTemplateGenerateCommand() *RepoTemplateGenerateCommand {
+	return &RepoTemplateGenerateCommand{}
+}
+
+func (rtgc *RepoTemplateGenerateCommand) SetRclass(rclass string) *RepoTemplateGenerateCommand {
+	rtgc.rclass = rclass
+	return rtgc
+}
+
+func (rtgc *RepoTemplateGenerateCommand) SetPackageType(packageType string) *RepoTemplateGenerateCommand {
+	rtgc.packageType = packageType
+	return rtgc
+}
+
+func (rtgc *RepoTemplateGenerateCommand) SetFormat(format string) *RepoTemplateGenerateCommand {
+	rtgc.format = format
+	return rtgc
+}
+
+func (rtgc *RepoTemplateGenerateCommand) ServerDetails() (*config.ServerDetails, error) {
+	// Since it's a local command, usage won't be reported.
+	return nil, nil
+}
+
+func (rtgc *RepoTemplateGenerateCommand) CommandName() string {
+	return "rt_repo_template_generate"
+}
+
+func (rtgc *RepoTemplateGenerateCommand) Run() error {
+	content, err := GenerateRepoTemplate(rtgc.rclass, rtgc.packageType, rtgc.format)
+	if err != nil {
+		return err
+	}
+	fmt.Print(content)
+	return nil
+}
+
+// GenerateRepoTemplate returns a skeleton template for an rclass and package type in YAML or JSON format.
+// The mandatory keys are set, and the optional keys are commented out in YAML, or set to empty values in JSON, which has
+// no comments. All the values are strings, as in the templates written by the repo-template command.
+func GenerateRepoTemplate(rclass, packageType, format string) (string, error) {
+	if _, err := getRepoHandler(rclass, packageType); err != nil {
+		return "", err
+	}
+	rclass, _ = normalizeRclass(rclass)
+	packageType = normalizePackageType(rclass, packageType)
+	mandatoryKeys := []string{Key, Rclass, PackageType}
+	values := map[string]string{Key: packageType + "-" + rclass, Rclass: rclass, PackageType: packageType}
+	if rclass == Remote {
+		mandatoryKeys = append(mandatoryKeys, Url)
+		values[Url] = ""
+	}
+	optionalKeys := getRclassTemplateKeys(rclass, packageType, Create)
+
+	switch format {
+	case "", yamlTemplateFormat:
+		return generateYamlTemplate(rclass, packageType, mandatoryKeys, optionalKeys, values), nil
+	case jsonTemplateFormat:
+		templateMap := make(map[string]interface{}, len(mandatoryKeys)+len(optionalKeys))
+		for _, key := range mandatoryKeys {
+			templateMap[key] = values[key]
+		}
+		for _, key := range optionalKeys {
+			templateMap[key] = ""
+		}
+		content, err := marshalCanonicalJson(templateMap, "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(content) + "\n", nil
+	default:
+		return "", errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'yaml', 'json'", format)
+	}
+}
+
+func generateYamlTemplate(rclass, packageType string, mandatoryKeys, optionalKeys []string, values map[string]string) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("# Template of a %s %s repository, for the repo-create and repo-update commands.\n", rclass, packageType))
+	builder.WriteString("# All the values are strings. Uncomment the optional keys you need and set their values.\n")
+	builder.WriteString("\n# Mandatory keys\n")
+	for _, key := range mandatoryKeys {
+		builder.WriteString(fmt.Sprintf("%s: %s\n", key, strconv.Quote(values[key])))
+	}
+	if len(optionalKeys) == 0 {
+		return builder.String()
+	}
+	builder.WriteString("\n# Optional keys\n")
+	for _, key := range optionalKeys {
+		builder.WriteString(fmt.Sprintf("# %s: \"\"  # %s\n", key, getTemplateKeyGuidance(key)))
+	}
+	return builder.String()
+}
+
+// getTemplateKeyGuidance describes the expected value of a template key.
+func getTemplateKeyGuidance(key string) string {
+	if guidance, ok := templateKeysGuidance[key]; ok {
+		return guidance
+	}
+	switch getWriterValueType(writersMap[key]) {
+	case boolValueType:
+		return "true or false"
+	case intValueType:
+		return "integer"
+	case stringArrayValueType:
+		return "comma-separated list"
+	}
+	if options := questionMap[key].Options; len(options) > 0 {
+		return "one of: " + strings.Join(suggestsToKeys(options), ", ")
+	}
+	return "string"
+}
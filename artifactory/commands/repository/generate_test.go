@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func Test_GenerateRepoTemplate_Yaml(t *testing.T) {
+	content, err := GenerateRepoTemplate("Remote", "NPM", "")
+	require.NoError(t, err)
+	assert.Contains(t, content, "\nkey: \"npm-remote\"\nrclass: \"remote\"\npackageType: \"npm\"\nurl: \"\"\n")
+	assert.Contains(t, content, "\n# description: \"\"  # string\n")
+	assert.Contains(t, content, "\n# blackedOut: \"\"  # true or false\n")
+	assert.Contains(t, content, "\n# contentSynchronisation: \"\"  # comma-separated true/false values")
+
+	// The optional keys are commented out, so only the mandatory keys are parsed.
+	var template map[string]interface{}
+	require.NoError(t, yaml.Unmarshal([]byte(content), &template))
+	assert.Equal(t, map[string]interface{}{Key: "npm-remote", Rclass: Remote, PackageType: Npm, Url: ""}, template)
+}
+
+func Test_GenerateRepoTemplate_Json(t *testing.T) {
+	content, err := GenerateRepoTemplate(Virtual, Docker, jsonTemplateFormat)
+	require.NoError(t, err)
+	var template map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(content), &template))
+	assert.Equal(t, "docker-virtual", template[Key])
+	assert.Equal(t, Virtual, template[Rclass])
+	assert.Equal(t, Docker, template[PackageType])
+	assert.Equal(t, "", template[Repositories])
+	assert.Equal(t, "", template[PrimaryKeyPairRef])
+	assert.NotContains(t, template, Url)
+	for key, value := range template {
+		assert.IsType(t, "", value, key)
+		assert.Contains(t, writersMap, key)
+	}
+}
+
+func Test_GenerateRepoTemplate_Errors(t *testing.T) {
+	_, err := GenerateRepoTemplate("internal", Npm, "")
+	assert.ErrorContains(t, err, "unsupported rclass: internal")
+	_, err = GenerateRepoTemplate(Local, "unknown", "")
+	assert.ErrorContains(t, err, "unsupported package type: unknown")
+	_, err = GenerateRepoTemplate(Local, Npm, "table")
+	assert.EqualError(t, err, "unsupported format 'table'. Supported formats: 'yaml', 'json'")
+}
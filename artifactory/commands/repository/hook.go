@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// Environment variables through which a command hook receives the repository key and the action taken on it.
+	repoHookKeyEnv    = "JFROG_CLI_REPO_KEY"
+	repoHookActionEnv = "JFROG_CLI_REPO_ACTION"
+
+	repoHookTimeout = 30 * time.Second
+)
+
+// repoHook notifies an external system, such as a CMDB or a chat, after each repository is created or updated. The
+// target is either an HTTP(S) URL, to which the repository key and the action are posted as JSON, or a shell command,
+// which receives them in the JFROG_CLI_REPO_KEY and JFROG_CLI_REPO_ACTION environment variables.
+type repoHook struct {
+	target string
+	// When set, a failed hook fails the command. Otherwise, it is reported as a warning.
+	failOnError bool
+	httpClient  *http.Client
+}
+
+type repoHookPayload struct {
+	RepoKey string `json:"repoKey"`
+	Action  string `json:"action"`
+}
+
+func newRepoHook(target string, failOnError bool) *repoHook {
+	if target == "" {
+		return nil
+	}
+	return &repoHook{target: target, failOnError: failOnError, httpClient: &http.Client{Timeout: repoHookTimeout}}
+}
+
+func (h *repoHook) isHttp() bool {
+	return strings.HasPrefix(h.target, "http://") || strings.HasPrefix(h.target, "https://")
+}
+
+// notify invokes the hook for each of the repositories. A nil hook does nothing.
+func (h *repoHook) notify(action string, repoConfigMaps ...map[string]interface{}) error {
+	if h == nil {
+		return nil
+	}
+	for _, repoConfigMap := range repoConfigMaps {
+		repoKey := fmt.Sprint(repoConfigMap[Key])
+		var err error
+		if h.isHttp() {
+			err = h.post(repoKey, action)
+		} else {
+			err = h.run(repoKey, action)
+		}
+		if err == nil {
+			continue
+		}
+		if h.failOnError {
+			return errorutils.CheckErrorf("the hook of repository '%s' failed: %s", repoKey, err.Error())
+		}
+		log.Warn(fmt.Sprintf("The hook of repository '%s' failed: %s", repoKey, err.Error()))
+	}
+	return nil
+}
+
+func (h *repoHook) post(repoKey, action string) error {
+	body, err := json.Marshal(repoHookPayload{RepoKey: repoKey, Action: action})
+	if err != nil {
+		return err
+	}
+	log.Debug("Posting the", action, "action of repository", repoKey, "to:", h.target)
+	resp, err := h.httpClient.Post(h.target, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%s responded with status %s", h.target, resp.Status)
+	}
+	return nil
+}
+
+func (h *repoHook) run(repoKey, action string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", h.target)
+	} else {
+		cmd = exec.Command("sh", "-c", h.target)
+	}
+	cmd.Env = append(os.Environ(), repoHookKeyEnv+"="+repoKey, repoHookActionEnv+"="+action)
+	log.Debug("Running the hook of repository", repoKey+":", h.target)
+	output, err := cmd.CombinedOutput()
+	if len(output) > 0 {
+		log.Debug(string(output))
+	}
+	if err != nil {
+		if trimmed := strings.TrimSpace(string(output)); trimmed != "" {
+			return fmt.Errorf("%w: %s", err, trimmed)
+		}
+		return err
+	}
+	return nil
+}
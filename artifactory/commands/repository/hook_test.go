@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoHookHttp(t *testing.T) {
+	var payloads []repoHookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload repoHookPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+		if payload.RepoKey == "failing-repo" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	hook := newRepoHook(server.URL, false)
+	assert.NoError(t, hook.notify(repoActionCreated, map[string]interface{}{Key: "npm-local"}, map[string]interface{}{Key: "maven-local"}))
+	assert.Equal(t, []repoHookPayload{{RepoKey: "npm-local", Action: repoActionCreated}, {RepoKey: "maven-local", Action: repoActionCreated}}, payloads)
+
+	// A failed hook is only reported as a warning by default.
+	assert.NoError(t, hook.notify(repoActionUpdated, map[string]interface{}{Key: "failing-repo"}))
+
+	hook = newRepoHook(server.URL, true)
+	assert.ErrorContains(t, hook.notify(repoActionUpdated, map[string]interface{}{Key: "failing-repo"}), "500")
+}
+
+func TestRepoHookCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The hook command is written for a POSIX shell")
+	}
+	outputPath := filepath.Join(t.TempDir(), "hook.out")
+	hook := newRepoHook("echo \"$"+repoHookKeyEnv+" $"+repoHookActionEnv+"\" >> "+outputPath, true)
+	require.NoError(t, hook.notify(repoActionCreated, map[string]interface{}{Key: "npm-local"}))
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, "npm-local created\n", string(content))
+
+	hook = newRepoHook("exit 3", true)
+	assert.ErrorContains(t, hook.notify(repoActionCreated, map[string]interface{}{Key: "npm-local"}), "exit status 3")
+}
+
+func TestRepoHookDisabled(t *testing.T) {
+	hook := newRepoHook("", true)
+	assert.Nil(t, hook)
+	assert.NoError(t, hook.notify(repoActionCreated, map[string]interface{}{Key: "npm-local"}))
+}
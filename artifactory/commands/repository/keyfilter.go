@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Keys patterns with this prefix are regular expressions. Other patterns are globs, using the * and ? wildcards.
+const regexKeysPatternPrefix = "regex:"
+
+// newRepoKeyMatcher returns a function reporting whether a repository key matches the pattern.
+// A regular expression must match the whole key.
+func newRepoKeyMatcher(pattern string) (func(repoKey string) bool, error) {
+	if expression, isRegex := strings.CutPrefix(pattern, regexKeysPatternPrefix); isRegex {
+		keyRegex, err := regexp.Compile("^(?:" + expression + ")$")
+		if err != nil {
+			return nil, errorutils.CheckErrorf("invalid repository keys regular expression '%s': %s", expression, err.Error())
+		}
+		return keyRegex.MatchString, nil
+	}
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, errorutils.CheckErrorf("invalid repository keys pattern '%s': %s", pattern, err.Error())
+	}
+	return func(repoKey string) bool {
+		matched, _ := filepath.Match(pattern, repoKey)
+		return matched
+	}, nil
+}
+
+// isRepoKeysPattern reports whether the pattern may match several repository keys, rather than being a single key.
+func isRepoKeysPattern(pattern string) bool {
+	return strings.HasPrefix(pattern, regexKeysPatternPrefix) || strings.Contains(pattern, "*")
+}
+
+// getMatchingRepoKeys returns the sorted keys of the repositories matching any of the patterns. Keys which aren't patterns
+// are returned as is, so that a missing repository fails the command.
+func getMatchingRepoKeys(servicesManager artifactory.ArtifactoryServicesManager, repoPatterns []string) ([]string, error) {
+	var repoKeys []string
+	var matchers []func(repoKey string) bool
+	for _, repoPattern := range repoPatterns {
+		repoPattern = strings.TrimSpace(repoPattern)
+		switch {
+		case repoPattern == "":
+			continue
+		case isRepoKeysPattern(repoPattern):
+			matchesKey, err := newRepoKeyMatcher(repoPattern)
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, matchesKey)
+		case !slices.Contains(repoKeys, repoPattern):
+			repoKeys = append(repoKeys, repoPattern)
+		}
+	}
+	if len(matchers) > 0 {
+		repos, err := servicesManager.GetAllRepositories()
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range *repos {
+			if slices.Contains(repoKeys, repo.Key) {
+				continue
+			}
+			if slices.ContainsFunc(matchers, func(matchesKey func(string) bool) bool { return matchesKey(repo.Key) }) {
+				repoKeys = append(repoKeys, repo.Key)
+			}
+		}
+	}
+	sort.Strings(repoKeys)
+	return repoKeys, nil
+}
+
+// filterRepoConfigsBatches keeps only the repository configurations whose key matches, dropping batches which become empty.
+func filterRepoConfigsBatches(batches []repoConfigsBatch, matchesKey func(repoKey string) bool) []repoConfigsBatch {
+	var filteredBatches []repoConfigsBatch
+	total, filteredOut := 0, 0
+	for _, batch := range batches {
+		var repoConfigMaps []map[string]interface{}
+		for _, repoConfigMap := range batch.repoConfigMaps {
+			total++
+			if !matchesKey(fmt.Sprint(repoConfigMap[Key])) {
+				filteredOut++
+				continue
+			}
+			repoConfigMaps = append(repoConfigMaps, repoConfigMap)
+		}
+		if len(repoConfigMaps) > 0 {
+			filteredBatches = append(filteredBatches, repoConfigsBatch{repoConfigMaps: repoConfigMaps, strategy: batch.strategy})
+		}
+	}
+	log.Info(fmt.Sprintf("%d of %d repositories were filtered out, as their keys don't match the include keys pattern.", filteredOut, total))
+	return filteredBatches
+}
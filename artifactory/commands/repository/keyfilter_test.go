@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewRepoKeyMatcher(t *testing.T) {
+	tests := []struct {
+		name          string
+		pattern       string
+		matching      []string
+		notMatching   []string
+		expectedError string
+	}{
+		{name: "Key", pattern: "npm-local", matching: []string{"npm-local"}, notMatching: []string{"npm-local-2"}},
+		{name: "Glob", pattern: "npm-*", matching: []string{"npm-local", "npm-remote"}, notMatching: []string{"maven-local"}},
+		{name: "Regex", pattern: "regex:(npm|maven)-.*", matching: []string{"npm-local", "maven-remote"}, notMatching: []string{"go-local", "my-npm-local"}},
+		{name: "Invalid glob", pattern: "npm-[", expectedError: "invalid repository keys pattern 'npm-['"},
+		{name: "Invalid regex", pattern: "regex:npm-(", expectedError: "invalid repository keys regular expression 'npm-('"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matchesKey, err := newRepoKeyMatcher(tt.pattern)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			for _, repoKey := range tt.matching {
+				assert.True(t, matchesKey(repoKey), repoKey)
+			}
+			for _, repoKey := range tt.notMatching {
+				assert.False(t, matchesKey(repoKey), repoKey)
+			}
+		})
+	}
+}
+
+func Test_FilterRepoConfigsBatches(t *testing.T) {
+	multipleHandler := &MultipleRepositoryHandler{}
+	singleHandler := &SingleRepositoryHandler{}
+	batches := []repoConfigsBatch{
+		{repoConfigMaps: []map[string]interface{}{{Key: "npm-local"}, {Key: "maven-local"}, {Key: "npm-remote"}}, strategy: multipleHandler},
+		{repoConfigMaps: []map[string]interface{}{{Key: "maven-virtual"}}, strategy: singleHandler},
+	}
+	matchesKey, err := newRepoKeyMatcher("npm-*")
+	require.NoError(t, err)
+
+	filteredBatches := filterRepoConfigsBatches(batches, matchesKey)
+	// Batches left without repositories are dropped
+	require.Len(t, filteredBatches, 1)
+	assert.Equal(t, []map[string]interface{}{{Key: "npm-local"}, {Key: "npm-remote"}}, filteredBatches[0].repoConfigMaps)
+	assert.Same(t, multipleHandler, filteredBatches[0].strategy)
+}
+
+func Test_PerformRepoCmdInvalidIncludeKeys(t *testing.T) {
+	rc := &RepoCommand{templatePath: createTempTemplate(t, `{"key": "npm-local", "rclass": "local", "packageType": "npm"}`), includeKeys: "regex:["}
+	assert.ErrorContains(t, rc.PerformRepoCmd(false), "invalid repository keys regular expression")
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/jfrog/gofrog/version"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The template section holding the labels of a repository. Artifactory has no labels in the repository configuration, so
+	// the section is removed from the configuration, and the labels are set as properties on the repository root once the
+	// repository is created or updated.
+	Labels = "labels"
+	// Setting properties on the root of a repository, rather than on an item in it, requires Artifactory 7.
+	minArtifactoryVersionForRepoLabels = "7.0.0"
+
+	storageApi          = "api/storage/"
+	maxLabelKeyLength   = 255
+	maxLabelValueLength = 2400
+)
+
+var labelKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:-]*$`)
+
+// extractRepoLabels removes the labels section from the repository configurations, and returns the validated labels of each
+// repository by its key.
+func extractRepoLabels(repoConfigMaps []map[string]interface{}) (map[string]map[string]string, error) {
+	repoLabels := make(map[string]map[string]string)
+	for _, repoConfigMap := range repoConfigMaps {
+		value, ok := repoConfigMap[Labels]
+		if !ok {
+			continue
+		}
+		delete(repoConfigMap, Labels)
+		repoKey := fmt.Sprint(repoConfigMap[Key])
+		labelsMap, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, errorutils.CheckErrorf("repository '%s': '%s' must be an object mapping label keys to values", repoKey, Labels)
+		}
+		labels := make(map[string]string, len(labelsMap))
+		for labelKey, labelValue := range labelsMap {
+			stringValue, ok := labelValue.(string)
+			if !ok {
+				return nil, errorutils.CheckErrorf("repository '%s': the value of label '%s' must be a string", repoKey, labelKey)
+			}
+			if err := validateLabel(labelKey, stringValue); err != nil {
+				return nil, errorutils.CheckErrorf("repository '%s': %s", repoKey, err.Error())
+			}
+			labels[labelKey] = stringValue
+		}
+		if len(labels) > 0 {
+			repoLabels[repoKey] = labels
+		}
+	}
+	return repoLabels, nil
+}
+
+// validateLabel checks a label against the constraints Artifactory puts on property keys and values.
+func validateLabel(labelKey, labelValue string) error {
+	if len(labelKey) > maxLabelKeyLength || !labelKeyRegexp.MatchString(labelKey) {
+		return fmt.Errorf("invalid label key '%s': keys must be up to %d characters, start with a letter or a digit, and contain only letters, digits, '.', '_', ':' and '-'", labelKey, maxLabelKeyLength)
+	}
+	if len(labelValue) > maxLabelValueLength {
+		return fmt.Errorf("invalid value for label '%s': values must be up to %d characters", labelKey, maxLabelValueLength)
+	}
+	if strings.IndexFunc(labelValue, unicode.IsControl) != -1 {
+		return fmt.Errorf("invalid value for label '%s': values must not contain control characters", labelKey)
+	}
+	return nil
+}
+
+func validateRepoLabelsSupported(servicesManager artifactory.ArtifactoryServicesManager) error {
+	artifactoryVersion, err := servicesManager.GetVersion()
+	if err != nil {
+		return errorutils.CheckErrorf("failed to get the Artifactory version: %s", err.Error())
+	}
+	if !version.NewVersion(artifactoryVersion).AtLeast(minArtifactoryVersionForRepoLabels) {
+		return errorutils.CheckErrorf("repository labels require Artifactory %s or above, while the Artifactory version is %s. Remove the '%s' sections from the template", minArtifactoryVersionForRepoLabels, artifactoryVersion, Labels)
+	}
+	return nil
+}
+
+// applyRepoLabels sets the labels of each repository as properties on its root. Properties with other keys are kept.
+func applyRepoLabels(servicesManager artifactory.ArtifactoryServicesManager, repoLabels map[string]map[string]string) error {
+	rtDetails := servicesManager.GetConfig().GetServiceDetails()
+	repoKeys := make([]string, 0, len(repoLabels))
+	for repoKey := range repoLabels {
+		repoKeys = append(repoKeys, repoKey)
+	}
+	sort.Strings(repoKeys)
+	for _, repoKey := range repoKeys {
+		httpClientDetails := rtDetails.CreateHttpClientDetails()
+		requestUrl := rtDetails.GetUrl() + storageApi + url.PathEscape(repoKey) + "?recursive=0&properties=" + url.QueryEscape(formatLabelsAsProperties(repoLabels[repoKey]))
+		resp, body, err := servicesManager.Client().SendPut(requestUrl, nil, &httpClientDetails)
+		if err != nil {
+			return errorutils.CheckErrorf("failed to set the labels of repository '%s': %s", repoKey, err.Error())
+		}
+		if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusNoContent, http.StatusOK); err != nil {
+			return errorutils.CheckErrorf("failed to set the labels of repository '%s': %s", repoKey, err.Error())
+		}
+		log.Info("Repository", repoKey, "- labels set:", strings.Join(sortedLabelKeys(repoLabels[repoKey]), ", "))
+	}
+	return nil
+}
+
+// formatLabelsAsProperties formats labels in the 'key1=value1;key2=value2' form of the properties API, escaping the characters
+// which separate properties and values.
+func formatLabelsAsProperties(labels map[string]string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, `,`, `\,`, `;`, `\;`, `=`, `\=`, `|`, `\|`)
+	properties := make([]string, 0, len(labels))
+	for _, labelKey := range sortedLabelKeys(labels) {
+		properties = append(properties, labelKey+"="+escaper.Replace(labels[labelKey]))
+	}
+	return strings.Join(properties, ";")
+}
+
+func sortedLabelKeys(labels map[string]string) []string {
+	labelKeys := make([]string, 0, len(labels))
+	for labelKey := range labels {
+		labelKeys = append(labelKeys, labelKey)
+	}
+	sort.Strings(labelKeys)
+	return labelKeys
+}
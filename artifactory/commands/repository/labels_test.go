@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractRepoLabels(t *testing.T) {
+	tests := []struct {
+		name          string
+		labels        interface{}
+		expected      map[string]map[string]string
+		errorContains string
+	}{
+		{
+			name:     "Valid labels",
+			labels:   map[string]interface{}{"team": "platform", "cost-center": "1234", "owner.email": "a@example.com"},
+			expected: map[string]map[string]string{"generic-local": {"team": "platform", "cost-center": "1234", "owner.email": "a@example.com"}},
+		},
+		{
+			name:     "Empty labels",
+			labels:   map[string]interface{}{},
+			expected: map[string]map[string]string{},
+		},
+		{name: "Labels not an object", labels: []interface{}{"team"}, errorContains: "'labels' must be an object"},
+		{name: "Non-string value", labels: map[string]interface{}{"tier": 1.0}, errorContains: "the value of label 'tier' must be a string"},
+		{name: "Invalid key characters", labels: map[string]interface{}{"team name": "platform"}, errorContains: "invalid label key 'team name'"},
+		{name: "Key starting with a separator", labels: map[string]interface{}{"-team": "platform"}, errorContains: "invalid label key '-team'"},
+		{name: "Key too long", labels: map[string]interface{}{strings.Repeat("a", maxLabelKeyLength+1): "x"}, errorContains: "invalid label key"},
+		{name: "Value too long", labels: map[string]interface{}{"team": strings.Repeat("a", maxLabelValueLength+1)}, errorContains: "invalid value for label 'team'"},
+		{name: "Control characters in value", labels: map[string]interface{}{"team": "plat\nform"}, errorContains: "must not contain control characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoConfigMap := map[string]interface{}{Key: "generic-local", Rclass: Local, Labels: tt.labels}
+			repoLabels, err := extractRepoLabels([]map[string]interface{}{repoConfigMap, {Key: "no-labels"}})
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, "repository 'generic-local'")
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, repoLabels)
+			assert.NotContains(t, repoConfigMap, Labels)
+		})
+	}
+}
+
+func TestFormatLabelsAsProperties(t *testing.T) {
+	labels := map[string]string{"team": "platform", "notes": `a=b;c,d|e\f`}
+	assert.Equal(t, `notes=a\=b\;c\,d\|e\\f;team=platform`, formatLabelsAsProperties(labels))
+}
+
+func Test_PerformRepoCmd_Labels(t *testing.T) {
+	tests := []struct {
+		name               string
+		artifactoryVersion string
+		expectedProperties string
+		errorContains      string
+	}{
+		{name: "Supported version", artifactoryVersion: "7.104.2", expectedProperties: "cost-center=1234;team=platform"},
+		{name: "Unsupported version", artifactoryVersion: "6.23.42", errorContains: "repository labels require Artifactory 7.0.0 or above, while the Artifactory version is 6.23.42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var createdRepo bool
+			var actualProperties string
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/system/version":
+					_, _ = w.Write([]byte(`{"version":"` + tt.artifactoryVersion + `"}`))
+				case r.Method == http.MethodPut && r.URL.Path == "/api/repositories/generic-local":
+					createdRepo = true
+					w.WriteHeader(http.StatusOK)
+				case r.Method == http.MethodPut && r.URL.Path == "/api/storage/generic-local":
+					actualProperties = r.URL.Query().Get("properties")
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			template := `{"key": "generic-local", "rclass": "local", "packageType": "generic", "labels": {"team": "platform", "cost-center": "1234"}}`
+			repoCmd := &RepoCommand{
+				serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+				templatePath:  createTempTemplate(t, template),
+			}
+			err := repoCmd.PerformRepoCmd(false)
+
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				assert.False(t, createdRepo)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, createdRepo)
+			assert.Equal(t, tt.expectedProperties, actualProperties)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package repository
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// RepoLayout is a repository layout, as defined in the Artifactory configuration descriptor.
+type RepoLayout struct {
+	Name                             string `xml:"name" json:"name"`
+	ArtifactPathPattern              string `xml:"artifactPathPattern" json:"artifactPathPattern"`
+	DistinctiveDescriptorPathPattern bool   `xml:"distinctiveDescriptorPathPattern" json:"distinctiveDescriptorPathPattern"`
+	DescriptorPathPattern            string `xml:"descriptorPathPattern" json:"descriptorPathPattern,omitempty"`
+	FolderIntegrationRevisionRegExp  string `xml:"folderIntegrationRevisionRegExp" json:"folderIntegrationRevisionRegExp"`
+	FileIntegrationRevisionRegExp    string `xml:"fileIntegrationRevisionRegExp" json:"fileIntegrationRevisionRegExp"`
+}
+
+// RepoLayoutCommand prints the definition of the layout a repository refers to. Layouts are read from the configuration
+// descriptor, which requires admin permissions.
+type RepoLayoutCommand struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+}
+
+func NewRepoLayoutCommand() *RepoLayoutCommand {
+	return &RepoLayoutCommand{}
+}
+
+func (rlc *RepoLayoutCommand) SetRepoKey(repoKey string) *RepoLayoutCommand {
+	rlc.repoKey = repoKey
+	return rlc
+}
+
+func (rlc *RepoLayoutCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoLayoutCommand {
+	rlc.serverDetails = serverDetails
+	return rlc
+}
+
+func (rlc *RepoLayoutCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rlc.serverDetails, nil
+}
+
+func (rlc *RepoLayoutCommand) CommandName() string {
+	return "rt_repo_layout"
+}
+
+func (rlc *RepoLayoutCommand) Run() error {
+	servicesManager, err := rtUtils.CreateServiceManager(rlc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repoConfigMap := make(map[string]interface{})
+	if err = servicesManager.GetRepository(rlc.repoKey, &repoConfigMap); err != nil {
+		return err
+	}
+	layoutName, _ := repoConfigMap[RepoLayoutRef].(string)
+	if layoutName == "" {
+		return errorutils.CheckErrorf("repository '%s' has no repository layout", rlc.repoKey)
+	}
+	configDescriptor, err := servicesManager.GetConfigDescriptor()
+	if err != nil {
+		return err
+	}
+	layout, err := findRepoLayout(configDescriptor, layoutName)
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(layout, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	fmt.Println(string(content))
+	return nil
+}
+
+// findRepoLayout returns the layout with the given name from the XML configuration descriptor.
+func findRepoLayout(configDescriptor, layoutName string) (*RepoLayout, error) {
+	layouts, err := parseRepoLayouts(configDescriptor)
+	if err != nil {
+		return nil, err
+	}
+	for i := range layouts {
+		if layouts[i].Name == layoutName {
+			return &layouts[i], nil
+		}
+	}
+	return nil, errorutils.CheckErrorf("the repository layout '%s' was not found in the Artifactory configuration", layoutName)
+}
+
+// parseRepoLayouts returns the layouts defined in the XML configuration descriptor.
+func parseRepoLayouts(configDescriptor string) ([]RepoLayout, error) {
+	descriptor := struct {
+		RepoLayouts []RepoLayout `xml:"repoLayouts>repoLayout"`
+	}{}
+	if err := xml.Unmarshal([]byte(configDescriptor), &descriptor); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the Artifactory configuration descriptor: %s", err.Error())
+	}
+	return descriptor.RepoLayouts, nil
+}
+
+// RepoLayoutsCommand lists the repository layouts configured in Artifactory, so that templates refer to existing ones.
+// Layouts are read from the configuration descriptor, which requires admin permissions.
+type RepoLayoutsCommand struct {
+	serverDetails *config.ServerDetails
+	format        string
+}
+
+func NewRepoLayoutsCommand() *RepoLayoutsCommand {
+	return &RepoLayoutsCommand{}
+}
+
+// SetFormat sets the output format: table (the default) or json.
+func (rlc *RepoLayoutsCommand) SetFormat(format string) *RepoLayoutsCommand {
+	rlc.format = format
+	return rlc
+}
+
+func (rlc *RepoLayoutsCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoLayoutsCommand {
+	rlc.serverDetails = serverDetails
+	return rlc
+}
+
+func (rlc *RepoLayoutsCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rlc.serverDetails, nil
+}
+
+func (rlc *RepoLayoutsCommand) CommandName() string {
+	return "rt_repo_layouts"
+}
+
+func (rlc *RepoLayoutsCommand) Run() error {
+	if rlc.format != "" && rlc.format != "table" && rlc.format != "json" {
+		return errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'table', 'json'", rlc.format)
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rlc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	configDescriptor, err := servicesManager.GetConfigDescriptor()
+	if err != nil {
+		return err
+	}
+	layouts, err := parseRepoLayouts(configDescriptor)
+	if err != nil {
+		return err
+	}
+	sort.Slice(layouts, func(i, j int) bool {
+		return layouts[i].Name < layouts[j].Name
+	})
+	if rlc.format == "json" {
+		content, err := json.MarshalIndent(layouts, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+		return nil
+	}
+	if len(layouts) == 0 {
+		log.Info("No repository layouts are configured.")
+		return nil
+	}
+	return printRepoLayouts(layouts)
+}
+
+func printRepoLayouts(layouts []RepoLayout) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(writer, "NAME\tARTIFACT PATH PATTERN\tDESCRIPTOR PATH PATTERN"); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, layout := range layouts {
+		descriptorPathPattern := "-"
+		if layout.DistinctiveDescriptorPathPattern {
+			descriptorPathPattern = layout.DescriptorPathPattern
+		}
+		if _, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", layout.Name, layout.ArtifactPathPattern, descriptorPathPattern); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return errorutils.CheckError(writer.Flush())
+}
+
+// checkRepoLayoutRefs reads the configuration descriptor and validates the layouts the repositories refer to. The
+// descriptor is only read when at least one repository refers to a layout.
+func checkRepoLayoutRefs(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager) error {
+	hasLayoutRef := false
+	for _, repoConfigMap := range repoConfigMaps {
+		if layoutName, ok := repoConfigMap[RepoLayoutRef].(string); ok && layoutName != "" {
+			hasLayoutRef = true
+			break
+		}
+	}
+	if !hasLayoutRef {
+		return nil
+	}
+	configDescriptor, err := servicesManager.GetConfigDescriptor()
+	if err != nil {
+		return errorutils.CheckErrorf("failed to read the repository layouts, which requires admin permissions: %s", err.Error())
+	}
+	return validateRepoLayoutRefs(repoConfigMaps, configDescriptor)
+}
+
+// validateRepoLayoutRefs checks that the layouts the repositories refer to exist in the XML configuration descriptor.
+// All the repositories referring to missing layouts are reported in a single error.
+func validateRepoLayoutRefs(repoConfigMaps []map[string]interface{}, configDescriptor string) error {
+	layouts, err := parseRepoLayouts(configDescriptor)
+	if err != nil {
+		return err
+	}
+	layoutNames := make(map[string]bool, len(layouts))
+	for _, layout := range layouts {
+		layoutNames[layout.Name] = true
+	}
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		layoutName, ok := repoConfigMap[RepoLayoutRef].(string)
+		if !ok || layoutName == "" || layoutNames[layoutName] {
+			continue
+		}
+		problems = append(problems, fmt.Sprintf("repository '%v' refers to the repository layout '%s'", repoConfigMap[Key], layoutName))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	available := make([]string, 0, len(layoutNames))
+	for name := range layoutNames {
+		available = append(available, name)
+	}
+	sort.Strings(available)
+	return errorutils.CheckErrorf("the following repository layouts were not found in the Artifactory configuration:\n%s\nAvailable layouts: %s",
+		strings.Join(problems, "\n"), strings.Join(available, ", "))
+}
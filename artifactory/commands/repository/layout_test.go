@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigDescriptor = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<config xmlns="http://artifactory.jfrog.org/xsd/3.1.0">
+    <repoLayouts>
+        <repoLayout>
+            <name>maven-2-default</name>
+            <artifactPathPattern>[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).[ext]</artifactPathPattern>
+            <distinctiveDescriptorPathPattern>true</distinctiveDescriptorPathPattern>
+            <descriptorPathPattern>[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).pom</descriptorPathPattern>
+            <folderIntegrationRevisionRegExp>SNAPSHOT</folderIntegrationRevisionRegExp>
+            <fileIntegrationRevisionRegExp>SNAPSHOT|(?:(?:[0-9]{8}.[0-9]{6})-(?:[0-9]+))</fileIntegrationRevisionRegExp>
+        </repoLayout>
+        <repoLayout>
+            <name>simple-default</name>
+            <artifactPathPattern>[orgPath]/[module]/[module]-[baseRev].[ext]</artifactPathPattern>
+            <distinctiveDescriptorPathPattern>false</distinctiveDescriptorPathPattern>
+            <folderIntegrationRevisionRegExp>.*</folderIntegrationRevisionRegExp>
+            <fileIntegrationRevisionRegExp>.*</fileIntegrationRevisionRegExp>
+        </repoLayout>
+    </repoLayouts>
+</config>`
+
+func Test_FindRepoLayout(t *testing.T) {
+	layout, err := findRepoLayout(testConfigDescriptor, "simple-default")
+	require.NoError(t, err)
+	assert.Equal(t, &RepoLayout{
+		Name:                            "simple-default",
+		ArtifactPathPattern:             "[orgPath]/[module]/[module]-[baseRev].[ext]",
+		FolderIntegrationRevisionRegExp: ".*",
+		FileIntegrationRevisionRegExp:   ".*",
+	}, layout)
+
+	layout, err = findRepoLayout(testConfigDescriptor, "maven-2-default")
+	require.NoError(t, err)
+	assert.True(t, layout.DistinctiveDescriptorPathPattern)
+	assert.Equal(t, "[orgPath]/[module]/[baseRev](-[folderItegRev])/[module]-[baseRev](-[fileItegRev])(-[classifier]).pom", layout.DescriptorPathPattern)
+
+	_, err = findRepoLayout(testConfigDescriptor, "custom-layout")
+	assert.ErrorContains(t, err, "the repository layout 'custom-layout' was not found")
+
+	_, err = findRepoLayout("<config>", "simple-default")
+	assert.ErrorContains(t, err, "failed to parse the Artifactory configuration descriptor")
+}
+
+func Test_RepoLayoutRun(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/repositories/generic-local":
+			_, _ = w.Write([]byte(`{"key": "generic-local", "rclass": "local", "repoLayoutRef": "simple-default"}`))
+		case "/api/repositories/no-layout-local":
+			_, _ = w.Write([]byte(`{"key": "no-layout-local", "rclass": "local"}`))
+		case "/api/system/configuration":
+			_, _ = w.Write([]byte(testConfigDescriptor))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
+
+	assert.NoError(t, NewRepoLayoutCommand().SetServerDetails(serverDetails).SetRepoKey("generic-local").Run())
+	err := NewRepoLayoutCommand().SetServerDetails(serverDetails).SetRepoKey("no-layout-local").Run()
+	assert.ErrorContains(t, err, "repository 'no-layout-local' has no repository layout")
+	assert.Error(t, NewRepoLayoutCommand().SetServerDetails(serverDetails).SetRepoKey("missing-local").Run())
+}
+
+func Test_ParseRepoLayouts(t *testing.T) {
+	layouts, err := parseRepoLayouts(testConfigDescriptor)
+	require.NoError(t, err)
+	require.Len(t, layouts, 2)
+	assert.Equal(t, "maven-2-default", layouts[0].Name)
+	assert.Equal(t, "simple-default", layouts[1].Name)
+
+	layouts, err = parseRepoLayouts(`<config><repoLayouts/></config>`)
+	require.NoError(t, err)
+	assert.Empty(t, layouts)
+}
+
+func Test_ValidateRepoLayoutRefs(t *testing.T) {
+	repoConfigMaps := []map[string]interface{}{
+		{Key: "maven-local", RepoLayoutRef: "maven-2-default"},
+		{Key: "generic-local"},
+		{Key: "custom-local", RepoLayoutRef: "custom-layout"},
+		{Key: "other-local", RepoLayoutRef: "other-layout"},
+	}
+	err := validateRepoLayoutRefs(repoConfigMaps, testConfigDescriptor)
+	assert.ErrorContains(t, err, "repository 'custom-local' refers to the repository layout 'custom-layout'\nrepository 'other-local' refers to the repository layout 'other-layout'")
+	assert.ErrorContains(t, err, "Available layouts: maven-2-default, simple-default")
+
+	assert.NoError(t, validateRepoLayoutRefs(repoConfigMaps[:2], testConfigDescriptor))
+}
+
+func Test_RepoLayoutsRun(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/system/configuration" {
+			_, _ = w.Write([]byte(testConfigDescriptor))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
+
+	assert.NoError(t, NewRepoLayoutsCommand().SetServerDetails(serverDetails).Run())
+	assert.NoError(t, NewRepoLayoutsCommand().SetServerDetails(serverDetails).SetFormat("json").Run())
+	assert.ErrorContains(t, NewRepoLayoutsCommand().SetServerDetails(serverDetails).SetFormat("xml").Run(), "unsupported format 'xml'")
+}
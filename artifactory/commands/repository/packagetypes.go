@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+var supportedRclasses = []string{Local, Remote, Virtual, Federated}
+
+type RepoPackageTypesCommand struct {
+	rclass string
+	format string
+}
+
+func NewRepoPackageTypesCommand() *RepoPackageTypesCommand {
+	return &RepoPackageTypesCommand{}
+}
+
+func (rptc *RepoPackageTypesCommand) SetRclass(rclass string) *RepoPackageTypesCommand {
+	rptc.rclass = rclass
+	return rptc
+}
+
+func (rptc *RepoPackageTypesCommand) SetFormat(format string) *RepoPackageTypesCommand {
+	rptc.format = format
+	return rptc
+}
+
+func (rptc *RepoPackageTypesCommand) ServerDetails() (*config.ServerDetails, error) {
+	// Since it's a local command, usage won't be reported.
+	return nil, nil
+}
+
+func (rptc *RepoPackageTypesCommand) CommandName() string {
+	return "rt_repo_package_types"
+}
+
+func (rptc *RepoPackageTypesCommand) Run() error {
+	pkgTypes, err := GetSupportedPackageTypes(rptc.rclass)
+	if err != nil {
+		return err
+	}
+	switch rptc.format {
+	case "json":
+		var content []byte
+		if rptc.rclass != "" {
+			content, err = json.MarshalIndent(pkgTypes[rptc.rclass], "", "  ")
+		} else {
+			content, err = json.MarshalIndent(pkgTypes, "", "  ")
+		}
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+		return nil
+	case "", "list":
+		if rptc.rclass != "" {
+			fmt.Println(strings.Join(pkgTypes[rptc.rclass], "\n"))
+			return nil
+		}
+		for _, rclass := range supportedRclasses {
+			fmt.Printf("%s: %s\n", rclass, strings.Join(pkgTypes[rclass], ", "))
+		}
+		return nil
+	default:
+		return errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'list', 'json'", rptc.format)
+	}
+}
+
+// GetSupportedPackageTypes returns the sorted package types supported by the given rclass, keyed by the rclass.
+// If rclass is empty, the package types of all the rclasses are returned.
+// The package types are derived from the handler maps, so they always match the repositories the CLI can create.
+func GetSupportedPackageTypes(rclass string) (map[string][]string, error) {
+	rclassesPkgTypes := getRclassesPackageTypes()
+	if rclass == "" {
+		return rclassesPkgTypes, nil
+	}
+	if !slices.Contains(supportedRclasses, rclass) {
+		return nil, errorutils.CheckErrorf("unsupported rclass '%s'. Supported rclasses: %s", rclass, strings.Join(supportedRclasses, ", "))
+	}
+	return map[string][]string{rclass: rclassesPkgTypes[rclass]}, nil
+}
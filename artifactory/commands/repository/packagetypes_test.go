@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSupportedPackageTypes(t *testing.T) {
+	allPkgTypes, err := GetSupportedPackageTypes("")
+	require.NoError(t, err)
+	assert.Len(t, allPkgTypes, len(supportedRclasses))
+	assert.Len(t, allPkgTypes[Local], len(localRepoHandlers))
+	assert.Len(t, allPkgTypes[Remote], len(remoteRepoHandlers))
+	assert.Len(t, allPkgTypes[Virtual], len(virtualRepoHandlers))
+	assert.Len(t, allPkgTypes[Federated], len(federatedRepoHandlers))
+	assert.IsNonDecreasing(t, allPkgTypes[Local])
+
+	remotePkgTypes, err := GetSupportedPackageTypes(Remote)
+	require.NoError(t, err)
+	assert.Len(t, remotePkgTypes, 1)
+	assert.Contains(t, remotePkgTypes[Remote], P2)
+
+	localPkgTypes, err := GetSupportedPackageTypes(Local)
+	require.NoError(t, err)
+	assert.NotContains(t, localPkgTypes[Local], P2)
+
+	virtualPkgTypes, err := GetSupportedPackageTypes(Virtual)
+	require.NoError(t, err)
+	assert.NotContains(t, virtualPkgTypes[Virtual], Vagrant)
+
+	_, err = GetSupportedPackageTypes("distribution")
+	assert.ErrorContains(t, err, "unsupported rclass 'distribution'")
+}
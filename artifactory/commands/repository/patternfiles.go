@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	// The template fields referencing a file with the include or exclude patterns of the repository, which are expanded
+	// to the includesPattern and excludesPattern fields. The file lists one pattern per line. Empty lines and lines
+	// starting with '#' are ignored. A relative path is relative to the directory of the template referencing the file, or
+	// to the current directory for a template read from the standard input.
+	IncludePatternsFile = "includesPatternFile"
+	ExcludePatternsFile = "excludesPatternFile"
+
+	patternFileCommentPrefix = "#"
+)
+
+// The patterns fields each pattern file field expands to.
+var patternFilesFields = map[string]string{
+	IncludePatternsFile: IncludePatterns,
+	ExcludePatternsFile: ExcludePatterns,
+}
+
+// resolvePatternFilesPaths makes the relative paths of the pattern file fields of the repository configurations read
+// from the template relative to the directory of the template, rather than to the current directory.
+func resolvePatternFilesPaths(repoConfigMaps []map[string]interface{}, templatePath string) {
+	templateDir := filepath.Dir(templatePath)
+	for _, repoConfigMap := range repoConfigMaps {
+		for _, fileField := range []string{IncludePatternsFile, ExcludePatternsFile} {
+			value, ok := repoConfigMap[fileField]
+			if !ok {
+				continue
+			}
+			filePath := fmt.Sprint(value)
+			if filePath != "" && !filepath.IsAbs(filePath) {
+				repoConfigMap[fileField] = filepath.Join(templateDir, filePath)
+			}
+		}
+	}
+}
+
+// expandPatternFiles replaces the pattern file fields of the repository configurations with the patterns fields, set
+// to the comma separated patterns read from the files. Each pattern is validated, as a pattern file is typically long.
+func expandPatternFiles(repoConfigMaps []map[string]interface{}) error {
+	for _, repoConfigMap := range repoConfigMaps {
+		for _, fileField := range []string{IncludePatternsFile, ExcludePatternsFile} {
+			value, ok := repoConfigMap[fileField]
+			if !ok {
+				continue
+			}
+			patternsField := patternFilesFields[fileField]
+			if _, ok = repoConfigMap[patternsField]; ok {
+				return errorutils.CheckErrorf("repository '%v': '%s' can't be set together with '%s'", repoConfigMap[Key], patternsField, fileField)
+			}
+			patterns, err := readPatternFile(fmt.Sprint(value), patternsField)
+			if err != nil {
+				return errorutils.CheckErrorf("repository '%v': %s", repoConfigMap[Key], err.Error())
+			}
+			repoConfigMap[patternsField] = strings.Join(patterns, ",")
+			delete(repoConfigMap, fileField)
+		}
+	}
+	return nil
+}
+
+func readPatternFile(filePath, patternsField string) ([]string, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the patterns file of '%s': %s", patternsField, err.Error())
+	}
+	patterns, err := parsePatternFile(content, patternsField)
+	if err != nil {
+		return nil, fmt.Errorf("patterns file '%s': %s", filePath, err.Error())
+	}
+	return patterns, nil
+}
+
+// parsePatternFile returns the patterns listed in the content of a patterns file, failing on the first invalid pattern.
+func parsePatternFile(content []byte, patternsField string) ([]string, error) {
+	var patterns []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		pattern := strings.TrimSpace(scanner.Text())
+		if pattern == "" || strings.HasPrefix(pattern, patternFileCommentPrefix) {
+			continue
+		}
+		// The patterns are joined with commas, so a comma would split the pattern
+		if strings.Contains(pattern, ",") {
+			return nil, fmt.Errorf("line %d: the pattern '%s' must not contain a comma, list each pattern on its own line", lineNumber, pattern)
+		}
+		if problem := getPatternProblem(pattern, patternsField); problem != "" {
+			return nil, fmt.Errorf("line %d: %s", lineNumber, problem)
+		}
+		patterns = append(patterns, pattern)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(patterns) == 0 {
+		return nil, fmt.Errorf("no patterns are listed, remove the field referencing the file instead")
+	}
+	return patterns, nil
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePatternFile(t *testing.T) {
+	tests := []struct {
+		name             string
+		content          string
+		expectedPatterns []string
+		expectedError    string
+	}{
+		{
+			name:             "Patterns",
+			content:          "org/acme/**\ncom/acme/*.jar\n",
+			expectedPatterns: []string{"org/acme/**", "com/acme/*.jar"},
+		},
+		{
+			name:             "Comments and empty lines",
+			content:          "# Internal artifacts\norg/acme/**\n\n  # Indented comment\n\t com/acme/** \r\n",
+			expectedPatterns: []string{"org/acme/**", "com/acme/**"},
+		},
+		{
+			name:             "No trailing newline",
+			content:          "org/acme/**",
+			expectedPatterns: []string{"org/acme/**"},
+		},
+		{
+			name:          "Absolute pattern",
+			content:       "# Comment\norg/acme/**\n/com/acme/**\n",
+			expectedError: "line 3: the pattern '/com/acme/**' in 'includesPattern' must be relative to the repository root",
+		},
+		{
+			name:          "Invalid wildcard",
+			content:       "org/***/acme\n",
+			expectedError: "line 1: the pattern 'org/***/acme' in 'includesPattern' contains an invalid wildcard",
+		},
+		{
+			name:          "Comma",
+			content:       "org/acme/**,com/acme/**\n",
+			expectedError: "line 1: the pattern 'org/acme/**,com/acme/**' must not contain a comma",
+		},
+		{
+			name:          "Only comments",
+			content:       "# org/acme/**\n\n",
+			expectedError: "no patterns are listed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patterns, err := parsePatternFile([]byte(tt.content), IncludePatterns)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPatterns, patterns)
+		})
+	}
+}
+
+func TestExpandPatternFiles(t *testing.T) {
+	dir := t.TempDir()
+	includesFile := filepath.Join(dir, "includes.txt")
+	require.NoError(t, os.WriteFile(includesFile, []byte("# Internal artifacts\norg/acme/**\ncom/acme/**\n"), 0644))
+	excludesFile := filepath.Join(dir, "excludes.txt")
+	require.NoError(t, os.WriteFile(excludesFile, []byte("**/*-SNAPSHOT/**\n"), 0644))
+	invalidFile := filepath.Join(dir, "invalid.txt")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("org\\acme\n"), 0644))
+
+	tests := []struct {
+		name          string
+		repoConfigMap map[string]interface{}
+		expected      map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "Both files",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", IncludePatternsFile: includesFile, ExcludePatternsFile: excludesFile},
+			expected:      map[string]interface{}{Key: "maven-local", IncludePatterns: "org/acme/**,com/acme/**", ExcludePatterns: "**/*-SNAPSHOT/**"},
+		},
+		{
+			name:          "Excludes file with inline includes",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", IncludePatterns: "org/**", ExcludePatternsFile: excludesFile},
+			expected:      map[string]interface{}{Key: "maven-local", IncludePatterns: "org/**", ExcludePatterns: "**/*-SNAPSHOT/**"},
+		},
+		{
+			name:          "No files",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", IncludePatterns: "org/**"},
+			expected:      map[string]interface{}{Key: "maven-local", IncludePatterns: "org/**"},
+		},
+		{
+			name:          "File and inline patterns",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", IncludePatterns: "org/**", IncludePatternsFile: includesFile},
+			expectedError: "repository 'maven-local': 'includesPattern' can't be set together with 'includesPatternFile'",
+		},
+		{
+			name:          "Missing file",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", ExcludePatternsFile: filepath.Join(dir, "missing.txt")},
+			expectedError: "repository 'maven-local': failed to read the patterns file of 'excludesPattern'",
+		},
+		{
+			name:          "Invalid pattern",
+			repoConfigMap: map[string]interface{}{Key: "maven-local", IncludePatternsFile: invalidFile},
+			expectedError: "repository 'maven-local': patterns file '" + invalidFile + "': line 1: the pattern 'org\\acme' in 'includesPattern' must use '/' as the path separator",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := expandPatternFiles([]map[string]interface{}{tt.repoConfigMap})
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, tt.repoConfigMap)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The include pattern Artifactory applies when a repository doesn't define one.
+const defaultIncludePattern = "**/*"
+
+type PathPatternsResult struct {
+	Path     string `json:"path"`
+	Included bool   `json:"included"`
+	// The pattern which decided the result. Empty when the path matches no include pattern.
+	MatchedPattern string `json:"matchedPattern,omitempty"`
+}
+
+// SplitPatterns splits a comma separated patterns value, as stored in the includesPattern and excludesPattern fields.
+func SplitPatterns(patterns string) []string {
+	var result []string
+	for _, pattern := range strings.Split(patterns, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			result = append(result, pattern)
+		}
+	}
+	return result
+}
+
+// EvaluatePathPatterns decides whether a repository path is included by the repository's include/exclude patterns.
+// Like in Artifactory, a path is included when it matches at least one include pattern and no exclude pattern,
+// and an empty list of include patterns is treated as '**/*'.
+func EvaluatePathPatterns(repoPath string, includePatterns, excludePatterns []string) PathPatternsResult {
+	result := PathPatternsResult{Path: repoPath}
+	if len(includePatterns) == 0 {
+		includePatterns = []string{defaultIncludePattern}
+	}
+	for _, include := range includePatterns {
+		if MatchPathPattern(include, repoPath) {
+			result.Included = true
+			result.MatchedPattern = include
+			break
+		}
+	}
+	if !result.Included {
+		return result
+	}
+	for _, exclude := range excludePatterns {
+		if MatchPathPattern(exclude, repoPath) {
+			result.Included = false
+			result.MatchedPattern = exclude
+			break
+		}
+	}
+	return result
+}
+
+// MatchPathPattern matches a path relative to the repository root against an Ant-style pattern, the syntax used by
+// Artifactory's include/exclude patterns: '?' matches a single character, '*' matches zero or more characters within
+// a path segment and '**' matches zero or more path segments. A pattern ending with '/' matches everything under it.
+// Matching is case-sensitive.
+func MatchPathPattern(pattern, repoPath string) bool {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	return matchPathSegments(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(strings.Trim(repoPath, "/"), "/"))
+}
+
+func matchPathSegments(patternSegments, pathSegments []string) bool {
+	for len(patternSegments) > 0 {
+		if patternSegments[0] == "**" {
+			for i := 0; i <= len(pathSegments); i++ {
+				if matchPathSegments(patternSegments[1:], pathSegments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(pathSegments) == 0 || !matchSegment([]rune(patternSegments[0]), []rune(pathSegments[0])) {
+			return false
+		}
+		patternSegments, pathSegments = patternSegments[1:], pathSegments[1:]
+	}
+	return len(pathSegments) == 0
+}
+
+func matchSegment(pattern, segment []rune) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := 0; i <= len(segment); i++ {
+				if matchSegment(pattern[1:], segment[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(segment) == 0 {
+				return false
+			}
+		default:
+			if len(segment) == 0 || pattern[0] != segment[0] {
+				return false
+			}
+		}
+		pattern, segment = pattern[1:], segment[1:]
+	}
+	return len(segment) == 0
+}
+
+// PatternCovers reports whether every path matched by the pattern is also matched by the covering pattern, for
+// example 'org/**' covers 'org/acme/**' and '**/*.jar' covers 'org/*.jar'. The check is conservative: a pattern
+// segment with wildcards is only covered by an identical segment, '*' or '**'.
+func PatternCovers(coveringPattern, pattern string) bool {
+	if isMatchAllPattern(coveringPattern) {
+		return true
+	}
+	return coverPathSegments(splitPatternSegments(coveringPattern), splitPatternSegments(pattern))
+}
+
+func splitPatternSegments(pattern string) []string {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "**"
+	}
+	return strings.Split(strings.Trim(pattern, "/"), "/")
+}
+
+func coverPathSegments(coveringSegments, segments []string) bool {
+	for len(coveringSegments) > 0 {
+		if coveringSegments[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if coverPathSegments(coveringSegments[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		// Only '**' covers '**', as it matches any number of segments
+		if len(segments) == 0 || segments[0] == "**" || !coverSegment(coveringSegments[0], segments[0]) {
+			return false
+		}
+		coveringSegments, segments = coveringSegments[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
+
+func coverSegment(coveringSegment, segment string) bool {
+	if coveringSegment == "*" || coveringSegment == segment {
+		return true
+	}
+	if strings.ContainsAny(segment, "*?") {
+		return false
+	}
+	return matchSegment([]rune(coveringSegment), []rune(segment))
+}
+
+func formatPathPatternsResult(result PathPatternsResult) (status, reason string) {
+	switch {
+	case result.Included:
+		return "included", fmt.Sprintf("matches the include pattern '%s'", result.MatchedPattern)
+	case result.MatchedPattern != "":
+		return "excluded", fmt.Sprintf("matches the exclude pattern '%s'", result.MatchedPattern)
+	default:
+		return "excluded", "matches no include pattern"
+	}
+}
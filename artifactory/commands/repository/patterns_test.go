@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MatchPathPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"**/*", "file.jar", true},
+		{"**/*", "org/acme/file.jar", true},
+		{"**", "org/acme/file.jar", true},
+		{"org/acme/**", "org/acme/lib/1.0/lib-1.0.jar", true},
+		{"org/acme/**", "org/other/lib-1.0.jar", false},
+		{"org/acme/", "org/acme/lib/lib-1.0.jar", true},
+		{"org/*/lib-?.0.jar", "org/acme/lib-1.0.jar", true},
+		{"org/*/lib-?.0.jar", "org/acme/lib-10.0.jar", false},
+		{"org/*/lib.jar", "org/acme/nested/lib.jar", false},
+		{"**/*.pom", "org/acme/lib/1.0/lib-1.0.pom", true},
+		{"**/*.pom", "org/acme/lib/1.0/lib-1.0.jar", false},
+		{"**/snapshots/**", "org/snapshots/lib.jar", true},
+		{"org/**/lib.jar", "org/lib.jar", true},
+		{"Org/**", "org/lib.jar", false},
+		{"*.jar", "nested/lib.jar", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, MatchPathPattern(tt.pattern, tt.path))
+		})
+	}
+}
+
+func Test_PatternCovers(t *testing.T) {
+	tests := []struct {
+		coveringPattern string
+		pattern         string
+		expected        bool
+	}{
+		{"org/**", "org/**", true},
+		{"org/**", "org/acme/**", true},
+		{"org/**", "org/acme/lib.jar", true},
+		{"org/", "org/acme/**", true},
+		{"org/acme/**", "org/**", false},
+		{"org/acme/**", "org/other/**", false},
+		{"**/*", "org/**", true},
+		{"**/*.jar", "org/*.jar", true},
+		{"**/*.jar", "org/acme/lib-1.0.jar", true},
+		{"**/*.jar", "org/**", false},
+		{"org/*/lib.jar", "org/acme/lib.jar", true},
+		{"org/*/lib.jar", "org/**/lib.jar", false},
+		{"org/*.jar", "org/lib-?.jar", false},
+		{"**/snapshots/**", "org/acme/snapshots/**", true},
+		{"**/snapshots/**", "org/acme/**", false},
+		{"com/**", "org/**", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.coveringPattern+" "+tt.pattern, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PatternCovers(tt.coveringPattern, tt.pattern))
+		})
+	}
+}
+
+func Test_EvaluatePathPatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		includes []string
+		excludes []string
+		expected PathPatternsResult
+	}{
+		{
+			name:     "Default include",
+			path:     "org/acme/lib.jar",
+			expected: PathPatternsResult{Path: "org/acme/lib.jar", Included: true, MatchedPattern: defaultIncludePattern},
+		},
+		{
+			name:     "Included",
+			path:     "org/acme/lib.jar",
+			includes: []string{"com/**", "org/acme/**"},
+			excludes: []string{"**/*.pom"},
+			expected: PathPatternsResult{Path: "org/acme/lib.jar", Included: true, MatchedPattern: "org/acme/**"},
+		},
+		{
+			name:     "Excluded by exclude pattern",
+			path:     "org/acme/lib.pom",
+			includes: []string{"org/acme/**"},
+			excludes: []string{"**/*.pom"},
+			expected: PathPatternsResult{Path: "org/acme/lib.pom", MatchedPattern: "**/*.pom"},
+		},
+		{
+			name:     "Not matching any include pattern",
+			path:     "net/acme/lib.jar",
+			includes: []string{"org/acme/**"},
+			expected: PathPatternsResult{Path: "net/acme/lib.jar"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, EvaluatePathPatterns(tt.path, tt.includes, tt.excludes))
+		})
+	}
+}
+
+func Test_SplitPatterns(t *testing.T) {
+	assert.Equal(t, []string{"org/**", "com/acme/**"}, SplitPatterns(" org/** , ,com/acme/**"))
+	assert.Nil(t, SplitPatterns(""))
+}
@@ -0,0 +1,206 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The repository classes on which priorityResolution has an effect. Virtual repositories resolve from their members in
+// order, except that members with priorityResolution are resolved before all the others.
+var priorityResolutionRclasses = []string{Local, Remote, Federated}
+
+// RepoPriorityResolutionCommand sets or clears the priorityResolution field of the repositories matching a pattern, and
+// reports the effective resolution order of the virtual repositories which include the changed repositories.
+type RepoPriorityResolutionCommand struct {
+	serverDetails      *config.ServerDetails
+	repoPattern        string
+	priorityResolution bool
+	dryRun             bool
+}
+
+func NewRepoPriorityResolutionCommand() *RepoPriorityResolutionCommand {
+	return &RepoPriorityResolutionCommand{}
+}
+
+func (rpc *RepoPriorityResolutionCommand) SetRepoPattern(repoPattern string) *RepoPriorityResolutionCommand {
+	rpc.repoPattern = repoPattern
+	return rpc
+}
+
+func (rpc *RepoPriorityResolutionCommand) SetPriorityResolution(priorityResolution bool) *RepoPriorityResolutionCommand {
+	rpc.priorityResolution = priorityResolution
+	return rpc
+}
+
+func (rpc *RepoPriorityResolutionCommand) SetDryRun(dryRun bool) *RepoPriorityResolutionCommand {
+	rpc.dryRun = dryRun
+	return rpc
+}
+
+func (rpc *RepoPriorityResolutionCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoPriorityResolutionCommand {
+	rpc.serverDetails = serverDetails
+	return rpc
+}
+
+func (rpc *RepoPriorityResolutionCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rpc.serverDetails, nil
+}
+
+func (rpc *RepoPriorityResolutionCommand) CommandName() string {
+	return "rt_repo_priority_resolution"
+}
+
+func (rpc *RepoPriorityResolutionCommand) Run() error {
+	servicesManager, err := rtUtils.CreateServiceManager(rpc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repos, err := servicesManager.GetAllRepositories()
+	if err != nil {
+		return err
+	}
+	repoKeys, skippedRepoKeys, err := rpc.getApplicableRepoKeys(*repos)
+	if err != nil {
+		return err
+	}
+	for _, repoKey := range skippedRepoKeys {
+		log.Info("Repository", repoKey, "- skipped, priorityResolution has no effect on virtual repositories")
+	}
+	if len(repoKeys) == 0 {
+		log.Info("No applicable repositories match the pattern:", rpc.repoPattern)
+		return nil
+	}
+
+	// The priorityResolution of the repositories whose configuration was fetched, after the change.
+	priorities := make(map[string]bool)
+	var changedRepoKeys []string
+	for _, repoKey := range repoKeys {
+		changed, err := rpc.setPriorityResolution(servicesManager, repoKey)
+		if err != nil {
+			return err
+		}
+		priorities[repoKey] = rpc.priorityResolution
+		if changed {
+			changedRepoKeys = append(changedRepoKeys, repoKey)
+		}
+	}
+	summary := fmt.Sprintf("priorityResolution set to %t: %d changed, %d unchanged, %d skipped", rpc.priorityResolution, len(changedRepoKeys), len(repoKeys)-len(changedRepoKeys), len(skippedRepoKeys))
+	if rpc.dryRun {
+		summary = "[Dry run] " + summary
+	}
+	log.Info(summary)
+	if len(changedRepoKeys) == 0 {
+		return nil
+	}
+	return reportResolutionOrders(servicesManager, *repos, changedRepoKeys, priorities)
+}
+
+// getApplicableRepoKeys returns the keys of the repositories matching the pattern on which priorityResolution has an effect,
+// and the keys of the other matching repositories. A repository requested by its key must exist and be applicable.
+func (rpc *RepoPriorityResolutionCommand) getApplicableRepoKeys(repos []services.RepositoryDetails) (repoKeys, skippedRepoKeys []string, err error) {
+	isPattern := isRepoKeysPattern(rpc.repoPattern)
+	matchesKey, err := newRepoKeyMatcher(rpc.repoPattern)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, repo := range repos {
+		if !matchesKey(repo.Key) {
+			continue
+		}
+		rclass := strings.ToLower(repo.GetRepoType())
+		if slices.Contains(priorityResolutionRclasses, rclass) {
+			repoKeys = append(repoKeys, repo.Key)
+			continue
+		}
+		if !isPattern {
+			return nil, nil, errorutils.CheckErrorf("repository '%s' is a %s repository, on which priorityResolution has no effect", repo.Key, rclass)
+		}
+		skippedRepoKeys = append(skippedRepoKeys, repo.Key)
+	}
+	if !isPattern && len(repoKeys) == 0 {
+		return nil, nil, errorutils.CheckErrorf("repository '%s' doesn't exist", rpc.repoPattern)
+	}
+	return repoKeys, skippedRepoKeys, nil
+}
+
+// setPriorityResolution updates the priorityResolution of a repository, and reports whether it was, or would be in a dry
+// run, changed.
+func (rpc *RepoPriorityResolutionCommand) setPriorityResolution(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (bool, error) {
+	repoConfigMap := make(map[string]interface{})
+	if err := servicesManager.GetRepository(repoKey, &repoConfigMap); err != nil {
+		return false, err
+	}
+	if current, _ := repoConfigMap[PriorityResolution].(bool); current == rpc.priorityResolution {
+		log.Info("Repository", repoKey, "- priorityResolution is already", rpc.priorityResolution, "(no change)")
+		return false, nil
+	}
+	if rpc.dryRun {
+		log.Info("[Dry run] Repository", repoKey, "- priorityResolution would change from", !rpc.priorityResolution, "to", rpc.priorityResolution)
+		return true, nil
+	}
+	if err := updateRepoConfig(servicesManager, repoKey, repoConfigMap[Rclass], map[string]interface{}{PriorityResolution: rpc.priorityResolution}); err != nil {
+		return false, err
+	}
+	log.Info("Repository", repoKey, "- priorityResolution changed from", !rpc.priorityResolution, "to", rpc.priorityResolution)
+	return true, nil
+}
+
+// reportResolutionOrders logs the effective resolution order of each virtual repository including a changed repository.
+// priorities holds the priorityResolution of the repositories already fetched, and is completed as more are fetched.
+func reportResolutionOrders(servicesManager artifactory.ArtifactoryServicesManager, repos []services.RepositoryDetails, changedRepoKeys []string, priorities map[string]bool) error {
+	for _, repo := range repos {
+		if strings.ToLower(repo.GetRepoType()) != Virtual {
+			continue
+		}
+		repoConfigMap := make(map[string]interface{})
+		if err := servicesManager.GetRepository(repo.Key, &repoConfigMap); err != nil {
+			return err
+		}
+		members := getVirtualRepoMembers(repoConfigMap)
+		if !slices.ContainsFunc(members, func(member string) bool { return slices.Contains(changedRepoKeys, member) }) {
+			continue
+		}
+		for _, member := range members {
+			if _, ok := priorities[member]; ok {
+				continue
+			}
+			memberConfigMap := make(map[string]interface{})
+			if err := servicesManager.GetRepository(member, &memberConfigMap); err != nil {
+				return err
+			}
+			priorities[member], _ = memberConfigMap[PriorityResolution].(bool)
+		}
+		log.Info("Virtual repository", repo.Key, "- effective resolution order:", strings.Join(getEffectiveResolutionOrder(members, priorities), ", "))
+	}
+	return nil
+}
+
+func getVirtualRepoMembers(repoConfigMap map[string]interface{}) (members []string) {
+	values, _ := repoConfigMap[Repositories].([]interface{})
+	for _, value := range values {
+		members = append(members, fmt.Sprint(value))
+	}
+	return
+}
+
+// getEffectiveResolutionOrder returns the members of a virtual repository in the order they are resolved from: the members
+// with priorityResolution first, then the others, each group keeping the configured order.
+func getEffectiveResolutionOrder(members []string, priorities map[string]bool) []string {
+	var prioritized, others []string
+	for _, member := range members {
+		if priorities[member] {
+			prioritized = append(prioritized, member+" (priority)")
+		} else {
+			others = append(others, member)
+		}
+	}
+	return append(prioritized, others...)
+}
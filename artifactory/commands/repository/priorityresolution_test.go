@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetEffectiveResolutionOrder(t *testing.T) {
+	members := []string{"maven-remote", "maven-local", "maven-virtual-inner", "libs-local"}
+	assert.Equal(t, members, getEffectiveResolutionOrder(members, map[string]bool{}))
+	assert.Equal(t, []string{"maven-local (priority)", "libs-local (priority)", "maven-remote", "maven-virtual-inner"},
+		getEffectiveResolutionOrder(members, map[string]bool{"maven-local": true, "libs-local": true, "maven-remote": false}))
+}
+
+func Test_RepoPriorityResolutionCommand(t *testing.T) {
+	tests := []struct {
+		name               string
+		repoPattern        string
+		priorityResolution bool
+		dryRun             bool
+		expectedUpdated    map[string]bool
+		expectedError      string
+	}{
+		{
+			name:               "Set repositories matching a pattern",
+			repoPattern:        "maven-*",
+			priorityResolution: true,
+			expectedUpdated:    map[string]bool{"maven-remote": true},
+		},
+		{
+			name:               "Clear a single repository",
+			repoPattern:        "maven-local",
+			priorityResolution: false,
+			expectedUpdated:    map[string]bool{"maven-local": false},
+		},
+		{
+			name:               "Dry run doesn't update repositories",
+			repoPattern:        "maven-*",
+			priorityResolution: true,
+			dryRun:             true,
+			expectedUpdated:    map[string]bool{},
+		},
+		{
+			name:               "Virtual repository",
+			repoPattern:        "maven-virtual",
+			priorityResolution: true,
+			expectedUpdated:    map[string]bool{},
+			expectedError:      "repository 'maven-virtual' is a virtual repository, on which priorityResolution has no effect",
+		},
+		{
+			name:               "Missing repository",
+			repoPattern:        "maven-missing",
+			priorityResolution: true,
+			expectedUpdated:    map[string]bool{},
+			expectedError:      "repository 'maven-missing' doesn't exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// maven-local already has priority resolution.
+			repos := map[string]map[string]interface{}{
+				"maven-local":   {"key": "maven-local", "rclass": "local", "priorityResolution": true},
+				"maven-remote":  {"key": "maven-remote", "rclass": "remote"},
+				"maven-virtual": {"key": "maven-virtual", "rclass": "virtual", "repositories": []string{"maven-remote", "maven-local"}},
+			}
+			updated := map[string]bool{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/repositories" && r.Method == http.MethodGet:
+					_, err := w.Write([]byte(`[{"key":"maven-local","type":"LOCAL"},{"key":"maven-remote","type":"REMOTE"},{"key":"maven-virtual","type":"VIRTUAL"}]`))
+					require.NoError(t, err)
+				case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+					repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+					if r.Method == http.MethodPost {
+						body, err := io.ReadAll(r.Body)
+						require.NoError(t, err)
+						var repoConfigMap map[string]interface{}
+						require.NoError(t, json.Unmarshal(body, &repoConfigMap))
+						assert.Equal(t, repoKey, repoConfigMap["key"])
+						updated[repoKey] = repoConfigMap["priorityResolution"].(bool)
+						return
+					}
+					content, err := json.Marshal(repos[repoKey])
+					require.NoError(t, err)
+					_, err = w.Write(content)
+					require.NoError(t, err)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			priorityResolutionCmd := NewRepoPriorityResolutionCommand().SetRepoPattern(tt.repoPattern).SetPriorityResolution(tt.priorityResolution).
+				SetDryRun(tt.dryRun).SetServerDetails(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"})
+			err := priorityResolutionCmd.Run()
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUpdated, updated)
+		})
+	}
+}
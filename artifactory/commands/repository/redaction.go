@@ -0,0 +1,26 @@
+package repository
+
+import "slices"
+
+// The value which replaces sensitive values in the output and logs.
+const redactedValue = "****"
+
+// Fields whose values must never be written to the output or logs, as they may be credentials or secrets.
+var sensitiveFields = []string{Password, ClientTlsCertificate, KeyPair}
+
+func isSensitiveField(field string) bool {
+	return slices.Contains(sensitiveFields, field)
+}
+
+// redactSensitive returns a copy of the repository configuration in which the values of the sensitive fields are masked,
+// so it can be printed or logged. The configuration itself is left unchanged.
+func redactSensitive(repoConfigMap map[string]interface{}) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(repoConfigMap))
+	for field, value := range repoConfigMap {
+		if isSensitiveField(field) && value != nil && value != "" {
+			value = redactedValue
+		}
+		redacted[field] = value
+	}
+	return redacted
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactSensitive(t *testing.T) {
+	repoConfigMap := map[string]interface{}{
+		Key: "maven-remote", Rclass: Remote, Username: "deployer", Password: "s3cr3t",
+		ClientTlsCertificate: "client-cert", KeyPair: "", Url: "https://repo.example.com",
+	}
+
+	redacted := redactSensitive(repoConfigMap)
+
+	assert.Equal(t, map[string]interface{}{
+		Key: "maven-remote", Rclass: Remote, Username: "deployer", Password: redactedValue,
+		ClientTlsCertificate: redactedValue, KeyPair: "", Url: "https://repo.example.com",
+	}, redacted)
+	assert.NotContains(t, fmt.Sprint(redacted), "s3cr3t")
+	assert.NotContains(t, fmt.Sprint(redacted), "client-cert")
+	// The configuration which is sent to Artifactory keeps the values.
+	assert.Equal(t, "s3cr3t", repoConfigMap[Password])
+	assert.Equal(t, "client-cert", repoConfigMap[ClientTlsCertificate])
+}
+
+func TestGetRepoDrifts_RedactsSensitiveFields(t *testing.T) {
+	repoConfigMap := map[string]interface{}{Key: "maven-remote", ClientTlsCertificate: "golden-cert"}
+	liveConfigMap := map[string]interface{}{Key: "maven-remote", ClientTlsCertificate: "other-cert"}
+
+	assert.Equal(t, []repoDrift{{repoKey: "maven-remote", field: ClientTlsCertificate, expected: redactedValue, actual: redactedValue}},
+		getRepoDrifts("maven-remote", repoConfigMap, liveConfigMap, defaultAuditIgnoredFields))
+}
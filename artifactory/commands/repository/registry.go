@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// rclassesRepoHandlers is the registry of the handlers used to create and update repositories, keyed by rclass and package type.
+// It holds the built-in handler maps, which are augmented by RegisterRepoHandler.
+var rclassesRepoHandlers = map[string]map[string]repoHandler{
+	Local:     localRepoHandlers,
+	Remote:    remoteRepoHandlers,
+	Virtual:   virtualRepoHandlers,
+	Federated: federatedRepoHandlers,
+}
+
+// genericFallbackPackageTypes lists the package types supported by Artifactory which have no handler, as the client has no
+// parameters for them. Their repositories are created and updated by the generic handler of the rclass, which keeps the
+// package type of the template, so only the settings common to all package types are applied. Registering a handler for
+// one of these package types replaces the fallback.
+var genericFallbackPackageTypes = map[string][]string{
+	Local:     {Ansible, HuggingFaceML, Oci, Pub, TerraformBackend},
+	Remote:    {Ansible, HuggingFaceML, Oci, Pub},
+	Virtual:   {Ansible, Oci, Pub},
+	Federated: {Ansible, HuggingFaceML, Oci, Pub},
+}
+
+// RegisterRepoHandler adds a handler for repositories of a package type which has no built-in handler for the given rclass,
+// allowing to create and update them without changing the built-in handler maps.
+// The registry is not safe for concurrent use, so handlers should be registered at init time.
+// Note that the keys of the templates are still validated, so the repository configuration must consist of known keys.
+func RegisterRepoHandler(rclass, packageType string, h repoHandler) error {
+	handlers, ok := rclassesRepoHandlers[rclass]
+	if !ok {
+		return errorutils.CheckErrorf("unsupported rclass: %s", rclass)
+	}
+	if packageType == "" {
+		return errorutils.CheckErrorf("a package type must be provided to register a %s repository handler", rclass)
+	}
+	if h == nil {
+		return errorutils.CheckErrorf("the handler of %s %s repositories must not be nil", rclass, packageType)
+	}
+	if _, exists := handlers[packageType]; exists {
+		return errorutils.CheckErrorf("a handler of %s %s repositories is already registered", rclass, packageType)
+	}
+	handlers[packageType] = h
+	return nil
+}
+
+// getRepoHandler returns the handler of repositories of the given rclass and package type.
+func getRepoHandler(rclass interface{}, packageType string) (repoHandler, error) {
+	normalizedRclass, ok := normalizeRclass(rclass)
+	if !ok {
+		return nil, errorutils.CheckErrorf("unsupported rclass: %s. Valid rclasses are: %s", rclass, strings.Join(getSortedKeys(rclassesRepoHandlers), ", "))
+	}
+	handlers := rclassesRepoHandlers[normalizedRclass]
+	normalizedPackageType := normalizePackageType(normalizedRclass, packageType)
+	handler, ok := handlers[normalizedPackageType]
+	if !ok && slices.Contains(genericFallbackPackageTypes[normalizedRclass], normalizedPackageType) {
+		log.Debug(fmt.Sprintf("No handler of %s %s repositories, using the generic handler.", normalizedRclass, normalizedPackageType))
+		handler, ok = handlers[Generic]
+	}
+	if !ok {
+		return nil, errorutils.CheckErrorf("unsupported package type: %s. Valid package types of %s repositories are: %s", packageType, normalizedRclass, strings.Join(getSortedKeys(handlers), ", "))
+	}
+	return handler, nil
+}
+
+// normalizeRepoConfigsClasses rewrites the rclass and the package type of each repository configuration in the form used by
+// the registry, so that values such as "LOCAL" or "federated " are accepted.
+func normalizeRepoConfigsClasses(repoConfigMaps []map[string]interface{}) error {
+	for _, repoConfigMap := range repoConfigMaps {
+		rclass, ok := repoConfigMap[Rclass]
+		if !ok {
+			continue
+		}
+		normalizedRclass, ok := normalizeRclass(rclass)
+		if !ok {
+			return errorutils.CheckErrorf("repository '%v': unsupported rclass '%v'. Valid rclasses are: %s", repoConfigMap[Key], rclass, strings.Join(getSortedKeys(rclassesRepoHandlers), ", "))
+		}
+		repoConfigMap[Rclass] = normalizedRclass
+		if packageType, ok := repoConfigMap[PackageType]; ok {
+			repoConfigMap[PackageType] = normalizePackageType(normalizedRclass, fmt.Sprint(packageType))
+		}
+	}
+	return nil
+}
+
+// normalizeRclass matches the rclass ignoring case and surrounding whitespace.
+func normalizeRclass(rclass interface{}) (string, bool) {
+	normalizedRclass := strings.ToLower(strings.TrimSpace(fmt.Sprint(rclass)))
+	_, ok := rclassesRepoHandlers[normalizedRclass]
+	return normalizedRclass, ok
+}
+
+// normalizePackageType matches the package type ignoring case and surrounding whitespace. Package types which match no
+// handler or generic fallback are only trimmed, and left for getRepoHandler to report.
+func normalizePackageType(rclass, packageType string) string {
+	packageType = strings.TrimSpace(packageType)
+	handlers := rclassesRepoHandlers[rclass]
+	if _, ok := handlers[packageType]; ok {
+		return packageType
+	}
+	lowerPackageType := strings.ToLower(packageType)
+	if _, ok := handlers[lowerPackageType]; ok || slices.Contains(genericFallbackPackageTypes[rclass], lowerPackageType) {
+		return lowerPackageType
+	}
+	return packageType
+}
+
+func getSortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
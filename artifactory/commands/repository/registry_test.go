@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RegisterRepoHandler(t *testing.T) {
+	const customPackageType = "custompkg"
+	var handledConfigs []map[string]interface{}
+	customHandler := func(_ artifactory.ArtifactoryServicesManager, jsonConfig []byte, isUpdate bool) error {
+		assert.True(t, isUpdate)
+		repoConfigMap := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(jsonConfig, &repoConfigMap))
+		handledConfigs = append(handledConfigs, repoConfigMap)
+		return nil
+	}
+	require.NoError(t, RegisterRepoHandler(Local, customPackageType, customHandler))
+	t.Cleanup(func() { delete(localRepoHandlers, customPackageType) })
+
+	// The registered handler is routed to
+	repoConfigMaps := []map[string]interface{}{{Key: "custom-local", Rclass: Local, PackageType: customPackageType}}
+	assert.NoError(t, (&SingleRepositoryHandler{}).Execute(repoConfigMaps, nil, true))
+	assert.Equal(t, []map[string]interface{}{{Key: "custom-local", Rclass: Local, PackageType: customPackageType}}, handledConfigs)
+
+	// The registered package type is supported only by the rclass it was registered for
+	pkgTypes, err := GetSupportedPackageTypes("")
+	require.NoError(t, err)
+	assert.Contains(t, pkgTypes[Local], customPackageType)
+	assert.NotContains(t, pkgTypes[Remote], customPackageType)
+	repoConfigMaps = []map[string]interface{}{{Key: "custom-remote", Rclass: Remote, PackageType: customPackageType}}
+	assert.ErrorContains(t, (&SingleRepositoryHandler{}).Execute(repoConfigMaps, nil, true), "unsupported package type: custompkg")
+
+	// Registrations which would replace existing handlers or are invalid are rejected
+	assert.ErrorContains(t, RegisterRepoHandler(Local, customPackageType, customHandler), "a handler of local custompkg repositories is already registered")
+	assert.ErrorContains(t, RegisterRepoHandler(Local, Maven, customHandler), "a handler of local maven repositories is already registered")
+	assert.ErrorContains(t, RegisterRepoHandler("distribution", customPackageType, customHandler), "unsupported rclass: distribution")
+	assert.ErrorContains(t, RegisterRepoHandler(Remote, "", customHandler), "a package type must be provided")
+	assert.ErrorContains(t, RegisterRepoHandler(Remote, customPackageType, nil), "must not be nil")
+}
+
+func Test_NormalizeRepoConfigsClasses(t *testing.T) {
+	tests := []struct {
+		name                string
+		rclass              interface{}
+		packageType         interface{}
+		expectedRclass      string
+		expectedPackageType string
+		errorContains       string
+	}{
+		{name: "Normalized values", rclass: Local, packageType: Maven, expectedRclass: Local, expectedPackageType: Maven},
+		{name: "Upper case", rclass: "LOCAL", packageType: "Maven", expectedRclass: Local, expectedPackageType: Maven},
+		{name: "Surrounding whitespace", rclass: "federated ", packageType: " docker\t", expectedRclass: Federated, expectedPackageType: Docker},
+		{name: "Unknown package type is only trimmed", rclass: " Remote", packageType: " Unknown ", expectedRclass: Remote, expectedPackageType: "Unknown"},
+		{name: "Unknown rclass", rclass: "lokal", packageType: Maven, errorContains: "repository 'test-repo': unsupported rclass 'lokal'. Valid rclasses are: federated, local, remote, virtual"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoConfigMap := map[string]interface{}{Key: "test-repo", Rclass: tt.rclass, PackageType: tt.packageType}
+			err := normalizeRepoConfigsClasses([]map[string]interface{}{repoConfigMap})
+			if tt.errorContains != "" {
+				assert.EqualError(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRclass, repoConfigMap[Rclass])
+			assert.Equal(t, tt.expectedPackageType, repoConfigMap[PackageType])
+		})
+	}
+}
+
+func Test_GetRepoHandler_NotNormalized(t *testing.T) {
+	handler, err := getRepoHandler(" Virtual", "NPM ")
+	require.NoError(t, err)
+	assert.NotNil(t, handler)
+
+	_, err = getRepoHandler(Local, "unknown")
+	assert.ErrorContains(t, err, "unsupported package type: unknown. Valid package types of local repositories are: alpine, ")
+
+	_, err = getRepoHandler("release", Maven)
+	assert.EqualError(t, err, "unsupported rclass: release. Valid rclasses are: federated, local, remote, virtual")
+}
+
+func Test_GetRepoHandler_GenericFallback(t *testing.T) {
+	handlerPointer := func(h repoHandler) uintptr { return reflect.ValueOf(h).Pointer() }
+
+	// Package types without a handler are created by the generic handler of the rclass
+	handler, err := getRepoHandler(Local, " Ansible")
+	require.NoError(t, err)
+	assert.Equal(t, handlerPointer(localGenericHandler), handlerPointer(handler))
+	handler, err = getRepoHandler(Virtual, Oci)
+	require.NoError(t, err)
+	assert.Equal(t, handlerPointer(virtualGenericHandler), handlerPointer(handler))
+	assert.Equal(t, HuggingFaceML, normalizePackageType(Remote, "HuggingFaceML"))
+
+	// The fallback applies only to the rclasses supporting the package type
+	_, err = getRepoHandler(Remote, TerraformBackend)
+	assert.ErrorContains(t, err, "unsupported package type: terraformbackend")
+
+	// A registered handler replaces the fallback
+	registeredHandler := func(_ artifactory.ArtifactoryServicesManager, _ []byte, _ bool) error { return nil }
+	require.NoError(t, RegisterRepoHandler(Local, Pub, registeredHandler))
+	t.Cleanup(func() { delete(localRepoHandlers, Pub) })
+	handler, err = getRepoHandler(Local, Pub)
+	require.NoError(t, err)
+	assert.Equal(t, handlerPointer(registeredHandler), handlerPointer(handler))
+}
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	ioutils "github.com/jfrog/gofrog/io"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// What is done with the content of a local repository when it's renamed.
+const (
+	RenameContentMove = "move"
+	RenameContentCopy = "copy"
+	RenameContentSkip = "skip"
+)
+
+// RepoRenameCommand renames a repository. Artifactory can't change the key of a repository, so the configuration is
+// cloned to a repository with the new key, the content is moved or copied to it, and once the content is verified the
+// original repository is deleted. Each step runs only if the previous one succeeded, so a failure never deletes the
+// original repository.
+type RepoRenameCommand struct {
+	serverDetails *config.ServerDetails
+	sourceRepoKey string
+	targetRepoKey string
+	content       string
+	// The password of a remote repository, which Artifactory masks in the configuration it returns.
+	repoPassword string
+	dryRun       bool
+	quiet        bool
+}
+
+func NewRepoRenameCommand() *RepoRenameCommand {
+	return &RepoRenameCommand{}
+}
+
+func (rrc *RepoRenameCommand) SetSourceRepoKey(sourceRepoKey string) *RepoRenameCommand {
+	rrc.sourceRepoKey = sourceRepoKey
+	return rrc
+}
+
+func (rrc *RepoRenameCommand) SetTargetRepoKey(targetRepoKey string) *RepoRenameCommand {
+	rrc.targetRepoKey = targetRepoKey
+	return rrc
+}
+
+func (rrc *RepoRenameCommand) SetContent(content string) *RepoRenameCommand {
+	rrc.content = content
+	return rrc
+}
+
+func (rrc *RepoRenameCommand) SetRepoPassword(repoPassword string) *RepoRenameCommand {
+	rrc.repoPassword = repoPassword
+	return rrc
+}
+
+func (rrc *RepoRenameCommand) SetDryRun(dryRun bool) *RepoRenameCommand {
+	rrc.dryRun = dryRun
+	return rrc
+}
+
+func (rrc *RepoRenameCommand) SetQuiet(quiet bool) *RepoRenameCommand {
+	rrc.quiet = quiet
+	return rrc
+}
+
+func (rrc *RepoRenameCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoRenameCommand {
+	rrc.serverDetails = serverDetails
+	return rrc
+}
+
+func (rrc *RepoRenameCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rrc.serverDetails, nil
+}
+
+func (rrc *RepoRenameCommand) CommandName() string {
+	return "rt_repo_rename"
+}
+
+func (rrc *RepoRenameCommand) Run() error {
+	if rrc.content == "" {
+		rrc.content = RenameContentMove
+	}
+	if rrc.content != RenameContentMove && rrc.content != RenameContentCopy && rrc.content != RenameContentSkip {
+		return errorutils.CheckErrorf("unsupported content action '%s'. Acceptable values are: %s, %s and %s", rrc.content, RenameContentMove, RenameContentCopy, RenameContentSkip)
+	}
+	if rrc.sourceRepoKey == rrc.targetRepoKey {
+		return errorutils.CheckErrorf("the new repository key must be different from '%s'", rrc.sourceRepoKey)
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rrc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+
+	repoConfigMap := make(map[string]interface{})
+	if err = servicesManager.GetRepository(rrc.sourceRepoKey, &repoConfigMap); err != nil {
+		return err
+	}
+	rclass := fmt.Sprint(repoConfigMap[Rclass])
+	if rclass == Federated {
+		return errorutils.CheckErrorf("repository '%s' is a federated repository, which can't be renamed since its federation members refer to its key", rrc.sourceRepoKey)
+	}
+	if err = rrc.restoreMaskedSecrets(repoConfigMap); err != nil {
+		return err
+	}
+	exists, err := isRepoExistsWithRetries(servicesManager, rrc.targetRepoKey)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errorutils.CheckErrorf("repository '%s' already exists", rrc.targetRepoKey)
+	}
+
+	// Only local repositories hold content of their own. The cache of a remote repository is not transferred.
+	transferContent := rclass == Local && rrc.content != RenameContentSkip
+	sourceFilesCount := 0
+	if transferContent {
+		if sourceFilesCount, err = countRepoFiles(servicesManager, rrc.sourceRepoKey); err != nil {
+			return err
+		}
+	}
+
+	if rrc.dryRun {
+		log.Info("[Dry run] Repository", rrc.targetRepoKey, "would be created with the configuration of", rrc.sourceRepoKey)
+		if transferContent {
+			log.Info(fmt.Sprintf("[Dry run] %d files would be %s from %s to %s", sourceFilesCount, pastParticiple(rrc.content), rrc.sourceRepoKey, rrc.targetRepoKey))
+		}
+		log.Info("[Dry run] Repository", rrc.sourceRepoKey, "would be deleted")
+		return nil
+	}
+	if !rrc.quiet && !coreutils.AskYesNo(rrc.confirmationMessage(transferContent), false) {
+		return nil
+	}
+
+	log.Info("Creating repository", rrc.targetRepoKey, "with the configuration of", rrc.sourceRepoKey)
+	if err = createRepoFromConfig(servicesManager, repoConfigMap, rrc.targetRepoKey); err != nil {
+		return errorutils.CheckErrorf("failed to create repository '%s': %s", rrc.targetRepoKey, err.Error())
+	}
+	if transferContent {
+		if err = rrc.transferContent(servicesManager, sourceFilesCount); err != nil {
+			return errorutils.CheckErrorf("%s\nRepository '%s' was created and repository '%s' was kept. Resolve the problem, then complete the rename manually.", err.Error(), rrc.targetRepoKey, rrc.sourceRepoKey)
+		}
+	}
+	log.Info("Deleting repository", rrc.sourceRepoKey)
+	if err = servicesManager.DeleteRepository(rrc.sourceRepoKey); err != nil {
+		return errorutils.CheckErrorf("repository '%s' was created, but failed to delete repository '%s': %s", rrc.targetRepoKey, rrc.sourceRepoKey, err.Error())
+	}
+	log.Info("Repository", rrc.sourceRepoKey, "was renamed to", rrc.targetRepoKey)
+	log.Warn("Virtual repositories which included", rrc.sourceRepoKey, "must be updated to include", rrc.targetRepoKey, "instead.")
+	return nil
+}
+
+// restoreMaskedSecrets sets the secrets which Artifactory masks in the returned configuration of the repository, so the
+// renamed repository isn't created without them. It fails if a secret of the repository can't be restored, before the
+// original repository, the only one holding the secret, is deleted.
+func (rrc *RepoRenameCommand) restoreMaskedSecrets(repoConfigMap map[string]interface{}) error {
+	if certificate, ok := repoConfigMap[ClientTlsCertificate]; ok && certificate != nil && certificate != "" {
+		return errorutils.CheckErrorf("repository '%s' authenticates with a client certificate, which Artifactory doesn't return with its configuration. "+
+			"Remove the certificate from the repository, rename it, then set the certificate on the renamed repository", rrc.sourceRepoKey)
+	}
+	if password, ok := repoConfigMap[Password]; !ok || password == nil || password == "" {
+		return nil
+	}
+	if rrc.repoPassword == "" {
+		return errorutils.CheckErrorf("repository '%s' has a password, which Artifactory doesn't return with its configuration. "+
+			"Provide it with --repo-password, so the renamed repository is created with it", rrc.sourceRepoKey)
+	}
+	repoConfigMap[Password] = rrc.repoPassword
+	return nil
+}
+
+func (rrc *RepoRenameCommand) confirmationMessage(transferContent bool) string {
+	message := fmt.Sprintf("Are you sure you want to rename repository %s to %s? Repository %s will be permanently deleted", rrc.sourceRepoKey, rrc.targetRepoKey, rrc.sourceRepoKey)
+	if transferContent {
+		return message + " once its content is " + pastParticiple(rrc.content) + "."
+	}
+	return message + " including all of its content."
+}
+
+// transferContent moves or copies all the files of the source repository to the target repository, and verifies that the
+// target repository holds as many files as the source repository held before the transfer.
+func (rrc *RepoRenameCommand) transferContent(servicesManager artifactory.ArtifactoryServicesManager, sourceFilesCount int) error {
+	params := services.NewMoveCopyParams()
+	params.Pattern = rrc.sourceRepoKey + "/*"
+	params.Target = rrc.targetRepoKey + "/"
+	params.Recursive = true
+	log.Info(fmt.Sprintf("Transferring %d files from %s to %s (%s)", sourceFilesCount, rrc.sourceRepoKey, rrc.targetRepoKey, rrc.content))
+	var failedCount int
+	var err error
+	if rrc.content == RenameContentCopy {
+		_, failedCount, err = servicesManager.Copy(params)
+	} else {
+		_, failedCount, err = servicesManager.Move(params)
+	}
+	if err != nil {
+		return err
+	}
+	if failedCount > 0 {
+		return errorutils.CheckErrorf("%d files failed to be %s from '%s' to '%s'", failedCount, pastParticiple(rrc.content), rrc.sourceRepoKey, rrc.targetRepoKey)
+	}
+	targetFilesCount, err := countRepoFiles(servicesManager, rrc.targetRepoKey)
+	if err != nil {
+		return err
+	}
+	if targetFilesCount != sourceFilesCount {
+		return errorutils.CheckErrorf("verification failed: repository '%s' holds %d files, while repository '%s' held %d files", rrc.targetRepoKey, targetFilesCount, rrc.sourceRepoKey, sourceFilesCount)
+	}
+	return nil
+}
+
+// createRepoFromConfig creates a repository with the configuration of another repository, as returned by Artifactory.
+func createRepoFromConfig(servicesManager artifactory.ArtifactoryServicesManager, repoConfigMap map[string]interface{}, repoKey string) error {
+	newRepoConfigMap := make(map[string]interface{}, len(repoConfigMap))
+	for key, value := range repoConfigMap {
+		newRepoConfigMap[key] = value
+	}
+	newRepoConfigMap[Key] = repoKey
+	content, err := json.Marshal(newRepoConfigMap)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	rtDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	resp, body, err := servicesManager.Client().SendPut(rtDetails.GetUrl()+repositoriesApi+url.PathEscape(repoKey), content, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated)
+}
+
+// countRepoFiles returns the number of files in a repository.
+func countRepoFiles(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (filesCount int, err error) {
+	query := fmt.Sprintf(`items.find({"repo": %q, "type": "file"}).include("name")`, repoKey)
+	reader, err := servicesManager.Aql(query)
+	if err != nil {
+		return 0, err
+	}
+	defer ioutils.Close(reader, &err)
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return 0, errorutils.CheckError(err)
+	}
+	result := struct {
+		Range struct {
+			Total int `json:"total"`
+		} `json:"range"`
+	}{}
+	if err = json.Unmarshal(content, &result); err != nil {
+		return 0, errorutils.CheckErrorf("failed to parse the files of repository '%s': %s", repoKey, err.Error())
+	}
+	return result.Range.Total, nil
+}
+
+func pastParticiple(content string) string {
+	if content == RenameContentCopy {
+		return "copied"
+	}
+	return "moved"
+}
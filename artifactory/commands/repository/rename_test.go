@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createRenameTestServer serves the given repositories, and records the repositories created and deleted through it.
+func createRenameTestServer(t *testing.T, repositories map[string]string, created map[string]map[string]interface{}, deleted *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/search/aql":
+			_, _ = w.Write([]byte(`{"results": [{"name": "a.txt"}, {"name": "b.txt"}], "range": {"start_pos": 0, "end_pos": 2, "total": 2}}`))
+		case r.Method == http.MethodGet:
+			if repoConfig, ok := repositories[r.URL.Path[len("/api/repositories/"):]]; ok {
+				_, _ = w.Write([]byte(repoConfig))
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+		case r.Method == http.MethodPut:
+			content, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			repoConfigMap := make(map[string]interface{})
+			require.NoError(t, json.Unmarshal(content, &repoConfigMap))
+			created[r.URL.Path[len("/api/repositories/"):]] = repoConfigMap
+		case r.Method == http.MethodDelete:
+			*deleted = append(*deleted, r.URL.Path[len("/api/repositories/"):])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func Test_RepoRenameRun(t *testing.T) {
+	repositories := map[string]string{
+		"npm-virtual":   `{"key": "npm-virtual", "rclass": "virtual", "packageType": "npm", "repositories": ["npm-local"]}`,
+		"generic-local": `{"key": "generic-local", "rclass": "local", "packageType": "generic"}`,
+	}
+	created := make(map[string]map[string]interface{})
+	var deleted []string
+	testServer := createRenameTestServer(t, repositories, created, &deleted)
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
+
+	// Dry run
+	err := NewRepoRenameCommand().SetServerDetails(serverDetails).SetSourceRepoKey("generic-local").SetTargetRepoKey("generic-new").SetDryRun(true).Run()
+	assert.NoError(t, err)
+	assert.Empty(t, created)
+	assert.Empty(t, deleted)
+
+	// A virtual repository has no content to transfer
+	err = NewRepoRenameCommand().SetServerDetails(serverDetails).SetSourceRepoKey("npm-virtual").SetTargetRepoKey("npm-proxy").SetQuiet(true).Run()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]interface{}{
+		"npm-proxy": {"key": "npm-proxy", "rclass": "virtual", "packageType": "npm", "repositories": []interface{}{"npm-local"}},
+	}, created)
+	assert.Equal(t, []string{"npm-virtual"}, deleted)
+}
+
+func Test_RepoRenameRunMaskedSecrets(t *testing.T) {
+	repositories := map[string]string{
+		"npm-remote":      `{"key": "npm-remote", "rclass": "remote", "packageType": "npm", "url": "https://registry.npmjs.org", "username": "admin", "password": "****"}`,
+		"npm-remote-cert": `{"key": "npm-remote-cert", "rclass": "remote", "packageType": "npm", "clientTlsCertificate": "****"}`,
+	}
+	created := make(map[string]map[string]interface{})
+	var deleted []string
+	testServer := createRenameTestServer(t, repositories, created, &deleted)
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
+
+	// The source repository, the only one holding the secrets, is kept when they can't be restored
+	err := NewRepoRenameCommand().SetServerDetails(serverDetails).SetSourceRepoKey("npm-remote").SetTargetRepoKey("npm-proxy").SetQuiet(true).Run()
+	assert.ErrorContains(t, err, "repository 'npm-remote' has a password, which Artifactory doesn't return with its configuration")
+	err = NewRepoRenameCommand().SetServerDetails(serverDetails).SetSourceRepoKey("npm-remote-cert").SetTargetRepoKey("npm-proxy").
+		SetRepoPassword("secret").SetQuiet(true).Run()
+	assert.ErrorContains(t, err, "repository 'npm-remote-cert' authenticates with a client certificate")
+	assert.Empty(t, created)
+	assert.Empty(t, deleted)
+
+	err = NewRepoRenameCommand().SetServerDetails(serverDetails).SetSourceRepoKey("npm-remote").SetTargetRepoKey("npm-proxy").
+		SetRepoPassword("secret").SetQuiet(true).Run()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]map[string]interface{}{
+		"npm-proxy": {"key": "npm-proxy", "rclass": "remote", "packageType": "npm", "url": "https://registry.npmjs.org", "username": "admin", "password": "secret"},
+	}, created)
+	assert.Equal(t, []string{"npm-remote"}, deleted)
+}
+
+func Test_RepoRenameRunValidations(t *testing.T) {
+	repositories := map[string]string{
+		"npm-virtual":     `{"key": "npm-virtual", "rclass": "virtual", "packageType": "npm"}`,
+		"npm-federated":   `{"key": "npm-federated", "rclass": "federated", "packageType": "npm"}`,
+		"npm-virtual-new": `{"key": "npm-virtual-new", "rclass": "virtual", "packageType": "npm"}`,
+	}
+	created := make(map[string]map[string]interface{})
+	var deleted []string
+	testServer := createRenameTestServer(t, repositories, created, &deleted)
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
+
+	tests := []struct {
+		name          string
+		sourceRepoKey string
+		targetRepoKey string
+		content       string
+		expectedError string
+	}{
+		{name: "unsupported content", sourceRepoKey: "npm-virtual", targetRepoKey: "npm-new", content: "delete", expectedError: "unsupported content action 'delete'"},
+		{name: "same key", sourceRepoKey: "npm-virtual", targetRepoKey: "npm-virtual", expectedError: "the new repository key must be different from 'npm-virtual'"},
+		{name: "federated", sourceRepoKey: "npm-federated", targetRepoKey: "npm-new", expectedError: "repository 'npm-federated' is a federated repository"},
+		{name: "existing target", sourceRepoKey: "npm-virtual", targetRepoKey: "npm-virtual-new", expectedError: "repository 'npm-virtual-new' already exists"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRepoRenameCommand().SetServerDetails(serverDetails).SetSourceRepoKey(tt.sourceRepoKey).SetTargetRepoKey(tt.targetRepoKey).
+				SetContent(tt.content).SetQuiet(true).Run()
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+	assert.Empty(t, created)
+	assert.Empty(t, deleted)
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const repoExistsMaxRetries = 2
+
+// The interval between the retries of isRepoExistsWithRetries. Replaced in tests.
+var repoExistsRetriesIntervalMilliSecs = 1000
+
+// isRepoExistsWithRetries checks whether a repository exists, retrying on transient failures.
+// Unlike servicesManager.IsRepoExists, which reports any unsuccessful response as a missing repository, only a 400 or 404
+// response means the repository doesn't exist. Network errors, 429 and 5xx responses are retried, and any other
+// response is returned as an error.
+func isRepoExistsWithRetries(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (exists bool, err error) {
+	rtDetails := servicesManager.GetConfig().GetServiceDetails()
+	retryExecutor := &clientUtils.RetryExecutor{
+		MaxRetries:               repoExistsMaxRetries,
+		RetriesIntervalMilliSecs: repoExistsRetriesIntervalMilliSecs,
+		ErrorMessage:             fmt.Sprintf("Failed checking whether repository '%s' exists", repoKey),
+		ExecutionHandler: func() (shouldRetry bool, err error) {
+			httpClientDetails := rtDetails.CreateHttpClientDetails()
+			resp, body, _, err := servicesManager.Client().SendGet(rtDetails.GetUrl()+repositoriesApi+url.PathEscape(repoKey), true, &httpClientDetails)
+			if err != nil {
+				return true, err
+			}
+			switch {
+			case resp.StatusCode == http.StatusOK:
+				exists = true
+				return false, nil
+			case resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound:
+				exists = false
+				return false, nil
+			case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+				return true, errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+			default:
+				return false, errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+			}
+		},
+	}
+	if err = retryExecutor.Execute(); err != nil {
+		return false, errorutils.CheckErrorf("failed to check whether repository '%s' exists: %s", repoKey, err.Error())
+	}
+	return exists, nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IsRepoExistsWithRetries(t *testing.T) {
+	originalInterval := repoExistsRetriesIntervalMilliSecs
+	repoExistsRetriesIntervalMilliSecs = 0
+	defer func() { repoExistsRetriesIntervalMilliSecs = originalInterval }()
+
+	tests := []struct {
+		name             string
+		statusCodes      []int
+		expectedExists   bool
+		expectedRequests int
+		expectError      bool
+	}{
+		{name: "Exists", statusCodes: []int{http.StatusOK}, expectedExists: true, expectedRequests: 1},
+		{name: "Doesn't exist", statusCodes: []int{http.StatusBadRequest}, expectedRequests: 1},
+		{name: "Not found", statusCodes: []int{http.StatusNotFound}, expectedRequests: 1},
+		{name: "Transient failure", statusCodes: []int{http.StatusServiceUnavailable, http.StatusOK}, expectedExists: true, expectedRequests: 2},
+		{name: "Persistent failure", statusCodes: []int{http.StatusInternalServerError}, expectedRequests: repoExistsMaxRetries + 1, expectError: true},
+		{name: "Unauthorized isn't retried", statusCodes: []int{http.StatusUnauthorized}, expectedRequests: 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/repositories/test-repo", r.URL.Path)
+				w.WriteHeader(tt.statusCodes[min(requests, len(tt.statusCodes)-1)])
+				requests++
+			}))
+			defer testServer.Close()
+
+			servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+			require.NoError(t, err)
+
+			exists, err := isRepoExistsWithRetries(servicesManager, "test-repo")
+			if tt.expectError {
+				assert.ErrorContains(t, err, "failed to check whether repository 'test-repo' exists")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedExists, exists)
+			assert.Equal(t, tt.expectedRequests, requests)
+		})
+	}
+}
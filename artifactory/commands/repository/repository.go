@@ -1,17 +1,24 @@
 package repository
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/jfrog/gofrog/version"
 	"github.com/jfrog/jfrog-client-go/utils/log"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
 	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -22,12 +29,48 @@ const (
 	// The actual field in the repository configuration is an array (plural) but in practice only one environment is allowed.
 	// This is why the question differs from the repository configuration.
 	environmentsKey = "environments"
+	// Older Artifactory versions accept at most one environment per repository.
+	minArtifactoryVersionForMultipleEnvironments = "7.110.0"
+
+	keyPairApi = "api/security/keypair/"
+
+	// Reading the template from the standard input is requested by using this value as the template path.
+	stdinTemplatePath = "-"
 )
 
+// The reader from which the template is read when its path is stdinTemplatePath. Replaced in tests.
+var templateStdin io.Reader = os.Stdin
+
 type RepoCommand struct {
-	serverDetails *config.ServerDetails
-	templatePath  string
-	vars          string
+	serverDetails     *config.ServerDetails
+	templatePath      string
+	vars              string
+	validate          bool
+	strictPatterns    bool
+	strict            bool
+	rollbackOnFailure bool
+	annotate          bool
+	verifyMembers     bool
+	// When set, only the repositories whose keys match the pattern are created or updated.
+	includeKeys string
+	// When set, the configurations are only validated, and no repositories are created or updated.
+	validateOnly bool
+	// When set, the progress of creating or updating the repositories one by one is reported to the standard error.
+	progress bool
+	// When set, the storage provider of the instance is checked to support the settings which depend on it, such as cdnRedirect.
+	checkStorage bool
+	// When set, the repository layouts the repositories refer to are checked to exist on the instance.
+	checkLayouts bool
+	// When set, the recommended security settings are applied to the Docker repositories which don't set them.
+	dockerHardening bool
+	// When set, the progress and the summary of the run aren't reported.
+	quiet bool
+	// The format of the summary of the run: text (the default) or json.
+	summaryFormat string
+	// When set, the hook is invoked after each repository is created or updated.
+	onSuccess string
+	// When set, a failed hook fails the command instead of being reported as a warning.
+	failOnHookError bool
 }
 
 func (rc *RepoCommand) Vars() string {
@@ -43,36 +86,45 @@ type repoCreateUpdateHandler interface {
 }
 
 type (
-	MultipleRepositoryHandler struct{}
-	SingleRepositoryHandler   struct{}
+	MultipleRepositoryHandler struct {
+		// When set, the repositories are counted in it once the batch is created or updated.
+		summary *repoApplySummary
+		// When set, it is invoked for each repository once the batch is created or updated.
+		hook *repoHook
+	}
+	SingleRepositoryHandler struct {
+		// When set, the progress of the batch is written to it before each repository is created or updated.
+		progressWriter io.Writer
+		// When set, each repository is counted in it once created or updated.
+		summary *repoApplySummary
+		// When set, it is invoked for each repository once created or updated.
+		hook *repoHook
+	}
 )
 
 func (rc *RepoCommand) PerformRepoCmd(isUpdate bool) (err error) {
-	configs, err := utils.ConvertTemplateToMaps(rc)
-	if err != nil {
+	if err = validateSummaryFormat(rc.summaryFormat); err != nil {
 		return err
 	}
 
-	var (
-		repoConfigMaps []map[string]interface{}
-		strategy       repoCreateUpdateHandler
-	)
+	var matchesKey func(repoKey string) bool
+	if rc.includeKeys != "" {
+		if matchesKey, err = newRepoKeyMatcher(rc.includeKeys); err != nil {
+			return err
+		}
+	}
 
-	switch configType := configs.(type) {
-	case []map[string]interface{}:
-		repoConfigMaps = configType
-		strategy = &MultipleRepositoryHandler{}
-	case map[string]interface{}:
-		repoConfigMaps = []map[string]interface{}{configType}
-		strategy = &SingleRepositoryHandler{}
-	default:
-		return fmt.Errorf("unexpected repository configuration type: %T", configType)
+	batches, err := rc.getRepoConfigsBatches()
+	if err != nil {
+		return err
 	}
 
+	repoConfigMaps := flattenRepoConfigsBatches(batches)
+
 	var missingKeys []string
 	for _, repoConfigMap := range repoConfigMaps {
 		if key, ok := repoConfigMap["key"]; !ok || key == "" {
-			missingKeys = append(missingKeys, fmt.Sprintf("%v\n", repoConfigMap))
+			missingKeys = append(missingKeys, fmt.Sprintf("%v\n", redactSensitive(repoConfigMap)))
 		}
 	}
 
@@ -80,26 +132,325 @@ func (rc *RepoCommand) PerformRepoCmd(isUpdate bool) (err error) {
 		return fmt.Errorf("'key' is missing in the following configs\n: %v", missingKeys)
 	}
 
+	if err = normalizeRepoConfigsClasses(repoConfigMaps); err != nil {
+		return err
+	}
+
+	if err = validateUniqueRepoKeys(repoConfigMaps); err != nil {
+		return err
+	}
+
+	if err = expandExternalDependencies(repoConfigMaps); err != nil {
+		return err
+	}
+
+	if err = expandPatternFiles(repoConfigMaps); err != nil {
+		return err
+	}
+
+	summary := newRepoApplySummary()
+	if matchesKey != nil {
+		for _, repoConfigMap := range repoConfigMaps {
+			if !matchesKey(fmt.Sprint(repoConfigMap[Key])) {
+				summary.add(repoActionSkipped, repoConfigMap)
+			}
+		}
+		batches = filterRepoConfigsBatches(batches, matchesKey)
+		repoConfigMaps = flattenRepoConfigsBatches(batches)
+		if len(repoConfigMaps) == 0 {
+			return rc.reportSummary(summary)
+		}
+	}
+
+	if rc.dockerHardening {
+		applyDockerHardening(repoConfigMaps, isUpdate)
+	}
+
+	repoLabels, err := extractRepoLabels(repoConfigMaps)
+	if err != nil {
+		return err
+	}
+
+	if err = analyzeVirtualRepoPatterns(repoConfigMaps, rc.strictPatterns); err != nil {
+		return err
+	}
+
+	if err = validateRepoCapabilities(repoConfigMaps, rc.strict); err != nil {
+		return err
+	}
+
+	if err = validateDeprecatedFields(repoConfigMaps, rc.strict); err != nil {
+		return err
+	}
+
+	if err = validateRemoteCacheSettings(repoConfigMaps, rc.strict); err != nil {
+		return err
+	}
+
+	if err = validateYumSettings(repoConfigMaps, isUpdate, rc.strict); err != nil {
+		return err
+	}
+
+	if err = validateFederationMembers(repoConfigMaps); err != nil {
+		return err
+	}
+
 	servicesManager, err := rtUtils.CreateServiceManager(rc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
 
-	return strategy.Execute(repoConfigMaps, servicesManager, isUpdate)
+	if len(repoLabels) > 0 {
+		if err = validateRepoLabelsSupported(servicesManager); err != nil {
+			return err
+		}
+	}
+
+	if err = validateEnvironmentsCount(repoConfigMaps, servicesManager); err != nil {
+		return err
+	}
+
+	if err = validateRepoEdition(repoConfigMaps, newEditionProbe(servicesManager)); err != nil {
+		return err
+	}
+
+	if rc.checkStorage {
+		if err = validateCdnRedirectStorage(repoConfigMaps, newStorageProbe(servicesManager), rc.strict); err != nil {
+			return err
+		}
+	}
+
+	if rc.checkLayouts {
+		if err = checkRepoLayoutRefs(repoConfigMaps, servicesManager); err != nil {
+			return err
+		}
+	}
+
+	if rc.verifyMembers {
+		if err = verifyFederationMembers(repoConfigMaps, servicesManager); err != nil {
+			return err
+		}
+	}
+
+	if rc.validateOnly {
+		return validateRepoConfigsOnly(repoConfigMaps, servicesManager, isUpdate)
+	}
+
+	if rc.validate {
+		if err = validateKeyPairRefs(repoConfigMaps, servicesManager); err != nil {
+			return err
+		}
+	}
+
+	if rc.annotate {
+		templateSource := rc.templatePath
+		if templateSource == stdinTemplatePath {
+			templateSource = "stdin"
+		}
+		annotateRepoConfigs(repoConfigMaps, templateSource, time.Now())
+	}
+
+	// The repositories created by any of the batches are rolled back together when a batch fails.
+	// Updates don't create repositories, so there is nothing to roll back.
+	var newRepoKeys []string
+	if rc.rollbackOnFailure && !isUpdate {
+		if newRepoKeys, err = getNonExistingRepoKeys(repoConfigMaps, servicesManager); err != nil {
+			return err
+		}
+	}
+
+	hook := newRepoHook(rc.onSuccess, rc.failOnHookError)
+	for _, batch := range batches {
+		switch strategy := batch.strategy.(type) {
+		case *SingleRepositoryHandler:
+			if rc.progress {
+				strategy.progressWriter = os.Stderr
+			}
+			strategy.summary = summary
+			strategy.hook = hook
+		case *MultipleRepositoryHandler:
+			strategy.summary = summary
+			strategy.hook = hook
+		}
+		if err = batch.strategy.Execute(batch.repoConfigMaps, servicesManager, isUpdate); err != nil {
+			if len(newRepoKeys) > 0 {
+				return errors.Join(err, rollbackCreatedRepos(newRepoKeys, servicesManager))
+			}
+			return err
+		}
+	}
+	if err = applyRepoLabels(servicesManager, repoLabels); err != nil {
+		return err
+	}
+	return rc.reportSummary(summary)
+}
+
+// reportSummary reports the counts of the repositories created, updated and skipped, unless quiet is set.
+func (rc *RepoCommand) reportSummary(summary *repoApplySummary) error {
+	if rc.quiet {
+		return nil
+	}
+	return summary.printSummary(rc.summaryFormat)
+}
+
+func (rc *RepoCommand) convertTemplateToMaps() (interface{}, error) {
+	if rc.templatePath != stdinTemplatePath {
+		if isYamlTemplateFile(rc.templatePath) {
+			return readTemplateFile(rc.templatePath, rc.vars)
+		}
+		return utils.ConvertTemplateToMaps(rc)
+	}
+	content, err := io.ReadAll(templateStdin)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(bytes.TrimSpace(content)) == 0 {
+		return nil, errorutils.CheckErrorf("no template was provided on the standard input")
+	}
+	configs, err := convertTemplateContentToMaps(content, rc.vars)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the template read from the standard input: %s", err.Error())
+	}
+	return configs, nil
+}
+
+// convertTemplateContentToMaps parses the template content the same way utils.ConvertTemplateToMaps parses a template file.
+// The result is a slice of maps for a multiple repositories template, or a single map otherwise.
+func convertTemplateContentToMaps(content []byte, vars string) (interface{}, error) {
+	if len(vars) > 0 {
+		content = coreutils.ReplaceVars(content, coreutils.SpecVarsStringToMap(vars))
+	}
+	var repoConfigMaps []map[string]interface{}
+	err := json.Unmarshal(content, &repoConfigMaps)
+	if err == nil {
+		return repoConfigMaps, nil
+	}
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return nil, err
+	}
+	var repoConfigMap map[string]interface{}
+	if err = json.Unmarshal(content, &repoConfigMap); err != nil {
+		return nil, err
+	}
+	return repoConfigMap, nil
+}
+
+// annotateRepoConfigs sets the notes of each repository to provenance details, unless the notes are already set by the template.
+func annotateRepoConfigs(repoConfigMaps []map[string]interface{}, templatePath string, provisionTime time.Time) {
+	annotation := fmt.Sprintf("Provisioned by %s at %s from template '%s'", coreutils.GetCliUserAgent(), provisionTime.UTC().Format(time.RFC3339), templatePath)
+	for _, repoConfigMap := range repoConfigMaps {
+		if notes, ok := repoConfigMap[Notes]; ok && notes != "" {
+			continue
+		}
+		repoConfigMap[Notes] = annotation
+	}
 }
 
 func (m *MultipleRepositoryHandler) Execute(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager, isUpdate bool) error {
+	for _, repoConfigMap := range repoConfigMaps {
+		if err := validateChecksumPolicy(repoConfigMap); err != nil {
+			return err
+		}
+		if csObject, ok := repoConfigMap[ContentSynchronisation].(map[string]interface{}); ok {
+			if _, err := parseContentSynchronisationObject(csObject); err != nil {
+				return err
+			}
+		}
+		for _, key := range nonNegativeIntKeys {
+			if value, ok := repoConfigMap[key]; ok {
+				if err := validateNonNegativeInt(key, fmt.Sprint(value)); err != nil {
+					return err
+				}
+			}
+		}
+		if err := writeBoolValues(repoConfigMap); err != nil {
+			return err
+		}
+	}
 	content, err := json.Marshal(repoConfigMaps)
 	if err != nil {
 		return err
 	}
-	return multipleRepoHandler(servicesManager, content, isUpdate)
+	if err = multipleRepoHandler(servicesManager, content, isUpdate); err != nil {
+		return err
+	}
+	m.addToSummary(repoConfigMaps, isUpdate)
+	return m.hook.notify(getRepoApplyAction(isUpdate), repoConfigMaps...)
+}
+
+// writeBoolValues writes the boolean values of the repository configuration with their writers, as the single repository
+// handler does, so the same spellings of booleans are accepted in multiple repositories templates.
+func writeBoolValues(repoConfigMap map[string]interface{}) error {
+	for key, value := range repoConfigMap {
+		writer, ok := writersMap[key]
+		if !ok || getWriterValueType(writer) != boolValueType {
+			continue
+		}
+		if err := writer(&repoConfigMap, key, fmt.Sprint(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MultipleRepositoryHandler) addToSummary(repoConfigMaps []map[string]interface{}, isUpdate bool) {
+	if m.summary != nil {
+		m.summary.add(getRepoApplyAction(isUpdate), repoConfigMaps...)
+	}
+}
+
+// getNonExistingRepoKeys returns the keys of the repositories which don't exist yet, and may therefore be created during this run.
+func getNonExistingRepoKeys(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager) ([]string, error) {
+	var newRepoKeys []string
+	for _, repoConfigMap := range repoConfigMaps {
+		repoKey := fmt.Sprint(repoConfigMap[Key])
+		exists, err := isRepoExistsWithRetries(servicesManager, repoKey)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			newRepoKeys = append(newRepoKeys, repoKey)
+		}
+	}
+	return newRepoKeys, nil
+}
+
+// rollbackCreatedRepos deletes the repositories out of newRepoKeys which were created before the run failed.
+func rollbackCreatedRepos(newRepoKeys []string, servicesManager artifactory.ArtifactoryServicesManager) error {
+	var rollbackErrors []error
+	for _, repoKey := range newRepoKeys {
+		exists, err := isRepoExistsWithRetries(servicesManager, repoKey)
+		if err != nil {
+			rollbackErrors = append(rollbackErrors, err)
+			continue
+		}
+		if !exists {
+			continue
+		}
+		log.Info("Rolling back the creation of repository:", repoKey)
+		if err = servicesManager.DeleteRepository(repoKey); err != nil {
+			rollbackErrors = append(rollbackErrors, fmt.Errorf("failed to roll back the creation of repository '%s': %w", repoKey, err))
+		}
+	}
+	return errors.Join(rollbackErrors...)
 }
 
 func (s *SingleRepositoryHandler) Execute(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager, isUpdate bool) error {
 	// Go over the confMap and write the values with the correct type using the writersMap
-	for _, repoConfigMap := range repoConfigMaps {
+	for i, repoConfigMap := range repoConfigMaps {
+		s.reportProgress(i+1, len(repoConfigMaps), repoConfigMap[Key])
+		if err := validateChecksumPolicy(repoConfigMap); err != nil {
+			return err
+		}
 		for key, value := range repoConfigMap {
+			// Content synchronisation may also be provided as a nested object, which is passed through as is once validated
+			if csObject, ok := value.(map[string]interface{}); ok && key == ContentSynchronisation {
+				if err := writeContentSynchronisationObject(&repoConfigMap, key, csObject); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := utils.ValidateMapEntry(key, value, writersMap); err != nil {
 				return err
 			}
@@ -114,32 +465,34 @@ func (s *SingleRepositoryHandler) Execute(repoConfigMaps []map[string]interface{
 		}
 
 		// Rclass and packageType are mandatory keys in our templates
-		// Using their values we'll pick the suitable handler from one of the handler maps to create/update a repository
-		var handlerFunc func(servicesManager artifactory.ArtifactoryServicesManager, jsonConfig []byte, isUpdate bool) error
-		packageType := fmt.Sprint(repoConfigMap[PackageType])
-		switch repoConfigMap[Rclass] {
-		case Local:
-			handlerFunc = localRepoHandlers[packageType]
-		case Remote:
-			handlerFunc = remoteRepoHandlers[packageType]
-		case Virtual:
-			handlerFunc = virtualRepoHandlers[packageType]
-		case Federated:
-			handlerFunc = federatedRepoHandlers[packageType]
-		default:
-			return errorutils.CheckErrorf("unsupported rclass: %s", repoConfigMap[Rclass])
-		}
-		if handlerFunc == nil {
-			return errors.New("unsupported package type: " + packageType)
+		// Using their values we'll pick the suitable handler from the handlers registry to create/update a repository
+		handlerFunc, err := getRepoHandler(repoConfigMap[Rclass], fmt.Sprint(repoConfigMap[PackageType]))
+		if err != nil {
+			return err
 		}
 
-		if err := handlerFunc(servicesManager, content, isUpdate); err != nil {
+		if err = handlerFunc(servicesManager, content, isUpdate); err != nil {
+			return err
+		}
+		if s.summary != nil {
+			s.summary.add(getRepoApplyAction(isUpdate), repoConfigMap)
+		}
+		if err = s.hook.notify(getRepoApplyAction(isUpdate), repoConfigMap); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// reportProgress reports the repository about to be created or updated. A batch of a single repository has no progress
+// worth reporting.
+func (s *SingleRepositoryHandler) reportProgress(current, total int, repoKey interface{}) {
+	if s.progressWriter == nil || total < 2 {
+		return
+	}
+	_, _ = fmt.Fprintf(s.progressWriter, "Processing %d/%d: %v\n", current, total, repoKey)
+}
+
 func multipleRepoHandler(servicesManager artifactory.ArtifactoryServicesManager, jsonConfig []byte, isUpdate bool) (err error) {
 	artifactoryVersion, err := servicesManager.GetVersion()
 	if err != nil {
@@ -168,6 +521,177 @@ func multipleRepoHandler(servicesManager artifactory.ArtifactoryServicesManager,
 	return nil
 }
 
+// validateEnvironmentsCount fails if more than one environment is assigned to a repository, while the Artifactory version
+// doesn't support multiple environments. The version is fetched only if such a repository exists.
+func validateEnvironmentsCount(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager) error {
+	var multipleEnvsRepos []string
+	for _, repoConfigMap := range repoConfigMaps {
+		if countEnvironments(repoConfigMap[environmentsKey]) > 1 {
+			multipleEnvsRepos = append(multipleEnvsRepos, fmt.Sprint(repoConfigMap[Key]))
+		}
+	}
+	if len(multipleEnvsRepos) == 0 {
+		return nil
+	}
+	artifactoryVersion, err := servicesManager.GetVersion()
+	if err != nil {
+		return errorutils.CheckErrorf("failed to get Artifactory version: %s", err.Error())
+	}
+	if version.NewVersion(artifactoryVersion).AtLeast(minArtifactoryVersionForMultipleEnvironments) {
+		return nil
+	}
+	return errorutils.CheckErrorf("only one environment per repository is supported by Artifactory %s (multiple environments are supported from version %s). "+
+		"More than one environment was provided for the following repositories: %s", artifactoryVersion, minArtifactoryVersionForMultipleEnvironments, strings.Join(multipleEnvsRepos, ", "))
+}
+
+// countEnvironments counts the environments provided either as a comma separated string or as a JSON array.
+func countEnvironments(environments interface{}) int {
+	switch envs := environments.(type) {
+	case nil:
+		return 0
+	case []interface{}:
+		return len(envs)
+	default:
+		count := 0
+		for _, env := range strings.Split(fmt.Sprint(envs), ",") {
+			if strings.TrimSpace(env) != "" {
+				count++
+			}
+		}
+		return count
+	}
+}
+
+// validateKeyPairRefs verifies that every key pair referenced by the repositories configurations exists in Artifactory.
+func validateKeyPairRefs(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager) error {
+	var missingKeyPairs []string
+	checkedKeyPairs := make(map[string]bool)
+	for _, repoConfigMap := range repoConfigMaps {
+		for _, refKey := range []string{KeyPair, PrimaryKeyPairRef, SecondaryKeyPairRef} {
+			value, ok := repoConfigMap[refKey]
+			if !ok {
+				continue
+			}
+			keyPairName := fmt.Sprint(value)
+			if keyPairName == "" || checkedKeyPairs[keyPairName] {
+				continue
+			}
+			checkedKeyPairs[keyPairName] = true
+			exists, err := isKeyPairExists(servicesManager, keyPairName)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				missingKeyPairs = append(missingKeyPairs, fmt.Sprintf("'%s' (referenced by '%s' in repository '%v')", keyPairName, refKey, repoConfigMap[Key]))
+			}
+		}
+	}
+	if len(missingKeyPairs) > 0 {
+		return errorutils.CheckErrorf("the following key pairs do not exist in Artifactory: %s", strings.Join(missingKeyPairs, ", "))
+	}
+	return nil
+}
+
+func isKeyPairExists(servicesManager artifactory.ArtifactoryServicesManager, keyPairName string) (bool, error) {
+	rtDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(rtDetails.GetUrl()+keyPairApi+url.PathEscape(keyPairName), true, &httpClientDetails)
+	if err != nil {
+		return false, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+	}
+}
+
+// analyzeVirtualRepoPatterns validates the syntax of the include/exclude patterns of virtual repositories and detects
+// excludes which mask includes, meaning every path matched by the include is also matched by the exclude. Problems are reported as warnings, unless strict is set, in which case they are fatal.
+func analyzeVirtualRepoPatterns(repoConfigMaps []map[string]interface{}, strict bool) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		if repoConfigMap[Rclass] != Virtual {
+			continue
+		}
+		for _, problem := range getPatternsProblems(repoConfigMap) {
+			problems = append(problems, fmt.Sprintf("virtual repository '%v': %s", repoConfigMap[Key], problem))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if strict {
+		return errorutils.CheckErrorf("include/exclude patterns analysis failed:\n%s", strings.Join(problems, "\n"))
+	}
+	for _, problem := range problems {
+		log.Warn(problem)
+	}
+	return nil
+}
+
+func getPatternsProblems(repoConfigMap map[string]interface{}) (problems []string) {
+	includes, includeProblems := parsePatterns(repoConfigMap, IncludePatterns)
+	excludes, excludeProblems := parsePatterns(repoConfigMap, ExcludePatterns)
+	problems = append(includeProblems, excludeProblems...)
+	if len(excludes) == 0 {
+		return
+	}
+	for _, exclude := range excludes {
+		if isMatchAllPattern(exclude) {
+			return append(problems, fmt.Sprintf("the exclude pattern '%s' masks all includes, no artifact can be resolved", exclude))
+		}
+	}
+	for _, include := range includes {
+		for _, exclude := range excludes {
+			if PatternCovers(exclude, include) {
+				problems = append(problems, fmt.Sprintf("the include pattern '%s' is masked by the exclude pattern '%s'", include, exclude))
+				break
+			}
+		}
+	}
+	return
+}
+
+// parsePatterns splits the comma separated patterns of the given key, and validates the syntax of each pattern.
+func parsePatterns(repoConfigMap map[string]interface{}, patternsKey string) (patterns, problems []string) {
+	value, ok := repoConfigMap[patternsKey]
+	if !ok {
+		return
+	}
+	for _, pattern := range strings.Split(fmt.Sprint(value), ",") {
+		pattern = strings.TrimSpace(pattern)
+		if problem := getPatternProblem(pattern, patternsKey); problem != "" {
+			problems = append(problems, problem)
+		} else {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return
+}
+
+// getPatternProblem returns why the pattern isn't a valid Artifactory include/exclude pattern, or an empty string if it is.
+func getPatternProblem(pattern, patternsKey string) string {
+	switch {
+	case pattern == "":
+		return fmt.Sprintf("'%s' contains an empty pattern", patternsKey)
+	case strings.HasPrefix(pattern, "/"):
+		return fmt.Sprintf("the pattern '%s' in '%s' must be relative to the repository root", pattern, patternsKey)
+	case strings.Contains(pattern, "\\"):
+		return fmt.Sprintf("the pattern '%s' in '%s' must use '/' as the path separator", pattern, patternsKey)
+	case strings.Contains(pattern, "***"):
+		return fmt.Sprintf("the pattern '%s' in '%s' contains an invalid wildcard", pattern, patternsKey)
+	}
+	return ""
+}
+
+func isMatchAllPattern(pattern string) bool {
+	return pattern == "**" || pattern == "**/*" || pattern == "**/**"
+}
+
 var writersMap = map[string]ioutils.AnswerWriter{
 	Key:                               ioutils.WriteStringAnswer,
 	Rclass:                            ioutils.WriteStringAnswer,
@@ -181,77 +705,122 @@ var writersMap = map[string]ioutils.AnswerWriter{
 	RepoLayoutRef:                     ioutils.WriteStringAnswer,
 	ProjectKey:                        ioutils.WriteStringAnswer,
 	environmentsKey:                   ioutils.WriteStringArrayAnswer,
-	HandleReleases:                    ioutils.WriteBoolAnswer,
-	HandleSnapshots:                   ioutils.WriteBoolAnswer,
-	MaxUniqueSnapshots:                ioutils.WriteIntAnswer,
-	SuppressPomConsistencyChecks:      ioutils.WriteBoolAnswer,
-	BlackedOut:                        ioutils.WriteBoolAnswer,
-	DownloadRedirect:                  ioutils.WriteBoolAnswer,
-	PriorityResolution:                ioutils.WriteBoolAnswer,
-	CdnRedirect:                       ioutils.WriteBoolAnswer,
-	BlockPushingSchema1:               ioutils.WriteBoolAnswer,
-	DebianTrivialLayout:               ioutils.WriteBoolAnswer,
-	ExternalDependenciesEnabled:       ioutils.WriteBoolAnswer,
+	HandleReleases:                    writeBoolAnswer,
+	HandleSnapshots:                   writeBoolAnswer,
+	MaxUniqueSnapshots:                writeNonNegativeIntAnswer,
+	SuppressPomConsistencyChecks:      writeBoolAnswer,
+	BlackedOut:                        writeBoolAnswer,
+	DownloadRedirect:                  writeBoolAnswer,
+	PriorityResolution:                writeBoolAnswer,
+	CdnRedirect:                       writeBoolAnswer,
+	BlockPushingSchema1:               writeBoolAnswer,
+	DebianTrivialLayout:               writeBoolAnswer,
+	ExternalDependenciesEnabled:       writeBoolAnswer,
 	ExternalDependenciesPatterns:      ioutils.WriteStringArrayAnswer,
 	ChecksumPolicyType:                ioutils.WriteStringAnswer,
-	MaxUniqueTags:                     ioutils.WriteIntAnswer,
+	MaxUniqueTags:                     writeNonNegativeIntAnswer,
 	SnapshotVersionBehavior:           ioutils.WriteStringAnswer,
-	XrayIndex:                         ioutils.WriteBoolAnswer,
+	XrayIndex:                         writeBoolAnswer,
 	PropertySets:                      ioutils.WriteStringArrayAnswer,
-	ArchiveBrowsingEnabled:            ioutils.WriteBoolAnswer,
-	CalculateYumMetadata:              ioutils.WriteBoolAnswer,
+	ArchiveBrowsingEnabled:            writeBoolAnswer,
+	CalculateYumMetadata:              writeBoolAnswer,
 	YumRootDepth:                      ioutils.WriteIntAnswer,
 	DockerApiVersion:                  ioutils.WriteStringAnswer,
-	EnableFileListsIndexing:           ioutils.WriteBoolAnswer,
+	EnableFileListsIndexing:           writeBoolAnswer,
 	OptionalIndexCompressionFormats:   ioutils.WriteStringArrayAnswer,
 	Username:                          ioutils.WriteStringAnswer,
 	Password:                          ioutils.WriteStringAnswer,
 	Proxy:                             ioutils.WriteStringAnswer,
 	RemoteRepoChecksumPolicyType:      ioutils.WriteStringAnswer,
-	HardFail:                          ioutils.WriteBoolAnswer,
-	Offline:                           ioutils.WriteBoolAnswer,
-	StoreArtifactsLocally:             ioutils.WriteBoolAnswer,
+	HardFail:                          writeBoolAnswer,
+	Offline:                           writeBoolAnswer,
+	StoreArtifactsLocally:             writeBoolAnswer,
 	SocketTimeoutMillis:               ioutils.WriteIntAnswer,
 	LocalAddress:                      ioutils.WriteStringAnswer,
 	RetrievalCachePeriodSecs:          ioutils.WriteIntAnswer,
 	FailedRetrievalCachePeriodSecs:    ioutils.WriteIntAnswer,
 	MissedRetrievalCachePeriodSecs:    ioutils.WriteIntAnswer,
-	UnusedArtifactsCleanupEnabled:     ioutils.WriteBoolAnswer,
+	UnusedArtifactsCleanupEnabled:     writeBoolAnswer,
 	UnusedArtifactsCleanupPeriodHours: ioutils.WriteIntAnswer,
 	AssumedOfflinePeriodSecs:          ioutils.WriteIntAnswer,
-	FetchJarsEagerly:                  ioutils.WriteBoolAnswer,
-	FetchSourcesEagerly:               ioutils.WriteBoolAnswer,
-	ShareConfiguration:                ioutils.WriteBoolAnswer,
-	SynchronizeProperties:             ioutils.WriteBoolAnswer,
-	BlockMismatchingMimeTypes:         ioutils.WriteBoolAnswer,
-	AllowAnyHostAuth:                  ioutils.WriteBoolAnswer,
-	EnableCookieManagement:            ioutils.WriteBoolAnswer,
+	FetchJarsEagerly:                  writeBoolAnswer,
+	FetchSourcesEagerly:               writeBoolAnswer,
+	ShareConfiguration:                writeBoolAnswer,
+	SynchronizeProperties:             writeBoolAnswer,
+	BlockMismatchingMimeTypes:         writeBoolAnswer,
+	AllowAnyHostAuth:                  writeBoolAnswer,
+	EnableCookieManagement:            writeBoolAnswer,
 	BowerRegistryUrl:                  ioutils.WriteStringAnswer,
 	ComposerRegistryUrl:               ioutils.WriteStringAnswer,
 	PyPIRegistryUrl:                   ioutils.WriteStringAnswer,
 	VcsType:                           ioutils.WriteStringAnswer,
 	VcsGitProvider:                    ioutils.WriteStringAnswer,
 	VcsGitDownloadUrl:                 ioutils.WriteStringAnswer,
-	BypassHeadRequests:                ioutils.WriteBoolAnswer,
+	BypassHeadRequests:                writeBoolAnswer,
 	ClientTlsCertificate:              ioutils.WriteStringAnswer,
 	FeedContextPath:                   ioutils.WriteStringAnswer,
 	DownloadContextPath:               ioutils.WriteStringAnswer,
 	V3FeedUrl:                         ioutils.WriteStringAnswer,
 	ContentSynchronisation:            writeContentSynchronisation,
-	ListRemoteFolderItems:             ioutils.WriteBoolAnswer,
-	RejectInvalidJars:                 ioutils.WriteBoolAnswer,
+	ListRemoteFolderItems:             writeBoolAnswer,
+	RejectInvalidJars:                 writeBoolAnswer,
 	PodsSpecsRepoUrl:                  ioutils.WriteStringAnswer,
-	EnableTokenAuthentication:         ioutils.WriteBoolAnswer,
+	EnableTokenAuthentication:         writeBoolAnswer,
 	Repositories:                      ioutils.WriteStringArrayAnswer,
-	ArtifactoryRequestsCanRetrieveRemoteArtifacts: ioutils.WriteBoolAnswer,
+	ArtifactoryRequestsCanRetrieveRemoteArtifacts: writeBoolAnswer,
 	KeyPair:                              ioutils.WriteStringAnswer,
+	PrimaryKeyPairRef:                    ioutils.WriteStringAnswer,
+	SecondaryKeyPairRef:                  ioutils.WriteStringAnswer,
 	PomRepositoryReferencesCleanupPolicy: ioutils.WriteStringAnswer,
 	DefaultDeploymentRepo:                ioutils.WriteStringAnswer,
-	ForceMavenAuthentication:             ioutils.WriteBoolAnswer,
-	ForceNugetAuthentication:             ioutils.WriteBoolAnswer,
+	ForceMavenAuthentication:             writeBoolAnswer,
+	ForceNugetAuthentication:             writeBoolAnswer,
 	ExternalDependenciesRemoteRepo:       ioutils.WriteStringAnswer,
 }
 
+// Keys whose values must not be negative. For these keys, 0 means unlimited.
+var nonNegativeIntKeys = []string{MaxUniqueSnapshots, MaxUniqueTags}
+
+func writeNonNegativeIntAnswer(resultMap *map[string]interface{}, key, value string) error {
+	if err := validateNonNegativeInt(key, value); err != nil {
+		return err
+	}
+	return ioutils.WriteIntAnswer(resultMap, key, value)
+}
+
+// validateNonNegativeInt rejects negative values. Values which aren't integers are left to be rejected by the int writer.
+func validateNonNegativeInt(key, value string) error {
+	if intValue, err := strconv.Atoi(value); err == nil && intValue < 0 {
+		return errorutils.CheckErrorf("invalid value '%d' for '%s': the value must not be negative, use 0 for unlimited", intValue, key)
+	}
+	return nil
+}
+
+// writeBoolAnswer writes a boolean value, accepting the spellings of parseBoolAnswer. Values with variables which
+// weren't replaced are written as strings, as done by the other writers.
+func writeBoolAnswer(resultMap *map[string]interface{}, key, value string) error {
+	if boolValue, ok := parseBoolAnswer(value); ok {
+		(*resultMap)[key] = boolValue
+		return nil
+	}
+	if ioutils.VarPattern.MatchString(value) {
+		return ioutils.WriteStringAnswer(resultMap, key, value)
+	}
+	return errorutils.CheckErrorf("invalid value '%s' for '%s': expected a boolean, such as true/false, yes/no or on/off", value, key)
+}
+
+// parseBoolAnswer parses the common spellings of booleans in hand written templates, case-insensitively: true/false,
+// yes/no, on/off, as well as the values accepted by strconv.ParseBool, such as 1/0 and t/f.
+func parseBoolAnswer(value string) (boolValue bool, ok bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "on", "1", "t":
+		return true, true
+	case "false", "no", "off", "0", "f":
+		return false, true
+	}
+	return false, false
+}
+
 func writeContentSynchronisation(resultMap *map[string]interface{}, key, value string) error {
 	answerArray := strings.Split(value, ",")
 	if len(answerArray) != 4 {
@@ -293,6 +862,57 @@ func writeContentSynchronisation(resultMap *map[string]interface{}, key, value s
 	return nil
 }
 
+// contentSynchronisationObjectFields maps each nested object of the content synchronisation to its single boolean field.
+var contentSynchronisationObjectFields = map[string]string{
+	"statistics": "enabled",
+	"properties": "enabled",
+	"source":     "originAbsenceDetection",
+}
+
+func writeContentSynchronisationObject(resultMap *map[string]interface{}, key string, value map[string]interface{}) error {
+	cs, err := parseContentSynchronisationObject(value)
+	if err != nil {
+		return err
+	}
+	(*resultMap)[key] = cs
+	return nil
+}
+
+// parseContentSynchronisationObject validates a content synchronisation provided as a nested template object,
+// in the shape of services.ContentSynchronisation, and converts it to that struct.
+func parseContentSynchronisationObject(value map[string]interface{}) (cs services.ContentSynchronisation, err error) {
+	for field, fieldValue := range value {
+		if field == "enabled" {
+			if _, ok := fieldValue.(bool); !ok {
+				return cs, errorutils.CheckErrorf("invalid value for Content Synchronisation: '%s' must be a boolean", field)
+			}
+			continue
+		}
+		boolField, ok := contentSynchronisationObjectFields[field]
+		if !ok {
+			return cs, errorutils.CheckErrorf("invalid value for Content Synchronisation: unknown field '%s'", field)
+		}
+		fieldObject, ok := fieldValue.(map[string]interface{})
+		if !ok {
+			return cs, errorutils.CheckErrorf("invalid value for Content Synchronisation: '%s' must be an object", field)
+		}
+		for subField, subFieldValue := range fieldObject {
+			if subField != boolField {
+				return cs, errorutils.CheckErrorf("invalid value for Content Synchronisation: unknown field '%s.%s'", field, subField)
+			}
+			if _, ok = subFieldValue.(bool); !ok {
+				return cs, errorutils.CheckErrorf("invalid value for Content Synchronisation: '%s.%s' must be a boolean", field, subField)
+			}
+		}
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return cs, errorutils.CheckError(err)
+	}
+	err = errorutils.CheckError(json.Unmarshal(content, &cs))
+	return
+}
+
 // repoHandler is a function that gets serviceManager, JSON configuration content and a flag indicates is the operation in an update operation
 // Each handler unmarshal the JSOn content into the jfrog-client's unique rclass-pkgType param struct, and run the operation service
 type repoHandler func(artifactory.ArtifactoryServicesManager, []byte, bool) error
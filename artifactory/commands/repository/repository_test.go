@@ -1,15 +1,21 @@
 package repository
 
 import (
+	"bytes"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -57,6 +63,9 @@ func Test_PerformRepoCmd_SingleRepository(t *testing.T) {
 	for i, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if serveTestLicense(t, w, r) {
+					return
+				}
 				w.WriteHeader(tt.expectedStatus)
 
 				content, err := io.ReadAll(r.Body)
@@ -156,6 +165,9 @@ func Test_PerformRepoCmd_MultipleRepositories(t *testing.T) {
 	for i, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if serveTestLicense(t, w, r) {
+					return
+				}
 				switch r.URL.Path {
 				case "/api/system/version":
 					w.WriteHeader(http.StatusOK)
@@ -215,6 +227,7 @@ func Test_PerformRepoCmd_ErrorCases(t *testing.T) {
 		vars         string
 		isUpdate     bool
 		expErr       string
+		notExpErr    string
 	}{
 		{
 			name:         "Invalid JSON template",
@@ -230,6 +243,13 @@ func Test_PerformRepoCmd_ErrorCases(t *testing.T) {
 			isUpdate:     false,
 			expErr:       "'key' is missing in the following configs",
 		},
+		{
+			name:         "Missing key with credentials",
+			templatePath: createTempTemplate(t, missingKeyWithCredentialsTemplate),
+			isUpdate:     false,
+			expErr:       "password:" + redactedValue,
+			notExpErr:    "s3cr3t",
+		},
 		{
 			name:         "Unsupported package type",
 			templatePath: createTempTemplate(t, unsupportedPackageTemplate),
@@ -251,6 +271,9 @@ func Test_PerformRepoCmd_ErrorCases(t *testing.T) {
 
 			if err != nil {
 				assert.Contains(t, err.Error(), tt.expErr, "Testcase %v failed , expected error message to contain: %v, got: %v", i+1, tt.expErr, err.Error())
+				if tt.notExpErr != "" {
+					assert.NotContains(t, err.Error(), tt.notExpErr)
+				}
 			} else {
 				assert.Fail(t, "Testcase %v failed, expected error but got nil", i+1)
 			}
@@ -258,6 +281,256 @@ func Test_PerformRepoCmd_ErrorCases(t *testing.T) {
 	}
 }
 
+func Test_PerformRepoCmd_ValidateKeyPairRefs(t *testing.T) {
+	tests := []struct {
+		name                 string
+		vars                 string
+		validate             bool
+		expectedSecondaryRef string
+		expErr               string
+	}{
+		{
+			name:                 "Existing key pairs",
+			vars:                 "SECONDARY=gpg-secondary",
+			validate:             true,
+			expectedSecondaryRef: "gpg-secondary",
+		},
+		{
+			name:     "Missing secondary key pair",
+			vars:     "SECONDARY=gpg-missing",
+			validate: true,
+			expErr:   "'gpg-missing' (referenced by 'secondaryKeyPairRef' in repository 'test-debian-local')",
+		},
+		{
+			name:                 "Missing key pair without validation",
+			vars:                 "SECONDARY=gpg-missing",
+			validate:             false,
+			expectedSecondaryRef: "gpg-missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual *services.DebianLocalRepositoryParams
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if serveTestLicense(t, w, r) {
+					return
+				}
+				switch r.URL.Path {
+				case "/api/security/keypair/gpg-primary", "/api/security/keypair/gpg-secondary":
+					w.WriteHeader(http.StatusOK)
+				case "/api/security/keypair/gpg-missing":
+					w.WriteHeader(http.StatusNotFound)
+				default:
+					w.WriteHeader(http.StatusOK)
+
+					content, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+
+					actual = &services.DebianLocalRepositoryParams{}
+					require.NoError(t, json.Unmarshal(content, actual))
+				}
+			}))
+			defer testServer.Close()
+
+			repoCmd := &RepoCommand{
+				serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+				templatePath:  createTempTemplate(t, keyPairRefsTemplate),
+				vars:          tt.vars,
+				validate:      tt.validate,
+			}
+
+			err := repoCmd.PerformRepoCmd(false)
+			if tt.expErr != "" {
+				assert.ErrorContains(t, err, tt.expErr)
+				assert.Nil(t, actual)
+				return
+			}
+			assert.NoError(t, err)
+			require.NotNil(t, actual)
+			assert.Equal(t, "gpg-primary", actual.PrimaryKeyPairRef)
+			assert.Equal(t, tt.expectedSecondaryRef, actual.SecondaryKeyPairRef)
+		})
+	}
+}
+
+func Test_PerformRepoCmd_RollbackOnFailure(t *testing.T) {
+	tests := []struct {
+		name              string
+		rollbackOnFailure bool
+		expectedDeleted   []string
+	}{
+		{
+			name:              "Rollback deletes only the repositories created in this run",
+			rollbackOnFailure: true,
+			expectedDeleted:   []string{"test-maven"},
+		},
+		{
+			name:              "No rollback without the flag",
+			rollbackOnFailure: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// test-npm exists before the run, the batch creation fails after creating test-maven.
+			existingRepos := map[string]bool{"test-npm": true}
+			var deletedRepos []string
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/api/system/version":
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write([]byte(`{"version":"7.104.2"}`))
+					require.NoError(t, err)
+				case r.URL.Path == "/api/v2/repositories/batch":
+					existingRepos["test-maven"] = true
+					w.WriteHeader(http.StatusInternalServerError)
+				case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+					repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+					if r.Method == http.MethodDelete {
+						deletedRepos = append(deletedRepos, repoKey)
+						delete(existingRepos, repoKey)
+						w.WriteHeader(http.StatusOK)
+						return
+					}
+					if existingRepos[repoKey] {
+						w.WriteHeader(http.StatusOK)
+						return
+					}
+					w.WriteHeader(http.StatusBadRequest)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer testServer.Close()
+
+			repoCmd := &RepoCommand{
+				serverDetails:     &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+				templatePath:      createTempTemplate(t, multipleReposTemplate),
+				vars:              "MAVEN_REPO=test-maven;DOCKER_REPO=test-docker;NPM_REPO=test-npm",
+				rollbackOnFailure: tt.rollbackOnFailure,
+			}
+
+			err := repoCmd.PerformRepoCmd(false)
+			assert.Error(t, err)
+			assert.Equal(t, tt.expectedDeleted, deletedRepos)
+			assert.True(t, existingRepos["test-npm"])
+		})
+	}
+}
+
+func Test_PerformRepoCmd_RollbackOnFailureAcrossBatches(t *testing.T) {
+	// The multiple repositories template is created successfully, then the creation of the single repository template fails.
+	templateDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(templateDir, "a.json"), []byte(`[{"key": "test-maven", "rclass": "local", "packageType": "maven"}, {"key": "test-npm", "rclass": "local", "packageType": "npm"}]`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(templateDir, "b.json"), []byte(`{"key": "test-generic", "rclass": "local", "packageType": "generic"}`), 0600))
+
+	existingRepos := map[string]bool{"test-npm": true}
+	var deletedRepos []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/system/version":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"version":"7.104.2"}`))
+			require.NoError(t, err)
+		case r.URL.Path == "/api/v2/repositories/batch":
+			existingRepos["test-maven"] = true
+			w.WriteHeader(http.StatusOK)
+		case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+			repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+			switch {
+			case r.Method == http.MethodDelete:
+				deletedRepos = append(deletedRepos, repoKey)
+				delete(existingRepos, repoKey)
+				w.WriteHeader(http.StatusOK)
+			case r.Method == http.MethodPut:
+				w.WriteHeader(http.StatusInternalServerError)
+			case existingRepos[repoKey]:
+				w.WriteHeader(http.StatusOK)
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+
+	repoCmd := &RepoCommand{
+		serverDetails:     &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+		templatePath:      templateDir,
+		rollbackOnFailure: true,
+	}
+
+	assert.Error(t, repoCmd.PerformRepoCmd(false))
+	assert.Equal(t, []string{"test-maven"}, deletedRepos)
+	assert.True(t, existingRepos["test-npm"])
+}
+
+func Test_ValidateEnvironmentsCount(t *testing.T) {
+	tests := []struct {
+		name               string
+		environments       interface{}
+		artifactoryVersion string
+		expErr             string
+	}{
+		{
+			name:               "Single environment on an old server",
+			environments:       "DEV",
+			artifactoryVersion: "7.90.0",
+		},
+		{
+			name:               "Multiple environments on an old server",
+			environments:       "DEV,PROD",
+			artifactoryVersion: "7.90.0",
+			expErr:             "only one environment per repository is supported by Artifactory 7.90.0",
+		},
+		{
+			name:               "Multiple environments as an array on an old server",
+			environments:       []interface{}{"DEV", "PROD"},
+			artifactoryVersion: "7.90.0",
+			expErr:             "test-repo",
+		},
+		{
+			name:               "Multiple environments on a new server",
+			environments:       "DEV,PROD",
+			artifactoryVersion: minArtifactoryVersionForMultipleEnvironments,
+		},
+		{
+			name: "No environments",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/system/version" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"version":"` + tt.artifactoryVersion + `"}`))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, -1, 0, false)
+			require.NoError(t, err)
+
+			repoConfigMap := map[string]interface{}{Key: "test-repo"}
+			if tt.environments != nil {
+				repoConfigMap[environmentsKey] = tt.environments
+			}
+			err = validateEnvironmentsCount([]map[string]interface{}{repoConfigMap}, servicesManager)
+			if tt.expErr != "" {
+				assert.ErrorContains(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func createTempTemplate(t *testing.T, content string) string {
 	tmpFile, err := os.CreateTemp("", "repo-template-*.json")
 	require.NoError(t, err)
@@ -309,9 +582,465 @@ const missingFieldsTemplate = `{
   "description": "${DESCRIPTION}"
 }`
 
+const missingKeyWithCredentialsTemplate = `{
+  "rclass": "remote",
+  "packageType": "maven",
+  "username": "deployer",
+  "password": "s3cr3t"
+}`
+
 const unsupportedPackageTemplate = `{
   "key": "${REPO_KEY}",
   "rclass": "local",
   "packageType": "unsupported",
   "description": "${DESCRIPTION}"
 }`
+
+const keyPairRefsTemplate = `{
+  "key": "test-debian-local",
+  "rclass": "local",
+  "packageType": "debian",
+  "primaryKeyPairRef": "gpg-primary",
+  "secondaryKeyPairRef": "${SECONDARY}"
+}`
+
+func Test_AnalyzeVirtualRepoPatterns(t *testing.T) {
+	tests := []struct {
+		name   string
+		config map[string]interface{}
+		strict bool
+		expErr string
+	}{
+		{
+			name:   "Valid patterns",
+			config: map[string]interface{}{Key: "virtual", Rclass: Virtual, IncludePatterns: "org/**,com/**", ExcludePatterns: "org/internal/**"},
+			strict: true,
+		},
+		{
+			name:   "Exclude masking all includes in strict mode",
+			config: map[string]interface{}{Key: "virtual", Rclass: Virtual, IncludePatterns: "org/**", ExcludePatterns: "**"},
+			strict: true,
+			expErr: "the exclude pattern '**' masks all includes",
+		},
+		{
+			name:   "Identical include and exclude in strict mode",
+			config: map[string]interface{}{Key: "virtual", Rclass: Virtual, IncludePatterns: "org/**, com/**", ExcludePatterns: "com/**"},
+			strict: true,
+			expErr: "the include pattern 'com/**' is masked by the exclude pattern 'com/**'",
+		},
+		{
+			name:   "Include masked by a broader exclude in strict mode",
+			config: map[string]interface{}{Key: "virtual", Rclass: Virtual, IncludePatterns: "org/**,org/acme/**", ExcludePatterns: "com/**,org/acme/"},
+			strict: true,
+			expErr: "the include pattern 'org/acme/**' is masked by the exclude pattern 'org/acme/'",
+		},
+		{
+			name:   "Include partially overlapping an exclude",
+			config: map[string]interface{}{Key: "virtual", Rclass: Virtual, IncludePatterns: "org/**,**/*.jar", ExcludePatterns: "org/acme/**,**/*-sources.jar"},
+			strict: true,
+		},
+		{
+			name:   "Invalid pattern syntax in strict mode",
+			config: map[string]interface{}{Key: "virtual", Rclass: Virtual, IncludePatterns: "/org/**,,org\\com"},
+			strict: true,
+			expErr: "must be relative to the repository root",
+		},
+		{
+			name:   "Conflicting patterns without strict mode",
+			config: map[string]interface{}{Key: "virtual", Rclass: Virtual, IncludePatterns: "org/**", ExcludePatterns: "**/*"},
+			strict: false,
+		},
+		{
+			name:   "Non virtual repositories are ignored",
+			config: map[string]interface{}{Key: "local", Rclass: Local, IncludePatterns: "org/**", ExcludePatterns: "**"},
+			strict: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := analyzeVirtualRepoPatterns([]map[string]interface{}{tt.config}, tt.strict)
+			if tt.expErr != "" {
+				assert.ErrorContains(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_AnnotateRepoConfigs(t *testing.T) {
+	repoConfigMaps := []map[string]interface{}{
+		{"key": "annotated-repo"},
+		{"key": "empty-notes-repo", "notes": ""},
+		{"key": "user-notes-repo", "notes": "Managed by the platform team"},
+	}
+	provisionTime := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+	annotateRepoConfigs(repoConfigMaps, "templates/repos.json", provisionTime)
+
+	for _, repoConfigMap := range repoConfigMaps[:2] {
+		notes, ok := repoConfigMap["notes"].(string)
+		require.True(t, ok)
+		assert.Contains(t, notes, "2024-05-01T10:30:00Z")
+		assert.Contains(t, notes, "templates/repos.json")
+		assert.True(t, strings.HasPrefix(notes, "Provisioned by "))
+	}
+	assert.Equal(t, "Managed by the platform team", repoConfigMaps[2]["notes"])
+}
+
+func Test_ConvertTemplateToMaps_Stdin(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		vars          string
+		expected      interface{}
+		expectedError string
+	}{
+		{
+			name:     "Single repository",
+			content:  `{"key": "${name}-local", "rclass": "local"}`,
+			vars:     "name=stdin",
+			expected: map[string]interface{}{"key": "stdin-local", "rclass": "local"},
+		},
+		{
+			name:     "Multiple repositories",
+			content:  `[{"key": "repo1"}, {"key": "repo2"}]`,
+			expected: []map[string]interface{}{{"key": "repo1"}, {"key": "repo2"}},
+		},
+		{
+			name:          "Empty input",
+			content:       " \n",
+			expectedError: "no template was provided on the standard input",
+		},
+		{
+			name:          "Invalid template",
+			content:       `{"key": "repo1"`,
+			expectedError: "failed to parse the template read from the standard input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			originalStdin := templateStdin
+			templateStdin = strings.NewReader(tt.content)
+			defer func() { templateStdin = originalStdin }()
+
+			rc := &RepoCommand{templatePath: stdinTemplatePath, vars: tt.vars}
+			configs, err := rc.convertTemplateToMaps()
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, configs)
+		})
+	}
+}
+
+func Test_ParseContentSynchronisationObject(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         map[string]interface{}
+		expected      services.ContentSynchronisation
+		expectedError string
+	}{
+		{
+			name: "All fields",
+			value: map[string]interface{}{
+				"enabled":    true,
+				"statistics": map[string]interface{}{"enabled": false},
+				"properties": map[string]interface{}{"enabled": true},
+				"source":     map[string]interface{}{"originAbsenceDetection": true},
+			},
+			expected: services.ContentSynchronisation{
+				Enabled:    clientUtils.Pointer(true),
+				Statistics: &services.ContentSynchronisationStatistics{Enabled: clientUtils.Pointer(false)},
+				Properties: &services.ContentSynchronisationProperties{Enabled: clientUtils.Pointer(true)},
+				Source:     &services.ContentSynchronisationSource{OriginAbsenceDetection: clientUtils.Pointer(true)},
+			},
+		},
+		{
+			name:     "Partial fields",
+			value:    map[string]interface{}{"enabled": true},
+			expected: services.ContentSynchronisation{Enabled: clientUtils.Pointer(true)},
+		},
+		{
+			name:          "Non boolean field",
+			value:         map[string]interface{}{"enabled": "true"},
+			expectedError: "'enabled' must be a boolean",
+		},
+		{
+			name:          "Non boolean sub-field",
+			value:         map[string]interface{}{"source": map[string]interface{}{"originAbsenceDetection": "yes"}},
+			expectedError: "'source.originAbsenceDetection' must be a boolean",
+		},
+		{
+			name:          "Non object field",
+			value:         map[string]interface{}{"statistics": true},
+			expectedError: "'statistics' must be an object",
+		},
+		{
+			name:          "Unknown sub-field",
+			value:         map[string]interface{}{"properties": map[string]interface{}{"enable": true}},
+			expectedError: "unknown field 'properties.enable'",
+		},
+		{
+			name:          "Unknown field",
+			value:         map[string]interface{}{"stats": map[string]interface{}{"enabled": true}},
+			expectedError: "unknown field 'stats'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cs, err := parseContentSynchronisationObject(tt.value)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cs)
+		})
+	}
+}
+
+func Test_PerformRepoCmd_ContentSynchronisationObject(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var actual services.RemoteRepositoryBaseParams
+		require.NoError(t, json.Unmarshal(content, &actual))
+		require.NotNil(t, actual.ContentSynchronisation)
+		assert.Equal(t, clientUtils.Pointer(true), actual.ContentSynchronisation.Enabled)
+		assert.Equal(t, clientUtils.Pointer(false), actual.ContentSynchronisation.Statistics.Enabled)
+		assert.Nil(t, actual.ContentSynchronisation.Properties)
+		assert.Equal(t, clientUtils.Pointer(true), actual.ContentSynchronisation.Source.OriginAbsenceDetection)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	template := `{
+		"key": "generic-remote",
+		"rclass": "remote",
+		"packageType": "generic",
+		"url": "https://example.com",
+		"contentSynchronisation": {"enabled": true, "statistics": {"enabled": false}, "source": {"originAbsenceDetection": true}}
+	}`
+	repoCmd := &RepoCommand{
+		serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+		templatePath:  createTempTemplate(t, template),
+	}
+	assert.NoError(t, repoCmd.PerformRepoCmd(false))
+}
+
+func Test_PerformRepoCmd_VirtualKeyPairRefs(t *testing.T) {
+	tests := []struct {
+		name        string
+		packageType string
+	}{
+		{name: "Debian virtual repository", packageType: Debian},
+		{name: "Docker virtual repository", packageType: Docker},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoKey := tt.packageType + "-virtual"
+			var requested bool
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if serveTestLicense(t, w, r) {
+					return
+				}
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/api/repositories/"+repoKey, r.URL.Path)
+				content, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				var actual services.VirtualRepositoryBaseParams
+				require.NoError(t, json.Unmarshal(content, &actual))
+				assert.Equal(t, tt.packageType, actual.PackageType)
+				assert.Equal(t, "primary-key", actual.PrimaryKeyPairRef)
+				assert.Equal(t, "secondary-key", actual.SecondaryKeyPairRef)
+				requested = true
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer testServer.Close()
+
+			template := `{
+				"key": "` + repoKey + `",
+				"rclass": "virtual",
+				"packageType": "` + tt.packageType + `",
+				"primaryKeyPairRef": "primary-key",
+				"secondaryKeyPairRef": "secondary-key"
+			}`
+			repoCmd := &RepoCommand{
+				serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+				templatePath:  createTempTemplate(t, template),
+				strict:        true,
+			}
+			assert.NoError(t, repoCmd.PerformRepoCmd(false))
+			assert.True(t, requested)
+		})
+	}
+}
+
+func Test_WriteNonNegativeIntAnswer(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		value         string
+		expected      int
+		expectedError string
+	}{
+		{name: "Positive max unique snapshots", key: MaxUniqueSnapshots, value: "5", expected: 5},
+		{name: "Zero max unique snapshots", key: MaxUniqueSnapshots, value: "0", expected: 0},
+		{name: "Negative max unique snapshots", key: MaxUniqueSnapshots, value: "-1", expectedError: "invalid value '-1' for 'maxUniqueSnapshots'"},
+		{name: "Positive max unique tags", key: MaxUniqueTags, value: "10", expected: 10},
+		{name: "Zero max unique tags", key: MaxUniqueTags, value: "0", expected: 0},
+		{name: "Negative max unique tags", key: MaxUniqueTags, value: "-3", expectedError: "invalid value '-3' for 'maxUniqueTags'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resultMap := map[string]interface{}{}
+			err := writersMap[tt.key](&resultMap, tt.key, tt.value)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				assert.NotContains(t, resultMap, tt.key)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resultMap[tt.key])
+		})
+	}
+}
+
+func Test_WriteBoolAnswer(t *testing.T) {
+	tests := []struct {
+		value         string
+		expected      interface{}
+		expectedError string
+	}{
+		{value: "true", expected: true},
+		{value: "True", expected: true},
+		{value: "TRUE", expected: true},
+		{value: "yes", expected: true},
+		{value: "YES", expected: true},
+		{value: "on", expected: true},
+		{value: "On", expected: true},
+		{value: "1", expected: true},
+		{value: " true ", expected: true},
+		{value: "false", expected: false},
+		{value: "False", expected: false},
+		{value: "no", expected: false},
+		{value: "NO", expected: false},
+		{value: "off", expected: false},
+		{value: "OFF", expected: false},
+		{value: "0", expected: false},
+		{value: "${enabled}", expected: "${enabled}"},
+		{value: "enabled", expectedError: "invalid value 'enabled' for 'blackedOut': expected a boolean"},
+		{value: "", expectedError: "invalid value '' for 'blackedOut': expected a boolean"},
+		{value: "yess", expectedError: "invalid value 'yess' for 'blackedOut': expected a boolean"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			resultMap := map[string]interface{}{}
+			err := writersMap[BlackedOut](&resultMap, BlackedOut, tt.value)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				assert.NotContains(t, resultMap, BlackedOut)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, resultMap[BlackedOut])
+		})
+	}
+}
+
+func Test_MultipleRepositoryHandler_NegativeInt(t *testing.T) {
+	repoConfigMaps := []map[string]interface{}{
+		{Key: "docker-local", Rclass: Local, PackageType: Docker, MaxUniqueTags: float64(-1)},
+	}
+	err := (&MultipleRepositoryHandler{}).Execute(repoConfigMaps, nil, false)
+	assert.ErrorContains(t, err, "invalid value '-1' for 'maxUniqueTags'")
+}
+
+func Test_PerformRepoCmd_MultipleRepositoriesBoolValues(t *testing.T) {
+	tests := []struct {
+		name          string
+		template      string
+		expected      []map[string]interface{}
+		expectedError string
+	}{
+		{
+			name: "Spellings of booleans",
+			template: `[
+  {"key": "generic-local", "rclass": "local", "packageType": "generic", "blackedOut": "yes", "xrayIndex": "1"},
+  {"key": "maven-local", "rclass": "local", "packageType": "maven", "handleReleases": "off", "handleSnapshots": true}
+]`,
+			expected: []map[string]interface{}{
+				{Key: "generic-local", Rclass: Local, PackageType: Generic, BlackedOut: true, XrayIndex: true},
+				{Key: "maven-local", Rclass: Local, PackageType: Maven, HandleReleases: false, HandleSnapshots: true},
+			},
+		},
+		{
+			name:          "Invalid boolean",
+			template:      `[{"key": "generic-local", "rclass": "local", "packageType": "generic", "blackedOut": "enabled"}]`,
+			expectedError: "invalid value 'enabled' for 'blackedOut': expected a boolean",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []map[string]interface{}
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if serveTestLicense(t, w, r) {
+					return
+				}
+				if r.URL.Path == "/api/v2/repositories/batch" {
+					content, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					require.NoError(t, json.Unmarshal(content, &actual))
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer testServer.Close()
+
+			repoCmd := &RepoCommand{
+				serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+				templatePath:  createTempTemplate(t, tt.template),
+			}
+			err := repoCmd.PerformRepoCmd(false)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				assert.Nil(t, actual)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_SingleRepositoryHandler_Progress(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, -1, 0, false)
+	require.NoError(t, err)
+
+	repoConfigMaps := []map[string]interface{}{
+		{Key: "generic-local-1", Rclass: Local, PackageType: Generic},
+		{Key: "generic-local-2", Rclass: Local, PackageType: Generic},
+	}
+	var progress bytes.Buffer
+	require.NoError(t, (&SingleRepositoryHandler{progressWriter: &progress}).Execute(repoConfigMaps, servicesManager, false))
+	assert.Equal(t, "Processing 1/2: generic-local-1\nProcessing 2/2: generic-local-2\n", progress.String())
+
+	// A single repository has no progress to report.
+	progress.Reset()
+	require.NoError(t, (&SingleRepositoryHandler{progressWriter: &progress}).Execute(repoConfigMaps[:1], servicesManager, false))
+	assert.Empty(t, progress.String())
+}
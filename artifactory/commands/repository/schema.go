@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/c-bata/go-prompt"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	stringValueType      = "string"
+	boolValueType        = "bool"
+	intValueType         = "int"
+	stringArrayValueType = "string-array"
+	specialValueType     = "special"
+)
+
+var writersValueTypes = []struct {
+	writer    ioutils.AnswerWriter
+	valueType string
+}{
+	{ioutils.WriteStringAnswer, stringValueType},
+	{ioutils.WriteBoolAnswer, boolValueType},
+	{writeBoolAnswer, boolValueType},
+	{ioutils.WriteIntAnswer, intValueType},
+	{writeNonNegativeIntAnswer, intValueType},
+	{ioutils.WriteStringArrayAnswer, stringArrayValueType},
+}
+
+// Keys which are asked by the questionnaire under a different name than the one written to the template.
+var questionnaireKeysToTemplateKeys = map[string]string{
+	Environment: environmentsKey,
+}
+
+type RepoTemplateKeySchema struct {
+	Key       string `json:"key"`
+	ValueType string `json:"valueType"`
+	// Maps each rclass to the package types the key applies to.
+	ApplicableTo map[string][]string `json:"applicableTo,omitempty"`
+}
+
+type RepoTemplateSchemaCommand struct {
+	format string
+}
+
+func NewRepoTemplateSchemaCommand() *RepoTemplateSchemaCommand {
+	return &RepoTemplateSchemaCommand{}
+}
+
+func (rtsc *RepoTemplateSchemaCommand) SetFormat(format string) *RepoTemplateSchemaCommand {
+	rtsc.format = format
+	return rtsc
+}
+
+func (rtsc *RepoTemplateSchemaCommand) ServerDetails() (*config.ServerDetails, error) {
+	// Since it's a local command, usage won't be reported.
+	return nil, nil
+}
+
+func (rtsc *RepoTemplateSchemaCommand) CommandName() string {
+	return "rt_repo_template_schema"
+}
+
+func (rtsc *RepoTemplateSchemaCommand) Run() error {
+	schema := GetRepoTemplateSchema()
+	switch rtsc.format {
+	case "json":
+		content, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+		return nil
+	case "", "table":
+		return printSchemaTable(schema)
+	default:
+		return errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'table', 'json'", rtsc.format)
+	}
+}
+
+// GetRepoTemplateSchema returns the schema of all the supported template keys, sorted by key.
+// The keys and value types are derived from the writersMap, and the applicable package types from the handler maps.
+func GetRepoTemplateSchema() []RepoTemplateKeySchema {
+	applicability := getKeysApplicability()
+	var schema []RepoTemplateKeySchema
+	for key, writer := range writersMap {
+		schema = append(schema, RepoTemplateKeySchema{
+			Key:          key,
+			ValueType:    getWriterValueType(writer),
+			ApplicableTo: applicability[key],
+		})
+	}
+	sort.Slice(schema, func(i, j int) bool {
+		return schema[i].Key < schema[j].Key
+	})
+	return schema
+}
+
+func getWriterValueType(writer ioutils.AnswerWriter) string {
+	writerPointer := reflect.ValueOf(writer).Pointer()
+	for _, writerValueType := range writersValueTypes {
+		if reflect.ValueOf(writerValueType.writer).Pointer() == writerPointer {
+			return writerValueType.valueType
+		}
+	}
+	return specialValueType
+}
+
+// getKeysApplicability maps each template key to the rclasses and package types it applies to.
+func getKeysApplicability() map[string]map[string][]string {
+	applicability := make(map[string]map[string][]string)
+	for rclass, pkgTypes := range getRclassesPackageTypes() {
+		for _, pkgType := range pkgTypes {
+			mandatoryKeys := []string{Key, Rclass, PackageType}
+			if rclass == Remote {
+				mandatoryKeys = append(mandatoryKeys, MandatoryUrl)
+			}
+			for _, key := range append(mandatoryKeys, getRclassTemplateKeys(rclass, pkgType, Update)...) {
+				if _, ok := applicability[key]; !ok {
+					applicability[key] = make(map[string][]string)
+				}
+				if !slices.Contains(applicability[key][rclass], pkgType) {
+					applicability[key][rclass] = append(applicability[key][rclass], pkgType)
+				}
+			}
+		}
+	}
+	for _, rclasses := range applicability {
+		for rclass := range rclasses {
+			sort.Strings(rclasses[rclass])
+		}
+	}
+	return applicability
+}
+
+// getRclassTemplateKeys returns the optional template keys of an rclass and package type, as written to the template.
+// Questionnaire entries which aren't template keys, such as the save and exit option, are skipped.
+func getRclassTemplateKeys(rclass, pkgType, templateType string) []string {
+	var suggests []prompt.Suggest
+	switch rclass {
+	case Local, Federated:
+		suggests = getLocalRepoConfKeys(pkgType)
+	case Remote:
+		suggests = getRemoteRepoConfKeys(pkgType, templateType)
+	case Virtual:
+		suggests = getVirtualRepoConfKeys(pkgType)
+	}
+	var keys []string
+	for _, key := range suggestsToKeys(suggests) {
+		if templateKey, ok := questionnaireKeysToTemplateKeys[key]; ok {
+			key = templateKey
+		}
+		if _, ok := writersMap[key]; !ok || slices.Contains(keys, key) {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// getRclassesPackageTypes returns the package types supported by each rclass, according to the handlers registry.
+func getRclassesPackageTypes() map[string][]string {
+	rclassesPkgTypes := make(map[string][]string, len(rclassesRepoHandlers))
+	for rclass, handlers := range rclassesRepoHandlers {
+		for pkgType := range handlers {
+			rclassesPkgTypes[rclass] = append(rclassesPkgTypes[rclass], pkgType)
+		}
+		sort.Strings(rclassesPkgTypes[rclass])
+	}
+	return rclassesPkgTypes
+}
+
+func suggestsToKeys(suggests []prompt.Suggest) []string {
+	keys := make([]string, 0, len(suggests))
+	for _, suggest := range suggests {
+		keys = append(keys, suggest.Text)
+	}
+	return keys
+}
+
+func printSchemaTable(schema []RepoTemplateKeySchema) error {
+	rclassesPkgTypes := getRclassesPackageTypes()
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(writer, "KEY\tTYPE\tAPPLIES TO"); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, keySchema := range schema {
+		if _, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", keySchema.Key, keySchema.ValueType, formatApplicability(keySchema.ApplicableTo, rclassesPkgTypes)); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return errorutils.CheckError(writer.Flush())
+}
+
+// formatApplicability describes the applicability of a key in a single line, using 'all' when a key applies to all the package types of an rclass.
+func formatApplicability(applicableTo map[string][]string, rclassesPkgTypes map[string][]string) string {
+	if len(applicableTo) == 0 {
+		return "-"
+	}
+	var parts []string
+	for _, rclass := range []string{Local, Remote, Virtual, Federated} {
+		pkgTypes, ok := applicableTo[rclass]
+		if !ok {
+			continue
+		}
+		if len(pkgTypes) == len(rclassesPkgTypes[rclass]) {
+			parts = append(parts, rclass+": all")
+			continue
+		}
+		parts = append(parts, rclass+": "+strings.Join(pkgTypes, ","))
+	}
+	return strings.Join(parts, "; ")
+}
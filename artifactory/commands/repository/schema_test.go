@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetRepoTemplateSchema(t *testing.T) {
+	schema := GetRepoTemplateSchema()
+	keysSchema := make(map[string]RepoTemplateKeySchema, len(schema))
+	for _, keySchema := range schema {
+		keysSchema[keySchema.Key] = keySchema
+	}
+
+	primaryKeyPairRef, ok := keysSchema[PrimaryKeyPairRef]
+	require.True(t, ok)
+	assert.Equal(t, stringValueType, primaryKeyPairRef.ValueType)
+	assert.Contains(t, primaryKeyPairRef.ApplicableTo[Local], Debian)
+	assert.Contains(t, primaryKeyPairRef.ApplicableTo[Local], Alpine)
+
+	contentSynchronisation, ok := keysSchema[ContentSynchronisation]
+	require.True(t, ok)
+	assert.Equal(t, specialValueType, contentSynchronisation.ValueType)
+	assert.Contains(t, contentSynchronisation.ApplicableTo, Remote)
+
+	environments, ok := keysSchema[environmentsKey]
+	require.True(t, ok)
+	assert.NotEmpty(t, environments.ApplicableTo[Local])
+
+	_, ok = keysSchema[Environment]
+	assert.False(t, ok)
+}
+
+func Test_FormatApplicability(t *testing.T) {
+	rclassesPkgTypes := map[string][]string{Local: {Debian, Generic}, Remote: {Debian, Generic}}
+	assert.Equal(t, "-", formatApplicability(nil, rclassesPkgTypes))
+	assert.Equal(t, "local: all; remote: debian", formatApplicability(map[string][]string{
+		Remote: {Debian},
+		Local:  {Debian, Generic},
+	}, rclassesPkgTypes))
+}
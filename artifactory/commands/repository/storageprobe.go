@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The fragments of the storage types of cloud storage filestores, such as s3-storage-v3, google-storage-v2 and
+// azure-blob-storage-v2, including their cluster and sharding variants.
+var cloudStorageTypeFragments = []string{"s3", "google", "gcs", "azure"}
+
+// storageProbe queries the storage capabilities of the Artifactory instance. The storage info is fetched on first use
+// only, and is reused for the rest of the run.
+type storageProbe struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+	storageType     *string
+}
+
+func newStorageProbe(servicesManager artifactory.ArtifactoryServicesManager) *storageProbe {
+	return &storageProbe{servicesManager: servicesManager}
+}
+
+func (sp *storageProbe) getStorageType() (string, error) {
+	if sp.storageType != nil {
+		return *sp.storageType, nil
+	}
+	storageInfo, err := sp.servicesManager.GetStorageInfo()
+	if err != nil {
+		return "", err
+	}
+	storageType := storageInfo.FileStoreSummary.StorageType
+	sp.storageType = &storageType
+	return storageType, nil
+}
+
+// isCloudStorage returns whether the filestore is a cloud storage provider, along with its storage type.
+func (sp *storageProbe) isCloudStorage() (bool, string, error) {
+	storageType, err := sp.getStorageType()
+	if err != nil {
+		return false, "", err
+	}
+	lowerStorageType := strings.ToLower(storageType)
+	for _, fragment := range cloudStorageTypeFragments {
+		if strings.Contains(lowerStorageType, fragment) {
+			return true, storageType, nil
+		}
+	}
+	return false, storageType, nil
+}
+
+// validateCdnRedirectStorage detects repositories enabling cdnRedirect on an instance whose filestore isn't a cloud
+// storage provider, on which the setting is silently ineffective. The storage info is queried only if cdnRedirect is
+// enabled. Problems are reported as warnings, unless strict is set, in which case they are fatal.
+func validateCdnRedirectStorage(repoConfigMaps []map[string]interface{}, probe *storageProbe, strict bool) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		value, ok := repoConfigMap[CdnRedirect]
+		if !ok || !isSettingEnabled(CdnRedirect, value) {
+			continue
+		}
+		isCloud, storageType, err := probe.isCloudStorage()
+		if err != nil {
+			return err
+		}
+		if isCloud {
+			return nil
+		}
+		problems = append(problems, fmt.Sprintf("repository '%v': '%s' has no effect, as the Artifactory filestore is '%s' rather than a cloud storage provider", repoConfigMap[Key], CdnRedirect, storageType))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if strict {
+		return errorutils.CheckErrorf("storage provider check failed:\n%s", strings.Join(problems, "\n"))
+	}
+	for _, problem := range problems {
+		log.Warn(problem)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCdnRedirectStorage(t *testing.T) {
+	tests := []struct {
+		name            string
+		storageType     string
+		repoConfigMaps  []map[string]interface{}
+		strict          bool
+		expectError     bool
+		expectedQueries int
+	}{
+		{
+			name:            "CDN redirect on file system filestore",
+			storageType:     "file-system",
+			repoConfigMaps:  []map[string]interface{}{{Key: "npm-local", CdnRedirect: "true"}, {Key: "maven-local", CdnRedirect: true}},
+			expectedQueries: 1,
+		},
+		{
+			name:            "CDN redirect on file system filestore with strict",
+			storageType:     "file-system",
+			repoConfigMaps:  []map[string]interface{}{{Key: "npm-local", CdnRedirect: "true"}, {Key: "maven-local", CdnRedirect: true}},
+			strict:          true,
+			expectError:     true,
+			expectedQueries: 1,
+		},
+		{
+			name:            "CDN redirect on S3 filestore",
+			storageType:     "cluster-s3-storage-v3",
+			repoConfigMaps:  []map[string]interface{}{{Key: "npm-local", CdnRedirect: "true"}},
+			strict:          true,
+			expectedQueries: 1,
+		},
+		{
+			name:           "CDN redirect disabled",
+			storageType:    "file-system",
+			repoConfigMaps: []map[string]interface{}{{Key: "npm-local", CdnRedirect: "false"}, {Key: "maven-local"}},
+			strict:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queries := 0
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/storageinfo" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				queries++
+				_, err := w.Write([]byte(`{"fileStoreSummary":{"storageType":"` + tt.storageType + `"}}`))
+				require.NoError(t, err)
+			}))
+			defer testServer.Close()
+
+			servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, -1, 0, false)
+			require.NoError(t, err)
+			err = validateCdnRedirectStorage(tt.repoConfigMaps, newStorageProbe(servicesManager), tt.strict)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "has no effect, as the Artifactory filestore is 'file-system'")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedQueries, queries)
+		})
+	}
+}
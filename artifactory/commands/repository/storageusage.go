@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The storage summary lists the total of all the repositories as a repository with this key
+const storageSummaryTotalRepoKey = "TOTAL"
+
+// RepoStorageUsage is the storage usage of a single repository, or the total of several repositories.
+type RepoStorageUsage struct {
+	RepoKey          string `json:"repoKey,omitempty"`
+	RepoType         string `json:"repoType,omitempty"`
+	PackageType      string `json:"packageType,omitempty"`
+	ProjectKey       string `json:"projectKey,omitempty"`
+	FilesCount       int64  `json:"filesCount"`
+	FoldersCount     int64  `json:"foldersCount"`
+	UsedSpaceInBytes int64  `json:"usedSpaceInBytes"`
+	UsedSpace        string `json:"usedSpace"`
+}
+
+type RepoStorageUsageReport struct {
+	Repositories []RepoStorageUsage `json:"repositories"`
+	Total        RepoStorageUsage   `json:"total"`
+}
+
+// RepoStorageUsageCommand prints the used space and the files and folders count of the repositories matching a pattern,
+// as reported by the storage summary of Artifactory.
+type RepoStorageUsageCommand struct {
+	serverDetails *config.ServerDetails
+	repoPattern   string
+	project       string
+	format        string
+	refresh       bool
+}
+
+func NewRepoStorageUsageCommand() *RepoStorageUsageCommand {
+	return &RepoStorageUsageCommand{}
+}
+
+func (rsuc *RepoStorageUsageCommand) SetRepoPattern(repoPattern string) *RepoStorageUsageCommand {
+	rsuc.repoPattern = repoPattern
+	return rsuc
+}
+
+func (rsuc *RepoStorageUsageCommand) SetProject(project string) *RepoStorageUsageCommand {
+	rsuc.project = project
+	return rsuc
+}
+
+func (rsuc *RepoStorageUsageCommand) SetFormat(format string) *RepoStorageUsageCommand {
+	rsuc.format = format
+	return rsuc
+}
+
+func (rsuc *RepoStorageUsageCommand) SetRefresh(refresh bool) *RepoStorageUsageCommand {
+	rsuc.refresh = refresh
+	return rsuc
+}
+
+func (rsuc *RepoStorageUsageCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoStorageUsageCommand {
+	rsuc.serverDetails = serverDetails
+	return rsuc
+}
+
+func (rsuc *RepoStorageUsageCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rsuc.serverDetails, nil
+}
+
+func (rsuc *RepoStorageUsageCommand) CommandName() string {
+	return "rt_repo_storage_usage"
+}
+
+func (rsuc *RepoStorageUsageCommand) Run() error {
+	if rsuc.format != "" && rsuc.format != "table" && rsuc.format != "json" {
+		return errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'table', 'json'", rsuc.format)
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rsuc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if rsuc.refresh {
+		if err = servicesManager.CalculateStorageInfo(); err != nil {
+			return err
+		}
+		// Artifactory recalculates the storage summary in the background, so the results may still be the previous ones
+		log.Info("Storage summary recalculation was triggered. It may take a few minutes until the results below are updated.")
+	}
+	storageInfo, err := servicesManager.GetStorageInfo()
+	if err != nil {
+		return err
+	}
+	report, err := rsuc.createReport(storageInfo.RepositoriesSummaryList)
+	if err != nil {
+		return err
+	}
+	if rsuc.format == "json" {
+		content, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+		return nil
+	}
+	if len(report.Repositories) == 0 {
+		log.Info("No repositories match the pattern:", rsuc.getRepoPattern())
+		return nil
+	}
+	return printRepoStorageUsageReport(report)
+}
+
+func (rsuc *RepoStorageUsageCommand) getRepoPattern() string {
+	if rsuc.repoPattern == "" {
+		return "*"
+	}
+	return rsuc.repoPattern
+}
+
+// createReport returns the storage usage of the repositories matching the pattern and the project, and their total.
+func (rsuc *RepoStorageUsageCommand) createReport(repoSummaries []utils.RepositorySummary) (*RepoStorageUsageReport, error) {
+	matchesKey, err := newRepoKeyMatcher(rsuc.getRepoPattern())
+	if err != nil {
+		return nil, err
+	}
+	report := &RepoStorageUsageReport{Repositories: []RepoStorageUsage{}}
+	for _, repoSummary := range repoSummaries {
+		if repoSummary.RepoKey == storageSummaryTotalRepoKey {
+			continue
+		}
+		if !matchesKey(repoSummary.RepoKey) || (rsuc.project != "" && repoSummary.ProjectKey != rsuc.project) {
+			continue
+		}
+		usage, err := toRepoStorageUsage(repoSummary)
+		if err != nil {
+			return nil, err
+		}
+		report.Repositories = append(report.Repositories, usage)
+		report.Total.FilesCount += usage.FilesCount
+		report.Total.FoldersCount += usage.FoldersCount
+		report.Total.UsedSpaceInBytes += usage.UsedSpaceInBytes
+	}
+	report.Total.ProjectKey = rsuc.project
+	report.Total.UsedSpace = utils.ConvertIntToStorageSizeString(report.Total.UsedSpaceInBytes)
+	return report, nil
+}
+
+func toRepoStorageUsage(repoSummary utils.RepositorySummary) (RepoStorageUsage, error) {
+	usage := RepoStorageUsage{
+		RepoKey:     repoSummary.RepoKey,
+		RepoType:    repoSummary.RepoType,
+		PackageType: repoSummary.PackageType,
+		ProjectKey:  repoSummary.ProjectKey,
+		UsedSpace:   repoSummary.UsedSpace,
+	}
+	var err error
+	if usage.FilesCount, err = parseStorageCount(repoSummary.FilesCount); err != nil {
+		return usage, err
+	}
+	if usage.FoldersCount, err = parseStorageCount(repoSummary.FoldersCount); err != nil {
+		return usage, err
+	}
+	usage.UsedSpaceInBytes, err = parseStorageCount(repoSummary.UsedSpaceInBytes)
+	return usage, err
+}
+
+// parseStorageCount parses a number of the storage summary, which is omitted when it is zero.
+func parseStorageCount(number json.Number) (int64, error) {
+	if number == "" {
+		return 0, nil
+	}
+	count, err := number.Int64()
+	return count, errorutils.CheckError(err)
+}
+
+func printRepoStorageUsageReport(report *RepoStorageUsageReport) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(writer, "REPOSITORY\tTYPE\tPACKAGE TYPE\tFILES\tFOLDERS\tUSED SPACE"); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, usage := range report.Repositories {
+		if _, err := fmt.Fprintf(writer, "%s\t%s\t%s\t%d\t%d\t%s\n", usage.RepoKey, usage.RepoType, usage.PackageType, usage.FilesCount, usage.FoldersCount, usage.UsedSpace); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	// The total is only meaningful when the usage of several repositories is aggregated
+	if len(report.Repositories) > 1 {
+		if _, err := fmt.Fprintf(writer, "TOTAL\t\t\t%d\t%d\t%s\n", report.Total.FilesCount, report.Total.FoldersCount, report.Total.UsedSpace); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return errorutils.CheckError(writer.Flush())
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRepoSummaries = []utils.RepositorySummary{
+	{RepoKey: "npm-local", RepoType: "LOCAL", PackageType: "Npm", ProjectKey: "proj", FilesCount: "10", FoldersCount: "4", UsedSpace: "2.00 KB", UsedSpaceInBytes: "2048"},
+	{RepoKey: "npm-remote-cache", RepoType: "CACHE", PackageType: "Npm", ProjectKey: "proj", FilesCount: "5", FoldersCount: "2", UsedSpace: "1.00 KB", UsedSpaceInBytes: "1024"},
+	{RepoKey: "maven-local", RepoType: "LOCAL", PackageType: "Maven", UsedSpace: "0 bytes"},
+	{RepoKey: "TOTAL", RepoType: "NA", FilesCount: "15", FoldersCount: "6", UsedSpace: "3.00 KB"},
+}
+
+func Test_RepoStorageUsageCreateReport(t *testing.T) {
+	tests := []struct {
+		name             string
+		repoPattern      string
+		project          string
+		expectedRepoKeys []string
+		expectedTotal    RepoStorageUsage
+	}{
+		{
+			name:             "All repositories",
+			expectedRepoKeys: []string{"npm-local", "npm-remote-cache", "maven-local"},
+			expectedTotal:    RepoStorageUsage{FilesCount: 15, FoldersCount: 6, UsedSpaceInBytes: 3072, UsedSpace: "3.0KB"},
+		},
+		{
+			name:             "Single repository",
+			repoPattern:      "maven-local",
+			expectedRepoKeys: []string{"maven-local"},
+			expectedTotal:    RepoStorageUsage{UsedSpace: "0.0KB"},
+		},
+		{
+			name:             "Pattern",
+			repoPattern:      "npm-*",
+			expectedRepoKeys: []string{"npm-local", "npm-remote-cache"},
+			expectedTotal:    RepoStorageUsage{FilesCount: 15, FoldersCount: 6, UsedSpaceInBytes: 3072, UsedSpace: "3.0KB"},
+		},
+		{
+			name:             "Project",
+			project:          "proj",
+			expectedRepoKeys: []string{"npm-local", "npm-remote-cache"},
+			expectedTotal:    RepoStorageUsage{ProjectKey: "proj", FilesCount: 15, FoldersCount: 6, UsedSpaceInBytes: 3072, UsedSpace: "3.0KB"},
+		},
+		{
+			name:             "No match",
+			repoPattern:      "docker-*",
+			expectedRepoKeys: nil,
+			expectedTotal:    RepoStorageUsage{UsedSpace: "0.0KB"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsuc := NewRepoStorageUsageCommand().SetRepoPattern(tt.repoPattern).SetProject(tt.project)
+			report, err := rsuc.createReport(testRepoSummaries)
+			require.NoError(t, err)
+			var repoKeys []string
+			for _, usage := range report.Repositories {
+				repoKeys = append(repoKeys, usage.RepoKey)
+			}
+			assert.Equal(t, tt.expectedRepoKeys, repoKeys)
+			assert.Equal(t, tt.expectedTotal, report.Total)
+		})
+	}
+}
+
+func Test_RepoStorageUsageRun(t *testing.T) {
+	calculateRequests := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/storageinfo/calculate":
+			calculateRequests++
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/storageinfo":
+			_, _ = w.Write([]byte(`{"repositoriesSummaryList": [{"repoKey": "npm-local", "repoType": "LOCAL", "filesCount": 10, "foldersCount": 4, "usedSpace": "2.00 KB", "usedSpaceInBytes": 2048}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
+
+	for _, format := range []string{"", "table", "json"} {
+		assert.NoError(t, NewRepoStorageUsageCommand().SetServerDetails(serverDetails).SetFormat(format).Run())
+	}
+	assert.Zero(t, calculateRequests)
+
+	assert.NoError(t, NewRepoStorageUsageCommand().SetServerDetails(serverDetails).SetRefresh(true).Run())
+	assert.Equal(t, 1, calculateRequests)
+
+	err := NewRepoStorageUsageCommand().SetServerDetails(serverDetails).SetFormat("csv").Run()
+	assert.ErrorContains(t, err, "unsupported format 'csv'")
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	repoActionCreated = "created"
+	repoActionUpdated = "updated"
+	repoActionSkipped = "skipped"
+)
+
+// The order in which the actions are reported.
+var repoActions = []string{repoActionCreated, repoActionUpdated, repoActionSkipped}
+
+// repoActionCounts counts the repositories on which an action was taken, by rclass and by package type.
+type repoActionCounts struct {
+	Total        int            `json:"total"`
+	Rclasses     map[string]int `json:"rclasses"`
+	PackageTypes map[string]int `json:"packageTypes"`
+}
+
+// repoApplySummary counts the repositories created, updated and skipped by a run of the repository create or update
+// commands. Repositories are skipped when their keys don't match the include keys pattern.
+type repoApplySummary struct {
+	actions map[string]*repoActionCounts
+}
+
+func newRepoApplySummary() *repoApplySummary {
+	return &repoApplySummary{actions: make(map[string]*repoActionCounts)}
+}
+
+func getRepoApplyAction(isUpdate bool) string {
+	if isUpdate {
+		return repoActionUpdated
+	}
+	return repoActionCreated
+}
+
+// add counts the repositories of the configurations under the action.
+func (s *repoApplySummary) add(action string, repoConfigMaps ...map[string]interface{}) {
+	counts, ok := s.actions[action]
+	if !ok {
+		counts = &repoActionCounts{Rclasses: make(map[string]int), PackageTypes: make(map[string]int)}
+		s.actions[action] = counts
+	}
+	for _, repoConfigMap := range repoConfigMaps {
+		counts.Total++
+		counts.Rclasses[fmt.Sprint(repoConfigMap[Rclass])]++
+		counts.PackageTypes[fmt.Sprint(repoConfigMap[PackageType])]++
+	}
+}
+
+// String describes the counts of each action, for example "Created 12 (8 local, 4 remote; 7 maven, 5 npm), Skipped 3
+// (3 virtual; 3 npm)". Actions which weren't taken are omitted.
+func (s *repoApplySummary) String() string {
+	var parts []string
+	for _, action := range repoActions {
+		counts, ok := s.actions[action]
+		if !ok || counts.Total == 0 {
+			continue
+		}
+		var rclasses []string
+		for _, rclass := range supportedRclasses {
+			if count := counts.Rclasses[rclass]; count > 0 {
+				rclasses = append(rclasses, fmt.Sprintf("%d %s", count, rclass))
+			}
+		}
+		var packageTypes []string
+		for _, packageType := range slices.Sorted(maps.Keys(counts.PackageTypes)) {
+			packageTypes = append(packageTypes, fmt.Sprintf("%d %s", counts.PackageTypes[packageType], packageType))
+		}
+		parts = append(parts, fmt.Sprintf("%s%s %d (%s; %s)", strings.ToUpper(action[:1]), action[1:], counts.Total,
+			strings.Join(rclasses, ", "), strings.Join(packageTypes, ", ")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// MarshalJSON reports the counts of all the actions, including those which weren't taken, so the output has a fixed shape.
+func (s *repoApplySummary) MarshalJSON() ([]byte, error) {
+	actions := make(map[string]*repoActionCounts, len(repoActions))
+	for _, action := range repoActions {
+		counts, ok := s.actions[action]
+		if !ok {
+			counts = &repoActionCounts{Rclasses: map[string]int{}, PackageTypes: map[string]int{}}
+		}
+		actions[action] = counts
+	}
+	return json.Marshal(actions)
+}
+
+// validateSummaryFormat fails on an unsupported format, before any repository is created or updated.
+func validateSummaryFormat(format string) error {
+	if format != "" && format != "text" && format != "json" {
+		return errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'text', 'json'", format)
+	}
+	return nil
+}
+
+// printSummary logs the summary as text, or prints it to the standard output as JSON.
+func (s *repoApplySummary) printSummary(format string) error {
+	if format == "json" {
+		content, err := json.MarshalIndent(s, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+		return nil
+	}
+	if description := s.String(); description != "" {
+		log.Info("Summary:", description)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mixedReposTemplate = `[
+  {"key": "npm-local", "rclass": "local", "packageType": "npm"},
+  {"key": "npm-remote", "rclass": "remote", "packageType": "npm", "url": "https://registry.npmjs.org"},
+  {"key": "npm-virtual", "rclass": "virtual", "packageType": "npm", "repositories": ["npm-local", "npm-remote"]},
+  {"key": "maven-local", "rclass": "local", "packageType": "maven"},
+  {"key": "maven-remote", "rclass": "remote", "packageType": "maven", "url": "https://repo1.maven.org/maven2"},
+  {"key": "generic-local", "rclass": "local", "packageType": "generic"}
+]`
+
+func Test_RepoApplySummary(t *testing.T) {
+	summary := newRepoApplySummary()
+	summary.add(repoActionCreated,
+		map[string]interface{}{Key: "npm-local", Rclass: Local, PackageType: Npm},
+		map[string]interface{}{Key: "npm-remote", Rclass: Remote, PackageType: Npm},
+		map[string]interface{}{Key: "maven-local", Rclass: Local, PackageType: Maven})
+	summary.add(repoActionSkipped, map[string]interface{}{Key: "npm-virtual", Rclass: Virtual, PackageType: Npm})
+
+	assert.Equal(t, "Created 3 (2 local, 1 remote; 1 maven, 2 npm), Skipped 1 (1 virtual; 1 npm)", summary.String())
+
+	content, err := json.Marshal(summary)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"created": {"total": 3, "rclasses": {"local": 2, "remote": 1}, "packageTypes": {"maven": 1, "npm": 2}},
+		"updated": {"total": 0, "rclasses": {}, "packageTypes": {}},
+		"skipped": {"total": 1, "rclasses": {"virtual": 1}, "packageTypes": {"npm": 1}}
+	}`, string(content))
+
+	assert.Empty(t, newRepoApplySummary().String())
+}
+
+func Test_PerformRepoCmd_Summary(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if serveTestLicense(t, w, r) {
+			return
+		}
+		switch r.URL.Path {
+		case "/api/system/version":
+			_, err := w.Write([]byte(`{"version":"7.104.2"}`))
+			require.NoError(t, err)
+		case "/api/v2/repositories/batch":
+			if r.Method == http.MethodPut {
+				w.WriteHeader(http.StatusCreated)
+			}
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer testServer.Close()
+
+	tests := []struct {
+		name           string
+		isUpdate       bool
+		quiet          bool
+		expectedOutput string
+	}{
+		{
+			name: "Create",
+			expectedOutput: `{
+				"created": {"total": 4, "rclasses": {"local": 1, "remote": 2, "virtual": 1}, "packageTypes": {"maven": 1, "npm": 3}},
+				"updated": {"total": 0, "rclasses": {}, "packageTypes": {}},
+				"skipped": {"total": 2, "rclasses": {"local": 2}, "packageTypes": {"generic": 1, "maven": 1}}
+			}`,
+		},
+		{
+			name:     "Update",
+			isUpdate: true,
+			expectedOutput: `{
+				"created": {"total": 0, "rclasses": {}, "packageTypes": {}},
+				"updated": {"total": 4, "rclasses": {"local": 1, "remote": 2, "virtual": 1}, "packageTypes": {"maven": 1, "npm": 3}},
+				"skipped": {"total": 2, "rclasses": {"local": 2}, "packageTypes": {"generic": 1, "maven": 1}}
+			}`,
+		},
+		{
+			name:  "Quiet",
+			quiet: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoCmd := &RepoCommand{
+				serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+				templatePath:  createTempTemplate(t, mixedReposTemplate),
+				includeKeys:   "regex:npm-.*|maven-remote",
+				quiet:         tt.quiet,
+				summaryFormat: "json",
+			}
+
+			output := captureStdout(t, func() {
+				require.NoError(t, repoCmd.PerformRepoCmd(tt.isUpdate))
+			})
+
+			if tt.quiet {
+				assert.Empty(t, output)
+				return
+			}
+			assert.JSONEq(t, tt.expectedOutput, output)
+		})
+	}
+}
+
+func Test_PerformRepoCmd_InvalidSummaryFormat(t *testing.T) {
+	repoCmd := &RepoCommand{templatePath: createTempTemplate(t, mixedReposTemplate), summaryFormat: "table"}
+	assert.EqualError(t, repoCmd.PerformRepoCmd(false), "unsupported format 'table'. Supported formats: 'text', 'json'")
+}
+
+func captureStdout(t *testing.T, f func()) string {
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = writer
+	defer func() {
+		os.Stdout = stdout
+	}()
+	f()
+	require.NoError(t, writer.Close())
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return string(content)
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/gofrog/version"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The Artifactory versions which introduced package types that older versions reject.
+var packageTypesMinVersions = map[string]string{
+	Cargo:         "7.17.4",
+	Terraform:     "7.38.4",
+	HuggingFaceML: "7.77.3",
+}
+
+// repoCombination is an rclass and a package type requested by the template, along with the repositories requesting it.
+type repoCombination struct {
+	rclass, packageType string
+	repoKeys            []string
+}
+
+func (combination repoCombination) String() string {
+	return fmt.Sprintf("%s %s repositories (%s)", combination.rclass, combination.packageType, strings.Join(combination.repoKeys, ", "))
+}
+
+// RepoCheckSupportCommand checks that every rclass and package type combination in a template is supported by the
+// Artifactory instance, by its version and edition, before the template is applied. The version and the edition are
+// fetched once, and all the unsupported combinations are reported together, so a template isn't applied partially
+// until the first repository the server rejects.
+type RepoCheckSupportCommand struct {
+	RepoCommand
+}
+
+func NewRepoCheckSupportCommand() *RepoCheckSupportCommand {
+	return &RepoCheckSupportCommand{}
+}
+
+func (rcsc *RepoCheckSupportCommand) SetTemplatePath(path string) *RepoCheckSupportCommand {
+	rcsc.templatePath = path
+	return rcsc
+}
+
+func (rcsc *RepoCheckSupportCommand) SetVars(vars string) *RepoCheckSupportCommand {
+	rcsc.vars = vars
+	return rcsc
+}
+
+func (rcsc *RepoCheckSupportCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoCheckSupportCommand {
+	rcsc.serverDetails = serverDetails
+	return rcsc
+}
+
+func (rcsc *RepoCheckSupportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rcsc.serverDetails, nil
+}
+
+func (rcsc *RepoCheckSupportCommand) CommandName() string {
+	return "rt_repo_check_support"
+}
+
+func (rcsc *RepoCheckSupportCommand) Run() error {
+	batches, err := rcsc.getRepoConfigsBatches()
+	if err != nil {
+		return err
+	}
+	repoConfigMaps := flattenRepoConfigsBatches(batches)
+	if err = normalizeRepoConfigsClasses(repoConfigMaps); err != nil {
+		return err
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rcsc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	return validateRepoSupport(repoConfigMaps, servicesManager)
+}
+
+// validateRepoSupport fails with a report of all the rclass and package type combinations which the version or the
+// edition of the Artifactory instance doesn't support.
+func validateRepoSupport(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager) error {
+	artifactoryVersion, err := servicesManager.GetVersion()
+	if err != nil {
+		return errorutils.CheckErrorf("failed to get Artifactory version: %s", err.Error())
+	}
+	edition := newEditionProbe(servicesManager).getEdition()
+	if edition == editionUnknown {
+		log.Warn("Couldn't detect the Artifactory edition, so only the version is checked.")
+	}
+	combinations := getRepoCombinations(repoConfigMaps)
+	var problems []string
+	for _, combination := range combinations {
+		if reasons := getUnsupportedReasons(combination, version.NewVersion(artifactoryVersion), edition); len(reasons) > 0 {
+			problems = append(problems, fmt.Sprintf("%s: %s", combination, strings.Join(reasons, "; ")))
+		}
+	}
+	serverDescription := "Artifactory " + artifactoryVersion
+	if edition != editionUnknown {
+		serverDescription += fmt.Sprintf(" (%s)", edition)
+	}
+	if len(problems) > 0 {
+		return errorutils.CheckErrorf("the template requests repositories which %s doesn't support:\n%s", serverDescription, strings.Join(problems, "\n"))
+	}
+	log.Info(fmt.Sprintf("All the %d rclass and package type combinations of the template are supported by %s.", len(combinations), serverDescription))
+	return nil
+}
+
+// getRepoCombinations groups the repositories by their rclass and package type, sorted by rclass and then by package type.
+func getRepoCombinations(repoConfigMaps []map[string]interface{}) []repoCombination {
+	var combinations []repoCombination
+	indexes := make(map[string]int)
+	for _, repoConfigMap := range repoConfigMaps {
+		rclass, packageType := fmt.Sprint(repoConfigMap[Rclass]), fmt.Sprint(repoConfigMap[PackageType])
+		id := rclass + "/" + packageType
+		index, ok := indexes[id]
+		if !ok {
+			index = len(combinations)
+			indexes[id] = index
+			combinations = append(combinations, repoCombination{rclass: rclass, packageType: packageType})
+		}
+		combinations[index].repoKeys = append(combinations[index].repoKeys, fmt.Sprint(repoConfigMap[Key]))
+	}
+	sort.Slice(combinations, func(i, j int) bool {
+		if combinations[i].rclass != combinations[j].rclass {
+			return combinations[i].rclass < combinations[j].rclass
+		}
+		return combinations[i].packageType < combinations[j].packageType
+	})
+	return combinations
+}
+
+// getUnsupportedReasons returns why a combination isn't supported by the CLI, the version or the edition. The edition
+// isn't checked when it's unknown.
+func getUnsupportedReasons(combination repoCombination, artifactoryVersion *version.Version, edition artifactoryEdition) (reasons []string) {
+	if _, err := getRepoHandler(combination.rclass, combination.packageType); err != nil {
+		return []string{err.Error()}
+	}
+	if combination.rclass == Federated && !artifactoryVersion.AtLeast(minArtifactoryVersionForFederation) {
+		reasons = append(reasons, fmt.Sprintf("federated repositories require Artifactory %s or above", minArtifactoryVersionForFederation))
+	}
+	if minVersion, ok := packageTypesMinVersions[combination.packageType]; ok && !artifactoryVersion.AtLeast(minVersion) {
+		reasons = append(reasons, fmt.Sprintf("%s repositories require Artifactory %s or above", combination.packageType, minVersion))
+	}
+	if edition != editionUnknown {
+		if reason := getRequiredEdition(map[string]interface{}{Rclass: combination.rclass, PackageType: combination.packageType}, edition); reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	return
+}
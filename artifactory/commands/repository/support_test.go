@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateRepoSupport(t *testing.T) {
+	repoConfigMaps := []map[string]interface{}{
+		{Key: "cargo-local", Rclass: Local, PackageType: Cargo},
+		{Key: "maven-fed", Rclass: Federated, PackageType: Maven},
+		{Key: "libs-local", Rclass: Local, PackageType: Maven},
+		{Key: "libs-release", Rclass: Local, PackageType: Maven},
+	}
+	tests := []struct {
+		name           string
+		version        string
+		licenseType    string
+		expectedErrors []string
+	}{
+		{
+			name:        "Supported",
+			version:     "7.90.0",
+			licenseType: "Enterprise Plus",
+		},
+		{
+			name:        "Old version",
+			version:     "7.10.0",
+			licenseType: "Enterprise Plus",
+			expectedErrors: []string{
+				"the template requests repositories which Artifactory 7.10.0 (Enterprise+) doesn't support:",
+				"federated maven repositories (maven-fed): federated repositories require Artifactory 7.18.0 or above",
+				"local cargo repositories (cargo-local): cargo repositories require Artifactory 7.17.4 or above",
+			},
+		},
+		{
+			name:        "Limited edition",
+			version:     "7.90.0",
+			licenseType: "OSS",
+			expectedErrors: []string{
+				"federated maven repositories (maven-fed): federated repositories require Artifactory Enterprise or above",
+				"local cargo repositories (cargo-local): cargo repositories require Artifactory Pro or above",
+			},
+		},
+		{
+			name:        "Unknown edition",
+			version:     "7.90.0",
+			licenseType: "Edge",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				switch r.URL.Path {
+				case "/api/system/version":
+					_, _ = w.Write([]byte(`{"version": "` + tt.version + `"}`))
+				case "/" + systemLicenseApi:
+					_, _ = w.Write([]byte(`{"type": "` + tt.licenseType + `"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+			servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, -1, 0, false)
+			require.NoError(t, err)
+
+			err = validateRepoSupport(repoConfigMaps, servicesManager)
+			// The version and the edition are fetched once for all the repositories
+			assert.Equal(t, 2, requests)
+			if len(tt.expectedErrors) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			for _, expectedError := range tt.expectedErrors {
+				assert.ErrorContains(t, err, expectedError)
+			}
+		})
+	}
+}
+
+func Test_GetRepoCombinations(t *testing.T) {
+	combinations := getRepoCombinations([]map[string]interface{}{
+		{Key: "npm-remote", Rclass: Remote, PackageType: Npm},
+		{Key: "libs-local", Rclass: Local, PackageType: Maven},
+		{Key: "npm-local", Rclass: Local, PackageType: Npm},
+		{Key: "libs-release", Rclass: Local, PackageType: Maven},
+	})
+	assert.Equal(t, []repoCombination{
+		{rclass: Local, packageType: Maven, repoKeys: []string{"libs-local", "libs-release"}},
+		{rclass: Local, packageType: Npm, repoKeys: []string{"npm-local"}},
+		{rclass: Remote, packageType: Npm, repoKeys: []string{"npm-remote"}},
+	}, combinations)
+}
+
+func Test_GetUnsupportedReasonsUnknownCombination(t *testing.T) {
+	reasons := getUnsupportedReasons(repoCombination{rclass: Virtual, packageType: "unknown"}, nil, editionUnknown)
+	if assert.Len(t, reasons, 1) {
+		assert.Contains(t, reasons[0], "unsupported package type: unknown")
+	}
+}
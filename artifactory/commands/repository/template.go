@@ -1,7 +1,6 @@
 package repository
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -58,6 +57,7 @@ const (
 	DebianTrivialLayout             = "debianTrivialLayout"
 	OptionalIndexCompressionFormats = "optionalIndexCompressionFormats"
 	PrimaryKeyPairRef               = "primaryKeyPairRef"
+	SecondaryKeyPairRef             = "secondaryKeyPairRef"
 
 	// Mutual remote and virtual repository configuration JSON keys
 	ExternalDependenciesEnabled  = "externalDependenciesEnabled"
@@ -164,6 +164,13 @@ const (
 	Terraform = "terraform"
 	Cargo     = "cargo"
 
+	// Package types without a dedicated handler, which fall back to the generic handler
+	Ansible          = "ansible"
+	HuggingFaceML    = "huggingfaceml"
+	Oci              = "oci"
+	Pub              = "pub"
+	TerraformBackend = "terraformbackend"
+
 	// Repo layout Refs
 	BowerDefaultRepoLayout    = "bower-default"
 	buildDefaultRepoLayout    = "build-default"
@@ -259,6 +266,7 @@ var optionalSuggestsMap = map[string]prompt.Suggest{
 	Password:                          {Text: Password},
 	Proxy:                             {Text: Proxy},
 	PrimaryKeyPairRef:                 {Text: PrimaryKeyPairRef},
+	SecondaryKeyPairRef:               {Text: SecondaryKeyPairRef},
 	RemoteRepoChecksumPolicyType:      {Text: RemoteRepoChecksumPolicyType},
 	HardFail:                          {Text: HardFail},
 	Offline:                           {Text: Offline},
@@ -314,7 +322,7 @@ var mavenGradleLocalRepoConfKeys = []string{
 }
 
 var rpmLocalRepoConfKeys = []string{
-	YumRootDepth, CalculateYumMetadata, EnableFileListsIndexing, PrimaryKeyPairRef,
+	YumRootDepth, CalculateYumMetadata, EnableFileListsIndexing, PrimaryKeyPairRef, SecondaryKeyPairRef,
 }
 
 var nugetLocalRepoConfKeys = []string{
@@ -322,7 +330,11 @@ var nugetLocalRepoConfKeys = []string{
 }
 
 var debianLocalRepoConfKeys = []string{
-	DebianTrivialLayout, PrimaryKeyPairRef,
+	DebianTrivialLayout, PrimaryKeyPairRef, SecondaryKeyPairRef,
+}
+
+var alpineLocalRepoConfKeys = []string{
+	PrimaryKeyPairRef, SecondaryKeyPairRef,
 }
 
 var dockerLocalRepoConfKeys = []string{
@@ -396,7 +408,7 @@ var vcsRemoteRepoConfKeys = []string{
 
 var baseVirtualRepoConfKeys = []string{
 	Repositories, Description, Notes, IncludePatterns, ExcludePatterns, RepoLayoutRef, ProjectKey, Environment, ArtifactoryRequestsCanRetrieveRemoteArtifacts,
-	DefaultDeploymentRepo, OptionalIndexCompressionFormats, PrimaryKeyPairRef,
+	DefaultDeploymentRepo, OptionalIndexCompressionFormats,
 }
 
 var mavenGradleVirtualRepoConfKeys = []string{
@@ -416,7 +428,11 @@ var bowerVirtualRepoConfKeys = []string{
 }
 
 var debianVirtualRepoConfKeys = []string{
-	DebianTrivialLayout,
+	DebianTrivialLayout, PrimaryKeyPairRef, SecondaryKeyPairRef,
+}
+
+var signingVirtualRepoConfKeys = []string{
+	PrimaryKeyPairRef, SecondaryKeyPairRef,
 }
 
 var goVirtualRepoConfKeys = []string{
@@ -503,9 +519,9 @@ func (rtc *RepoTemplateCommand) Run() (err error) {
 	if err != nil {
 		return err
 	}
-	resBytes, err := json.Marshal(repoTemplateQuestionnaire.AnswersMap)
+	resBytes, err := marshalCanonicalJson(repoTemplateQuestionnaire.AnswersMap, "  ")
 	if err != nil {
-		return errorutils.CheckError(err)
+		return err
 	}
 	if err = os.WriteFile(rtc.path, resBytes, 0644); err != nil {
 		return errorutils.CheckError(err)
@@ -614,6 +630,8 @@ func getLocalRepoConfKeys(pkgType string) []prompt.Suggest {
 		optionalKeys = append(optionalKeys, nugetLocalRepoConfKeys...)
 	case Debian:
 		optionalKeys = append(optionalKeys, debianLocalRepoConfKeys...)
+	case Alpine:
+		optionalKeys = append(optionalKeys, alpineLocalRepoConfKeys...)
 	case Docker:
 		optionalKeys = append(optionalKeys, dockerLocalRepoConfKeys...)
 	}
@@ -675,6 +693,8 @@ func getVirtualRepoConfKeys(pkgType string) []prompt.Suggest {
 		optionalKeys = append(optionalKeys, debianVirtualRepoConfKeys...)
 	case Go:
 		optionalKeys = append(optionalKeys, goVirtualRepoConfKeys...)
+	case Docker, Rpm, Alpine:
+		optionalKeys = append(optionalKeys, signingVirtualRepoConfKeys...)
 	}
 	return ioutils.GetSuggestsFromKeys(optionalKeys, optionalSuggestsMap)
 }
@@ -863,10 +883,11 @@ var questionMap = map[string]ioutils.QuestionInfo{
 		AllowVars: true,
 		Writer:    ioutils.WriteStringAnswer,
 	},
-	PrimaryKeyPairRef: ioutils.FreeStringQuestionInfo,
-	Username:          ioutils.FreeStringQuestionInfo,
-	Password:          ioutils.FreeStringQuestionInfo,
-	Proxy:             ioutils.FreeStringQuestionInfo,
+	PrimaryKeyPairRef:   ioutils.FreeStringQuestionInfo,
+	SecondaryKeyPairRef: ioutils.FreeStringQuestionInfo,
+	Username:            ioutils.FreeStringQuestionInfo,
+	Password:            ioutils.FreeStringQuestionInfo,
+	Proxy:               ioutils.FreeStringQuestionInfo,
 	RemoteRepoChecksumPolicyType: {
 		Options: []prompt.Suggest{
 			{Text: GenerateIfAbsentPolicy},
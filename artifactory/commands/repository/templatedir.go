@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+// repoConfigsBatch is a group of repository configurations applied together by the handler matching the form of their templates.
+type repoConfigsBatch struct {
+	repoConfigMaps []map[string]interface{}
+	strategy       repoCreateUpdateHandler
+}
+
+// getRepoConfigsBatches reads the repository configurations from the template, or from every template in the template directory.
+func (rc *RepoCommand) getRepoConfigsBatches() ([]repoConfigsBatch, error) {
+	if rc.templatePath != stdinTemplatePath {
+		if info, err := os.Stat(rc.templatePath); err == nil && info.IsDir() {
+			return readTemplateDir(rc.templatePath, rc.vars)
+		}
+	}
+	configs, err := rc.convertTemplateToMaps()
+	if err != nil {
+		return nil, err
+	}
+	var batch repoConfigsBatch
+	switch configType := configs.(type) {
+	case []map[string]interface{}:
+		batch = repoConfigsBatch{repoConfigMaps: configType, strategy: &MultipleRepositoryHandler{}}
+	case map[string]interface{}:
+		batch = repoConfigsBatch{repoConfigMaps: []map[string]interface{}{configType}, strategy: &SingleRepositoryHandler{}}
+	default:
+		return nil, fmt.Errorf("unexpected repository configuration type: %T", configType)
+	}
+	if rc.templatePath != stdinTemplatePath {
+		resolvePatternFilesPaths(batch.repoConfigMaps, rc.templatePath)
+	}
+	return []repoConfigsBatch{batch}, nil
+}
+
+func flattenRepoConfigsBatches(batches []repoConfigsBatch) []map[string]interface{} {
+	var repoConfigMaps []map[string]interface{}
+	for _, batch := range batches {
+		repoConfigMaps = append(repoConfigMaps, batch.repoConfigMaps...)
+	}
+	return repoConfigMaps
+}
+
+// readTemplateDir reads the .json and .yaml templates in dirPath, ordered by their file names.
+// The repositories of all multiple repositories templates are merged into a single batch, followed by the repositories
+// of the single repository templates. A repository defined more than once must have identical configurations.
+func readTemplateDir(dirPath, vars string) ([]repoConfigsBatch, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var (
+		multipleRepoConfigs, singleRepoConfigs []map[string]interface{}
+		// Maps each repository key to the template file which defined it first
+		keysSources = make(map[string]string)
+		repoConfigs = make(map[string]map[string]interface{})
+	)
+	templatesCount := 0
+	// os.ReadDir returns the entries sorted by file name
+	for _, entry := range entries {
+		if entry.IsDir() || !isTemplateFile(entry.Name()) {
+			continue
+		}
+		templatesCount++
+		templatePath := filepath.Join(dirPath, entry.Name())
+		configs, err := readTemplateFile(templatePath, vars)
+		if err != nil {
+			return nil, err
+		}
+		var fileRepoConfigs []map[string]interface{}
+		isMultiple := false
+		switch configType := configs.(type) {
+		case []map[string]interface{}:
+			fileRepoConfigs = configType
+			isMultiple = true
+		case map[string]interface{}:
+			fileRepoConfigs = []map[string]interface{}{configType}
+		default:
+			return nil, fmt.Errorf("unexpected repository configuration type in template '%s': %T", templatePath, configType)
+		}
+		resolvePatternFilesPaths(fileRepoConfigs, templatePath)
+		for _, repoConfigMap := range fileRepoConfigs {
+			key, ok := repoConfigMap[Key]
+			if ok && key != "" {
+				repoKey := fmt.Sprint(key)
+				if source, exists := keysSources[repoKey]; exists {
+					if !reflect.DeepEqual(repoConfigs[repoKey], repoConfigMap) {
+						return nil, errorutils.CheckErrorf("repository '%s' is defined with different configurations in templates '%s' and '%s'", repoKey, source, templatePath)
+					}
+					log.Debug(fmt.Sprintf("Repository '%s' is defined in templates '%s' and '%s' with the same configuration, applying it once.", repoKey, source, templatePath))
+					continue
+				}
+				keysSources[repoKey] = templatePath
+				repoConfigs[repoKey] = repoConfigMap
+			}
+			if isMultiple {
+				multipleRepoConfigs = append(multipleRepoConfigs, repoConfigMap)
+			} else {
+				singleRepoConfigs = append(singleRepoConfigs, repoConfigMap)
+			}
+		}
+	}
+	if templatesCount == 0 {
+		return nil, errorutils.CheckErrorf("no .json or .yaml templates were found in directory '%s'", dirPath)
+	}
+
+	var batches []repoConfigsBatch
+	if len(multipleRepoConfigs) > 0 {
+		batches = append(batches, repoConfigsBatch{repoConfigMaps: multipleRepoConfigs, strategy: &MultipleRepositoryHandler{}})
+	}
+	if len(singleRepoConfigs) > 0 {
+		batches = append(batches, repoConfigsBatch{repoConfigMaps: singleRepoConfigs, strategy: &SingleRepositoryHandler{}})
+	}
+	return batches, nil
+}
+
+func isTemplateFile(fileName string) bool {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+func isYamlTemplateFile(fileName string) bool {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+// readTemplateFile parses a template file the same way convertTemplateContentToMaps parses a JSON template.
+// YAML templates are converted to JSON after the template variables are replaced.
+func readTemplateFile(templatePath, vars string) (interface{}, error) {
+	content, err := os.ReadFile(templatePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(vars) > 0 {
+		content = coreutils.ReplaceVars(content, coreutils.SpecVarsStringToMap(vars))
+	}
+	if isYamlTemplateFile(templatePath) {
+		if content, err = yamlToJson(content); err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse template '%s': %s", templatePath, err.Error())
+		}
+	}
+	configs, err := convertTemplateContentToMaps(content, "")
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse template '%s': %s", templatePath, err.Error())
+	}
+	return configs, nil
+}
+
+// yamlToJson converts a YAML template to a JSON template. The scalar values, such as booleans and numbers, are converted
+// to strings, as in a JSON template.
+func yamlToJson(content []byte) ([]byte, error) {
+	var template interface{}
+	if err := yaml.Unmarshal(content, &template); err != nil {
+		return nil, err
+	}
+	return json.Marshal(stringifyYamlScalars(template))
+}
+
+func stringifyYamlScalars(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, entry := range typedValue {
+			typedValue[key] = stringifyYamlScalars(entry)
+		}
+		return typedValue
+	case []interface{}:
+		for i, entry := range typedValue {
+			typedValue[i] = stringifyYamlScalars(entry)
+		}
+		return typedValue
+	case bool, int, float64:
+		return fmt.Sprint(typedValue)
+	default:
+		return value
+	}
+}
@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadTemplateDir(t *testing.T) {
+	tests := []struct {
+		name             string
+		files            map[string]string
+		vars             string
+		expectedMultiple []map[string]interface{}
+		expectedSingle   []map[string]interface{}
+		expectedError    string
+	}{
+		{
+			name: "Ordered by file name",
+			files: map[string]string{
+				"b-remotes.json": `[{"key": "npm-remote", "rclass": "remote"}]`,
+				"a-locals.yaml":  "- key: npm-local\n  rclass: local\n- key: maven-local\n  rclass: local\n",
+				"c-virtual.yml":  "key: npm-virtual\nrclass: virtual\n",
+				"d-generic.json": `{"key": "generic-local", "rclass": "local"}`,
+				"README.md":      "not a template",
+			},
+			expectedMultiple: []map[string]interface{}{
+				{"key": "npm-local", "rclass": "local"},
+				{"key": "maven-local", "rclass": "local"},
+				{"key": "npm-remote", "rclass": "remote"},
+			},
+			expectedSingle: []map[string]interface{}{
+				{"key": "npm-virtual", "rclass": "virtual"},
+				{"key": "generic-local", "rclass": "local"},
+			},
+		},
+		{
+			name: "Template variables",
+			files: map[string]string{
+				"locals.yaml": "- key: ${team}-local\n  rclass: local\n",
+			},
+			vars:             "team=platform",
+			expectedMultiple: []map[string]interface{}{{"key": "platform-local", "rclass": "local"}},
+		},
+		{
+			name: "YAML scalars",
+			files: map[string]string{
+				"locals.yaml": "- key: npm-local\n  rclass: local\n  blackedOut: true\n  maxUniqueSnapshots: 5\n  repoLayoutRef: npm-default\n",
+			},
+			expectedMultiple: []map[string]interface{}{
+				{"key": "npm-local", "rclass": "local", "blackedOut": "true", "maxUniqueSnapshots": "5", "repoLayoutRef": "npm-default"},
+			},
+		},
+		{
+			name: "Identical duplicates are applied once",
+			files: map[string]string{
+				"a.json": `[{"key": "npm-local", "rclass": "local"}]`,
+				"b.yaml": "key: npm-local\nrclass: local\n",
+			},
+			expectedMultiple: []map[string]interface{}{{"key": "npm-local", "rclass": "local"}},
+		},
+		{
+			name: "Conflicting duplicates",
+			files: map[string]string{
+				"a.json": `[{"key": "npm-local", "rclass": "local"}]`,
+				"b.json": `{"key": "npm-local", "rclass": "remote"}`,
+			},
+			expectedError: "repository 'npm-local' is defined with different configurations in templates",
+		},
+		{
+			name: "Invalid template",
+			files: map[string]string{
+				"a.yaml": "key: [npm-local\n",
+			},
+			expectedError: "failed to parse template",
+		},
+		{
+			name: "No templates",
+			files: map[string]string{
+				"README.md": "not a template",
+			},
+			expectedError: "no .json or .yaml templates were found in directory",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for fileName, content := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, fileName), []byte(content), 0600))
+			}
+			require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.json"), 0700))
+
+			batches, err := readTemplateDir(dir, tt.vars)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			var multipleRepoConfigs, singleRepoConfigs []map[string]interface{}
+			for _, batch := range batches {
+				switch batch.strategy.(type) {
+				case *MultipleRepositoryHandler:
+					multipleRepoConfigs = append(multipleRepoConfigs, batch.repoConfigMaps...)
+				case *SingleRepositoryHandler:
+					singleRepoConfigs = append(singleRepoConfigs, batch.repoConfigMaps...)
+				}
+			}
+			assert.Equal(t, tt.expectedMultiple, multipleRepoConfigs)
+			assert.Equal(t, tt.expectedSingle, singleRepoConfigs)
+		})
+	}
+}
+
+func Test_GetRepoConfigsBatches_TemplateFile(t *testing.T) {
+	templatePath := filepath.Join(t.TempDir(), "template.json")
+	require.NoError(t, os.WriteFile(templatePath, []byte(`{"key": "npm-local", "rclass": "local"}`), 0600))
+
+	rc := &RepoCommand{templatePath: templatePath}
+	batches, err := rc.getRepoConfigsBatches()
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	assert.IsType(t, &SingleRepositoryHandler{}, batches[0].strategy)
+	assert.Equal(t, []map[string]interface{}{{"key": "npm-local", "rclass": "local"}}, batches[0].repoConfigMaps)
+}
+
+func Test_GetRepoConfigsBatches_YamlTemplateFile(t *testing.T) {
+	templatePath := filepath.Join(t.TempDir(), "template.yaml")
+	require.NoError(t, os.WriteFile(templatePath, []byte("key: ${team}-local\nrclass: local\nxrayIndex: false\n"), 0600))
+
+	rc := &RepoCommand{templatePath: templatePath, vars: "team=platform"}
+	batches, err := rc.getRepoConfigsBatches()
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	assert.IsType(t, &SingleRepositoryHandler{}, batches[0].strategy)
+	assert.Equal(t, []map[string]interface{}{{"key": "platform-local", "rclass": "local", "xrayIndex": "false"}}, batches[0].repoConfigMaps)
+}
+
+func Test_GetRepoConfigsBatches_PatternFilesRelativeToTemplate(t *testing.T) {
+	dir := t.TempDir()
+	templatesDir := filepath.Join(dir, "templates")
+	require.NoError(t, os.MkdirAll(filepath.Join(templatesDir, "patterns"), 0700))
+	templatePath := filepath.Join(templatesDir, "maven.json")
+	require.NoError(t, os.WriteFile(templatePath, []byte(`{"key": "maven-local", "rclass": "local", "includesPatternFile": "patterns/includes.txt"}`), 0600))
+	absoluteExcludesFile := filepath.Join(dir, "excludes.txt")
+	require.NoError(t, os.WriteFile(filepath.Join(templatesDir, "npm.yaml"), []byte("key: npm-local\nrclass: local\nexcludesPatternFile: "+absoluteExcludesFile+"\n"), 0600))
+
+	tests := []struct {
+		name         string
+		templatePath string
+		expected     []map[string]interface{}
+	}{
+		{
+			name:         "Template file",
+			templatePath: templatePath,
+			expected: []map[string]interface{}{
+				{"key": "maven-local", "rclass": "local", IncludePatternsFile: filepath.Join(templatesDir, "patterns", "includes.txt")},
+			},
+		},
+		{
+			name:         "Template directory",
+			templatePath: templatesDir,
+			expected: []map[string]interface{}{
+				{"key": "maven-local", "rclass": "local", IncludePatternsFile: filepath.Join(templatesDir, "patterns", "includes.txt")},
+				{"key": "npm-local", "rclass": "local", ExcludePatternsFile: absoluteExcludesFile},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc := &RepoCommand{templatePath: tt.templatePath}
+			batches, err := rc.getRepoConfigsBatches()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, flattenRepoConfigsBatches(batches))
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Maps the repository configuration keys to the attribute names of the JFrog Artifactory Terraform provider repository resources.
+// Keys which are missing from this map have no equivalent attribute, and are written to the generated HCL as comments.
+var terraformAttributes = map[string]string{
+	Key:                                  "key",
+	Description:                          "description",
+	Notes:                                "notes",
+	IncludePatterns:                      "includes_pattern",
+	ExcludePatterns:                      "excludes_pattern",
+	RepoLayoutRef:                        "repo_layout_ref",
+	ProjectKey:                           "project_key",
+	environmentsKey:                      "project_environments",
+	HandleReleases:                       "handle_releases",
+	HandleSnapshots:                      "handle_snapshots",
+	MaxUniqueSnapshots:                   "max_unique_snapshots",
+	SuppressPomConsistencyChecks:         "suppress_pom_consistency_checks",
+	BlackedOut:                           "blacked_out",
+	XrayIndex:                            "xray_index",
+	PropertySets:                         "property_sets",
+	DownloadRedirect:                     "download_direct",
+	PriorityResolution:                   "priority_resolution",
+	CdnRedirect:                          "cdn_redirect",
+	BlockPushingSchema1:                  "block_pushing_schema1",
+	DebianTrivialLayout:                  "trivial_layout",
+	OptionalIndexCompressionFormats:      "index_compression_formats",
+	PrimaryKeyPairRef:                    "primary_keypair_ref",
+	SecondaryKeyPairRef:                  "secondary_keypair_ref",
+	ExternalDependenciesEnabled:          "external_dependencies_enabled",
+	ExternalDependenciesPatterns:         "external_dependencies_patterns",
+	ChecksumPolicyType:                   "checksum_policy_type",
+	MaxUniqueTags:                        "max_unique_tags",
+	SnapshotVersionBehavior:              "snapshot_version_behavior",
+	ArchiveBrowsingEnabled:               "archive_browsing_enabled",
+	CalculateYumMetadata:                 "calculate_yum_metadata",
+	YumRootDepth:                         "yum_root_depth",
+	EnableFileListsIndexing:              "enable_file_lists_indexing",
+	ForceNugetAuthentication:             "force_nuget_authentication",
+	Url:                                  "url",
+	Username:                             "username",
+	Password:                             "password",
+	Proxy:                                "proxy",
+	RemoteRepoChecksumPolicyType:         "remote_repo_checksum_policy_type",
+	HardFail:                             "hard_fail",
+	Offline:                              "offline",
+	StoreArtifactsLocally:                "store_artifacts_locally",
+	SocketTimeoutMillis:                  "socket_timeout_millis",
+	LocalAddress:                         "local_address",
+	RetrievalCachePeriodSecs:             "retrieval_cache_period_seconds",
+	MissedRetrievalCachePeriodSecs:       "missed_cache_period_seconds",
+	UnusedArtifactsCleanupPeriodHours:    "unused_artifacts_cleanup_period_hours",
+	AssumedOfflinePeriodSecs:             "assumed_offline_period_secs",
+	FetchJarsEagerly:                     "fetch_jars_eagerly",
+	FetchSourcesEagerly:                  "fetch_sources_eagerly",
+	RejectInvalidJars:                    "reject_invalid_jars",
+	ShareConfiguration:                   "share_configuration",
+	SynchronizeProperties:                "synchronize_properties",
+	BlockMismatchingMimeTypes:            "block_mismatching_mime_types",
+	AllowAnyHostAuth:                     "allow_any_host_auth",
+	EnableCookieManagement:               "enable_cookie_management",
+	BowerRegistryUrl:                     "bower_registry_url",
+	PyPIRegistryUrl:                      "pypi_registry_url",
+	VcsGitProvider:                       "vcs_git_provider",
+	VcsGitDownloadUrl:                    "vcs_git_download_url",
+	BypassHeadRequests:                   "bypass_head_requests",
+	ClientTlsCertificate:                 "client_tls_certificate",
+	FeedContextPath:                      "feed_context_path",
+	DownloadContextPath:                  "download_context_path",
+	V3FeedUrl:                            "v3_feed_url",
+	ListRemoteFolderItems:                "list_remote_folder_items",
+	EnableTokenAuthentication:            "enable_token_authentication",
+	Repositories:                         "repositories",
+	PomRepositoryReferencesCleanupPolicy: "pom_repository_references_cleanup_policy",
+	DefaultDeploymentRepo:                "default_deployment_repo",
+	ForceMavenAuthentication:             "force_maven_authentication",
+	ExternalDependenciesRemoteRepo:       "external_dependencies_remote_repo",
+	ArtifactoryRequestsCanRetrieveRemoteArtifacts: "artifactory_requests_can_retrieve_remote_artifacts",
+}
+
+// Package types whose Terraform resource name differs from the package type, per rclass.
+var terraformResourcePackageTypes = map[string]map[string]string{
+	Local:     {Docker: "docker_v2"},
+	Federated: {Docker: "docker_v2"},
+}
+
+var terraformInvalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+type RepoTerraformExportCommand struct {
+	RepoCommand
+	repoKeys []string
+}
+
+func NewRepoTerraformExportCommand() *RepoTerraformExportCommand {
+	return &RepoTerraformExportCommand{}
+}
+
+func (rtec *RepoTerraformExportCommand) SetTemplatePath(path string) *RepoTerraformExportCommand {
+	rtec.templatePath = path
+	return rtec
+}
+
+func (rtec *RepoTerraformExportCommand) SetVars(vars string) *RepoTerraformExportCommand {
+	rtec.vars = vars
+	return rtec
+}
+
+// SetRepoKeys sets the keys of existing repositories to export. When set, the template path is ignored.
+func (rtec *RepoTerraformExportCommand) SetRepoKeys(repoKeys []string) *RepoTerraformExportCommand {
+	rtec.repoKeys = repoKeys
+	return rtec
+}
+
+func (rtec *RepoTerraformExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoTerraformExportCommand {
+	rtec.serverDetails = serverDetails
+	return rtec
+}
+
+func (rtec *RepoTerraformExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rtec.serverDetails, nil
+}
+
+func (rtec *RepoTerraformExportCommand) CommandName() string {
+	return "rt_repo_terraform_export"
+}
+
+func (rtec *RepoTerraformExportCommand) Run() error {
+	repoConfigMaps, err := rtec.getRepoConfigMaps()
+	if err != nil {
+		return err
+	}
+	hcl, err := ToTerraformHcl(repoConfigMaps)
+	if err != nil {
+		return err
+	}
+	fmt.Print(hcl)
+	return nil
+}
+
+func (rtec *RepoTerraformExportCommand) getRepoConfigMaps() ([]map[string]interface{}, error) {
+	if len(rtec.repoKeys) == 0 {
+		batches, err := rtec.getRepoConfigsBatches()
+		if err != nil {
+			return nil, err
+		}
+		return flattenRepoConfigsBatches(batches), nil
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rtec.serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	repoConfigMaps := make([]map[string]interface{}, 0, len(rtec.repoKeys))
+	for _, repoKey := range rtec.repoKeys {
+		repoConfigMap := make(map[string]interface{})
+		if err = servicesManager.GetRepository(repoKey, &repoConfigMap); err != nil {
+			return nil, err
+		}
+		repoConfigMaps = append(repoConfigMaps, repoConfigMap)
+	}
+	return repoConfigMaps, nil
+}
+
+// ToTerraformHcl converts repository configurations, as provided in templates or returned by Artifactory,
+// to the equivalent resources of the JFrog Artifactory Terraform provider.
+func ToTerraformHcl(repoConfigMaps []map[string]interface{}) (string, error) {
+	var hcl strings.Builder
+	for i, repoConfigMap := range repoConfigMaps {
+		if i > 0 {
+			hcl.WriteString("\n")
+		}
+		if err := writeTerraformResource(&hcl, repoConfigMap); err != nil {
+			return "", err
+		}
+	}
+	return hcl.String(), nil
+}
+
+func writeTerraformResource(hcl *strings.Builder, repoConfigMap map[string]interface{}) error {
+	if repoConfigMap[Key] == nil || repoConfigMap[Rclass] == nil || repoConfigMap[PackageType] == nil {
+		return errorutils.CheckErrorf("repository '%v' must have the '%s', '%s' and '%s' keys", repoConfigMap[Key], Key, Rclass, PackageType)
+	}
+	repoKey := fmt.Sprint(repoConfigMap[Key])
+	rclass := fmt.Sprint(repoConfigMap[Rclass])
+	packageType := fmt.Sprint(repoConfigMap[PackageType])
+	if resourcePackageType, ok := terraformResourcePackageTypes[rclass][packageType]; ok {
+		packageType = resourcePackageType
+	}
+	fmt.Fprintf(hcl, "resource %q %q {\n", fmt.Sprintf("artifactory_%s_%s_repository", rclass, packageType), getTerraformResourceName(repoKey))
+
+	keys := make([]string, 0, len(repoConfigMap))
+	for key := range repoConfigMap {
+		if key != Key && key != Rclass && key != PackageType {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	keys = append([]string{Key}, keys...)
+
+	var unmapped []string
+	for _, key := range keys {
+		attribute, ok := terraformAttributes[key]
+		if !ok {
+			unmapped = append(unmapped, key)
+			continue
+		}
+		value, err := toTerraformValue(key, repoConfigMap[key])
+		if err != nil {
+			return err
+		}
+		if value == "" {
+			unmapped = append(unmapped, key)
+			continue
+		}
+		fmt.Fprintf(hcl, "  %s = %s\n", attribute, value)
+	}
+	if len(unmapped) > 0 {
+		hcl.WriteString("\n  # The following settings have no equivalent attribute in the Terraform provider:\n")
+		for _, key := range unmapped {
+			content, err := marshalCanonicalJson(repoConfigMap[key], "")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(hcl, "  # %s = %s\n", key, content)
+		}
+	}
+	hcl.WriteString("}\n")
+	return nil
+}
+
+// getTerraformResourceName converts the repository key to a valid Terraform resource name.
+func getTerraformResourceName(repoKey string) string {
+	name := terraformInvalidNameChars.ReplaceAllString(repoKey, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+		name = "repo_" + name
+	}
+	return name
+}
+
+// toTerraformValue formats the value as an HCL expression. Template values are strings, so they are converted according
+// to the value type of the key's writer. An empty expression is returned for values which can't be represented as an attribute.
+func toTerraformValue(key string, value interface{}) (string, error) {
+	if stringValue, ok := value.(string); ok {
+		writer, hasWriter := writersMap[key]
+		if !hasWriter {
+			return quoteTerraformString(stringValue), nil
+		}
+		switch getWriterValueType(writer) {
+		case boolValueType:
+			boolValue, err := strconv.ParseBool(stringValue)
+			if err != nil {
+				return "", errorutils.CheckErrorf("invalid boolean value '%s' for '%s'", stringValue, key)
+			}
+			return strconv.FormatBool(boolValue), nil
+		case intValueType:
+			if _, err := strconv.Atoi(stringValue); err != nil {
+				return "", errorutils.CheckErrorf("invalid integer value '%s' for '%s'", stringValue, key)
+			}
+			return stringValue, nil
+		case stringArrayValueType:
+			var values []interface{}
+			for _, item := range strings.Split(stringValue, ",") {
+				if item = strings.TrimSpace(item); item != "" {
+					values = append(values, item)
+				}
+			}
+			return toTerraformValue(key, values)
+		}
+		return quoteTerraformString(stringValue), nil
+	}
+	switch typedValue := value.(type) {
+	case bool:
+		return strconv.FormatBool(typedValue), nil
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64), nil
+	case json.Number:
+		return typedValue.String(), nil
+	case []interface{}:
+		items := make([]string, 0, len(typedValue))
+		for _, item := range typedValue {
+			itemValue, err := toTerraformValue("", item)
+			if err != nil {
+				return "", err
+			}
+			if itemValue == "" {
+				return "", nil
+			}
+			items = append(items, itemValue)
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	case []string:
+		items := make([]string, 0, len(typedValue))
+		for _, item := range typedValue {
+			items = append(items, quoteTerraformString(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	}
+	return "", nil
+}
+
+// quoteTerraformString quotes the string as an HCL string literal, escaping template sequences so they are not interpolated.
+func quoteTerraformString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
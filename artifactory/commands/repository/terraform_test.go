@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToTerraformHcl(t *testing.T) {
+	repoConfigMaps := []map[string]interface{}{
+		{
+			Key:              "maven-local",
+			Rclass:           Local,
+			PackageType:      Maven,
+			Description:      "Maven ${env} releases",
+			HandleSnapshots:  "false",
+			MaxUniqueTags:    "10",
+			environmentsKey:  "DEV,PROD",
+			DockerApiVersion: "V2",
+		},
+		{
+			Key:                    "1-docker",
+			Rclass:                 Local,
+			PackageType:            Docker,
+			XrayIndex:              true,
+			ContentSynchronisation: map[string]interface{}{"enabled": true},
+		},
+		{
+			Key:          "npm-virtual",
+			Rclass:       Virtual,
+			PackageType:  Npm,
+			Repositories: []interface{}{"npm-local", "npm-remote"},
+		},
+	}
+
+	hcl, err := ToTerraformHcl(repoConfigMaps)
+	require.NoError(t, err)
+	expected := `resource "artifactory_local_maven_repository" "maven-local" {
+  key = "maven-local"
+  description = "Maven $${env} releases"
+  project_environments = ["DEV", "PROD"]
+  handle_snapshots = false
+  max_unique_tags = 10
+
+  # The following settings have no equivalent attribute in the Terraform provider:
+  # dockerApiVersion = "V2"
+}
+
+resource "artifactory_local_docker_v2_repository" "repo_1-docker" {
+  key = "1-docker"
+  xray_index = true
+
+  # The following settings have no equivalent attribute in the Terraform provider:
+  # contentSynchronisation = {"enabled":true}
+}
+
+resource "artifactory_virtual_npm_repository" "npm-virtual" {
+  key = "npm-virtual"
+  repositories = ["npm-local", "npm-remote"]
+}
+`
+	assert.Equal(t, expected, hcl)
+}
+
+func TestToTerraformHcl_Errors(t *testing.T) {
+	_, err := ToTerraformHcl([]map[string]interface{}{{Key: "no-rclass", PackageType: Maven}})
+	assert.ErrorContains(t, err, "must have the 'key', 'rclass' and 'packageType' keys")
+
+	_, err = ToTerraformHcl([]map[string]interface{}{{Key: "repo", Rclass: Local, PackageType: Maven, HandleReleases: "maybe"}})
+	assert.ErrorContains(t, err, "invalid boolean value 'maybe' for 'handleReleases'")
+}
@@ -22,6 +22,90 @@ func (ruc *RepoUpdateCommand) SetVars(vars string) *RepoUpdateCommand {
 	return ruc
 }
 
+func (ruc *RepoUpdateCommand) SetValidate(validate bool) *RepoUpdateCommand {
+	ruc.validate = validate
+	return ruc
+}
+
+func (ruc *RepoUpdateCommand) SetValidateOnly(validateOnly bool) *RepoUpdateCommand {
+	ruc.validateOnly = validateOnly
+	return ruc
+}
+
+// SetCheckStorage sets whether the storage provider of the instance is checked to support cdnRedirect.
+func (ruc *RepoUpdateCommand) SetCheckStorage(checkStorage bool) *RepoUpdateCommand {
+	ruc.checkStorage = checkStorage
+	return ruc
+}
+
+// SetCheckLayouts sets whether the repository layouts the repositories refer to are checked to exist on the instance.
+func (ruc *RepoUpdateCommand) SetCheckLayouts(checkLayouts bool) *RepoUpdateCommand {
+	ruc.checkLayouts = checkLayouts
+	return ruc
+}
+
+// SetDockerHardening sets whether the recommended security settings, such as blocking schema 1 manifests, are applied to
+// the Docker repositories which don't set them.
+func (ruc *RepoUpdateCommand) SetDockerHardening(dockerHardening bool) *RepoUpdateCommand {
+	ruc.dockerHardening = dockerHardening
+	return ruc
+}
+
+// SetProgress sets whether the progress of creating or updating the repositories one by one is reported.
+func (ruc *RepoUpdateCommand) SetProgress(progress bool) *RepoUpdateCommand {
+	ruc.progress = progress
+	return ruc
+}
+
+// SetQuiet sets whether the progress and the summary of the run are reported.
+func (ruc *RepoUpdateCommand) SetQuiet(quiet bool) *RepoUpdateCommand {
+	ruc.quiet = quiet
+	return ruc
+}
+
+// SetSummaryFormat sets the format of the summary of the run: text or json.
+func (ruc *RepoUpdateCommand) SetSummaryFormat(summaryFormat string) *RepoUpdateCommand {
+	ruc.summaryFormat = summaryFormat
+	return ruc
+}
+
+// SetOnSuccess sets the hook invoked after each repository is created or updated: an HTTP(S) URL or a shell command.
+func (ruc *RepoUpdateCommand) SetOnSuccess(onSuccess string) *RepoUpdateCommand {
+	ruc.onSuccess = onSuccess
+	return ruc
+}
+
+// SetFailOnHookError sets whether a failed hook fails the command, instead of being reported as a warning.
+func (ruc *RepoUpdateCommand) SetFailOnHookError(failOnHookError bool) *RepoUpdateCommand {
+	ruc.failOnHookError = failOnHookError
+	return ruc
+}
+
+func (ruc *RepoUpdateCommand) SetStrictPatterns(strictPatterns bool) *RepoUpdateCommand {
+	ruc.strictPatterns = strictPatterns
+	return ruc
+}
+
+func (ruc *RepoUpdateCommand) SetStrict(strict bool) *RepoUpdateCommand {
+	ruc.strict = strict
+	return ruc
+}
+
+func (ruc *RepoUpdateCommand) SetAnnotate(annotate bool) *RepoUpdateCommand {
+	ruc.annotate = annotate
+	return ruc
+}
+
+func (ruc *RepoUpdateCommand) SetVerifyMembers(verifyMembers bool) *RepoUpdateCommand {
+	ruc.verifyMembers = verifyMembers
+	return ruc
+}
+
+func (ruc *RepoUpdateCommand) SetIncludeKeys(includeKeys string) *RepoUpdateCommand {
+	ruc.includeKeys = includeKeys
+	return ruc
+}
+
 func (ruc *RepoUpdateCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoUpdateCommand {
 	ruc.serverDetails = serverDetails
 	return ruc
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// RepoUsedByCommand lists the virtual repositories which include a repository as a member, so the repository can be
+// deleted or reconfigured without unknowingly breaking them.
+type RepoUsedByCommand struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+}
+
+func NewRepoUsedByCommand() *RepoUsedByCommand {
+	return &RepoUsedByCommand{}
+}
+
+func (rubc *RepoUsedByCommand) SetRepoKey(repoKey string) *RepoUsedByCommand {
+	rubc.repoKey = repoKey
+	return rubc
+}
+
+func (rubc *RepoUsedByCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoUsedByCommand {
+	rubc.serverDetails = serverDetails
+	return rubc
+}
+
+func (rubc *RepoUsedByCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rubc.serverDetails, nil
+}
+
+func (rubc *RepoUsedByCommand) CommandName() string {
+	return "rt_repo_used_by"
+}
+
+func (rubc *RepoUsedByCommand) Run() error {
+	servicesManager, err := rtUtils.CreateServiceManager(rubc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	exists, err := isRepoExistsWithRetries(servicesManager, rubc.repoKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		// Virtual repositories may still refer to a repository which was already deleted
+		log.Warn(fmt.Sprintf("Repository '%s' doesn't exist.", rubc.repoKey))
+	}
+	virtualRepoKeys, err := getIncludingVirtualRepos(servicesManager, rubc.repoKey)
+	if err != nil {
+		return err
+	}
+	if len(virtualRepoKeys) == 0 {
+		log.Info(fmt.Sprintf("Repository '%s' isn't included in any virtual repository.", rubc.repoKey))
+		return nil
+	}
+	for _, virtualRepoKey := range virtualRepoKeys {
+		fmt.Println(virtualRepoKey)
+	}
+	return nil
+}
+
+// getIncludingVirtualRepos returns the sorted keys of the virtual repositories which include the repository as a member.
+func getIncludingVirtualRepos(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) ([]string, error) {
+	repos, err := servicesManager.GetAllRepositoriesFiltered(services.RepositoriesFilterParams{RepoType: Virtual})
+	if err != nil {
+		return nil, err
+	}
+	var virtualRepoKeys []string
+	for _, repo := range *repos {
+		repoConfigMap := make(map[string]interface{})
+		if err = servicesManager.GetRepository(repo.Key, &repoConfigMap); err != nil {
+			return nil, err
+		}
+		if slices.Contains(getVirtualRepoMembers(repoConfigMap), repoKey) {
+			virtualRepoKeys = append(virtualRepoKeys, repo.Key)
+		}
+	}
+	sort.Strings(virtualRepoKeys)
+	return virtualRepoKeys, nil
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetIncludingVirtualRepos(t *testing.T) {
+	repos := map[string]string{
+		"npm-virtual":  `{"key": "npm-virtual", "rclass": "virtual", "packageType": "npm", "repositories": ["npm-local", "npm-remote"]}`,
+		"all-virtual":  `{"key": "all-virtual", "rclass": "virtual", "packageType": "npm", "repositories": ["npm-virtual", "npm-local"]}`,
+		"team-virtual": `{"key": "team-virtual", "rclass": "virtual", "packageType": "npm", "repositories": ["team-local"]}`,
+		"empty":        `{"key": "empty", "rclass": "virtual", "packageType": "npm"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/repositories":
+			assert.Equal(t, Virtual, r.URL.Query().Get("type"))
+			_, _ = w.Write([]byte(`[{"key": "npm-virtual"}, {"key": "all-virtual"}, {"key": "team-virtual"}, {"key": "empty"}]`))
+		case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+			_, _ = w.Write([]byte(repos[strings.TrimPrefix(r.URL.Path, "/api/repositories/")]))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, -1, 0, false)
+	require.NoError(t, err)
+
+	tests := map[string][]string{
+		"npm-local":   {"all-virtual", "npm-virtual"},
+		"npm-remote":  {"npm-virtual"},
+		"npm-virtual": {"all-virtual"},
+		"go-local":    nil,
+	}
+	for repoKey, expected := range tests {
+		virtualRepoKeys, err := getIncludingVirtualRepos(servicesManager, repoKey)
+		require.NoError(t, err)
+		assert.Equal(t, expected, virtualRepoKeys, repoKey)
+	}
+}
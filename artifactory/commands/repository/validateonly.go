@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// validateRepoConfigsOnly makes the checks which are otherwise made while the repositories are created or updated, without
+// creating or updating them. The repository configuration API of Artifactory has no validate-only mode, so the
+// configurations are validated by the CLI, using read-only requests to check that the repositories exist, when updated,
+// or don't exist yet, when created, and that the referenced key pairs exist.
+// All the problems found are reported together.
+func validateRepoConfigsOnly(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager, isUpdate bool) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		repoKey := fmt.Sprint(repoConfigMap[Key])
+		for _, err := range validateRepoConfigValues(repoConfigMap) {
+			problems = append(problems, fmt.Sprintf("repository '%s': %s", repoKey, err.Error()))
+		}
+		exists, err := isRepoExistsWithRetries(servicesManager, repoKey)
+		if err != nil {
+			return err
+		}
+		switch {
+		case isUpdate && !exists:
+			problems = append(problems, fmt.Sprintf("repository '%s': the repository doesn't exist", repoKey))
+		case !isUpdate && exists:
+			problems = append(problems, fmt.Sprintf("repository '%s': the repository already exists", repoKey))
+		}
+	}
+	if err := validateKeyPairRefs(repoConfigMaps, servicesManager); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if len(problems) > 0 {
+		return errorutils.CheckErrorf("the template is invalid:\n%s", strings.Join(problems, "\n"))
+	}
+	log.Info(fmt.Sprintf("The configurations of %d repositories are valid. No repositories were created or updated.", len(repoConfigMaps)))
+	return nil
+}
+
+// validateRepoConfigValues returns the errors the handlers would fail on while writing the values of the repository
+// configuration, without changing it.
+func validateRepoConfigValues(repoConfigMap map[string]interface{}) []error {
+	var errs []error
+	if err := validateChecksumPolicy(repoConfigMap); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := getRepoHandler(repoConfigMap[Rclass], fmt.Sprint(repoConfigMap[PackageType])); err != nil {
+		errs = append(errs, err)
+	}
+	writtenConfigMap := make(map[string]interface{}, len(repoConfigMap))
+	for _, key := range getSortedKeys(repoConfigMap) {
+		value := repoConfigMap[key]
+		if csObject, ok := value.(map[string]interface{}); ok && key == ContentSynchronisation {
+			if _, err := parseContentSynchronisationObject(csObject); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := utils.ValidateMapEntry(key, value, writersMap); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if slices.Contains(nonNegativeIntKeys, key) {
+			if err := validateNonNegativeInt(key, fmt.Sprint(value)); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+		if err := writersMap[key](&writtenConfigMap, key, fmt.Sprint(value)); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value '%v' for '%s': %w", value, key, err))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PerformRepoCmd_ValidateOnly(t *testing.T) {
+	const template = `[
+		{"key": "npm-local", "rclass": "local", "packageType": "npm", "description": "npm packages"},
+		{"key": "maven-local", "rclass": "local", "packageType": "maven", "blackedOut": "maybe"}
+	]`
+	tests := []struct {
+		name             string
+		isUpdate         bool
+		expectedProblems []string
+	}{
+		{
+			name:     "Create",
+			isUpdate: false,
+			expectedProblems: []string{
+				"repository 'npm-local': the repository already exists",
+				"repository 'maven-local': invalid value 'maybe' for 'blackedOut'",
+			},
+		},
+		{
+			name:     "Update",
+			isUpdate: true,
+			expectedProblems: []string{
+				"repository 'maven-local': invalid value 'maybe' for 'blackedOut'",
+				"repository 'maven-local': the repository doesn't exist",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Validation must not change anything on the server.
+				assert.Equal(t, http.MethodGet, r.Method)
+				if r.URL.Path == "/api/repositories/npm-local" {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				w.WriteHeader(http.StatusBadRequest)
+			}))
+			defer testServer.Close()
+
+			repoCmd := &RepoCommand{
+				serverDetails: &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"},
+				templatePath:  createTempTemplate(t, template),
+				validateOnly:  true,
+			}
+			err := repoCmd.PerformRepoCmd(tt.isUpdate)
+			require.Error(t, err)
+			assert.ErrorContains(t, err, "the template is invalid:\n")
+			for _, problem := range tt.expectedProblems {
+				assert.ErrorContains(t, err, problem)
+			}
+			assert.Len(t, strings.Split(err.Error(), "\n"), len(tt.expectedProblems)+1)
+		})
+	}
+}
+
+func Test_ValidateRepoConfigValues(t *testing.T) {
+	assert.Empty(t, validateRepoConfigValues(map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: Docker, MaxUniqueTags: "3"}))
+
+	errs := validateRepoConfigValues(map[string]interface{}{Key: "docker-remote", Rclass: Remote, PackageType: "unknown", MaxUniqueTags: "-1", "unknownKey": "value"})
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	assert.Len(t, messages, 3)
+	assert.Contains(t, strings.Join(messages, "\n"), "unsupported package type: unknown")
+	assert.Contains(t, strings.Join(messages, "\n"), "invalid value '-1' for 'maxUniqueTags'")
+	assert.Contains(t, strings.Join(messages, "\n"), "unknown key: \"unknownKey\"")
+}
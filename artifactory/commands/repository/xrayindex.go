@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	xrayVersionApi = "api/v1/system/version"
+	// Lists the repositories indexed by Xray, out of the repositories of the Artifactory instance.
+	xrayIndexedReposApi = "api/v1/binMgr/default/repos"
+
+	defaultXrayIndexMaxWait = 10 * time.Minute
+)
+
+// The interval between the checks of whether Xray indexes the repositories. Replaced in tests.
+var xrayIndexPollingInterval = 10 * time.Second
+
+// The editions in which Xray isn't available.
+var editionsWithoutXray = []artifactoryEdition{editionOss, editionJcr}
+
+// RepoXrayIndexCommand sets the xrayIndex field of the repositories matching a list of keys and patterns. When enabling
+// indexing, it can also wait until Xray reports the repositories as indexed, so onboarding flows know indexing is
+// active rather than only configured. Repositories which have no xrayIndex setting are skipped.
+type RepoXrayIndexCommand struct {
+	serverDetails *config.ServerDetails
+	repoPatterns  []string
+	enabled       bool
+	dryRun        bool
+	wait          bool
+	maxWait       time.Duration
+}
+
+func NewRepoXrayIndexCommand() *RepoXrayIndexCommand {
+	return &RepoXrayIndexCommand{maxWait: defaultXrayIndexMaxWait}
+}
+
+// SetRepoPatterns sets the keys of the repositories to update. Keys containing the * wildcard are patterns.
+func (rxic *RepoXrayIndexCommand) SetRepoPatterns(repoPatterns []string) *RepoXrayIndexCommand {
+	rxic.repoPatterns = repoPatterns
+	return rxic
+}
+
+func (rxic *RepoXrayIndexCommand) SetEnabled(enabled bool) *RepoXrayIndexCommand {
+	rxic.enabled = enabled
+	return rxic
+}
+
+func (rxic *RepoXrayIndexCommand) SetDryRun(dryRun bool) *RepoXrayIndexCommand {
+	rxic.dryRun = dryRun
+	return rxic
+}
+
+// SetWait sets whether to wait until Xray reports the repositories as indexed, after enabling indexing.
+func (rxic *RepoXrayIndexCommand) SetWait(wait bool) *RepoXrayIndexCommand {
+	rxic.wait = wait
+	return rxic
+}
+
+// SetMaxWait sets how long to wait for Xray to index the repositories.
+func (rxic *RepoXrayIndexCommand) SetMaxWait(maxWait time.Duration) *RepoXrayIndexCommand {
+	rxic.maxWait = maxWait
+	return rxic
+}
+
+func (rxic *RepoXrayIndexCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoXrayIndexCommand {
+	rxic.serverDetails = serverDetails
+	return rxic
+}
+
+func (rxic *RepoXrayIndexCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rxic.serverDetails, nil
+}
+
+func (rxic *RepoXrayIndexCommand) CommandName() string {
+	return "rt_repo_xray_index"
+}
+
+func (rxic *RepoXrayIndexCommand) Run() error {
+	if rxic.wait && !rxic.enabled {
+		return errorutils.CheckErrorf("waiting for the repositories to be indexed is only possible when enabling Xray indexing")
+	}
+	servicesManager, err := rtUtils.CreateServiceManager(rxic.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if rxic.enabled {
+		if err = rxic.validateXrayAvailable(servicesManager); err != nil {
+			return err
+		}
+	}
+	repoKeys, err := getMatchingRepoKeys(servicesManager, rxic.repoPatterns)
+	if err != nil {
+		return err
+	}
+	if len(repoKeys) == 0 {
+		log.Info("No repositories match the patterns:", strings.Join(rxic.repoPatterns, ", "))
+		return nil
+	}
+	var applicableRepoKeys []string
+	changed, unchanged, skipped := 0, 0, 0
+	for _, repoKey := range repoKeys {
+		isChanged, isApplicable, err := rxic.setXrayIndex(servicesManager, repoKey)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !isApplicable:
+			skipped++
+			continue
+		case isChanged:
+			changed++
+		default:
+			unchanged++
+		}
+		applicableRepoKeys = append(applicableRepoKeys, repoKey)
+	}
+	changedVerb := "changed"
+	if rxic.dryRun {
+		changedVerb = "would change"
+	}
+	log.Info(fmt.Sprintf("%d repositories %s, %d unchanged, %d skipped.", changed, changedVerb, unchanged, skipped))
+	if !rxic.wait || rxic.dryRun || len(applicableRepoKeys) == 0 {
+		return nil
+	}
+	return rxic.waitForIndexing(servicesManager, applicableRepoKeys)
+}
+
+// validateXrayAvailable fails if the edition of the instance doesn't provide Xray, or if Xray doesn't respond.
+func (rxic *RepoXrayIndexCommand) validateXrayAvailable(servicesManager artifactory.ArtifactoryServicesManager) error {
+	if edition := newEditionProbe(servicesManager).getEdition(); slices.Contains(editionsWithoutXray, edition) {
+		return errorutils.CheckErrorf("Xray isn't available in Artifactory %s, it requires Artifactory %s or above", edition, editionPro)
+	}
+	body, err := rxic.sendXrayGet(servicesManager, xrayVersionApi)
+	if err != nil {
+		return errorutils.CheckErrorf("Xray isn't available at %s: %s", rxic.getXrayUrl(), err.Error())
+	}
+	version := struct {
+		Version string `json:"xray_version"`
+	}{}
+	if err = json.Unmarshal(body, &version); err != nil {
+		return errorutils.CheckErrorf("failed to parse the Xray version: %s", err.Error())
+	}
+	log.Debug("Detected Xray version", version.Version)
+	return nil
+}
+
+// setXrayIndex returns whether the setting of the repository was changed, or would be changed on dry run, and whether
+// the setting is applicable to the repository at all.
+func (rxic *RepoXrayIndexCommand) setXrayIndex(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (changed, applicable bool, err error) {
+	repoConfigMap := make(map[string]interface{})
+	if err = servicesManager.GetRepository(repoKey, &repoConfigMap); err != nil {
+		return
+	}
+	rclass, pkgType := fmt.Sprint(repoConfigMap[Rclass]), fmt.Sprint(repoConfigMap[PackageType])
+	if !slices.Contains(getRclassTemplateKeys(rclass, pkgType, Update), XrayIndex) {
+		log.Info("Repository", repoKey, "- skipped, as Xray indexing isn't applicable to", rclass, "repositories")
+		return false, false, nil
+	}
+	if current, _ := repoConfigMap[XrayIndex].(bool); current == rxic.enabled {
+		log.Info("Repository", repoKey, "-", XrayIndex, "is already", rxic.enabled, "(no change)")
+		return false, true, nil
+	}
+	if rxic.dryRun {
+		log.Info("[Dry run] Repository", repoKey, "-", XrayIndex, "would change from", !rxic.enabled, "to", rxic.enabled)
+		return true, true, nil
+	}
+	if err = updateRepoConfig(servicesManager, repoKey, rclass, map[string]interface{}{XrayIndex: rxic.enabled}); err != nil {
+		return
+	}
+	log.Info("Repository", repoKey, "-", XrayIndex, "changed from", !rxic.enabled, "to", rxic.enabled)
+	return true, true, nil
+}
+
+// waitForIndexing polls Xray until it reports all the repositories as indexed, and reports the repositories which
+// aren't indexed when the maximal wait time is reached.
+func (rxic *RepoXrayIndexCommand) waitForIndexing(servicesManager artifactory.ArtifactoryServicesManager, repoKeys []string) error {
+	log.Info(fmt.Sprintf("Waiting up to %s for Xray to index %d repositories...", rxic.maxWait, len(repoKeys)))
+	var pending []string
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         rxic.maxWait,
+		PollingInterval: xrayIndexPollingInterval,
+		MsgPrefix:       "Waiting for Xray indexing:",
+		PollingAction: func() (shouldStop bool, responseBody []byte, err error) {
+			indexed, err := rxic.getIndexedRepoKeys(servicesManager)
+			if err != nil {
+				return true, nil, err
+			}
+			pending = pending[:0]
+			for _, repoKey := range repoKeys {
+				if !indexed[repoKey] {
+					pending = append(pending, repoKey)
+				}
+			}
+			log.Info(fmt.Sprintf("%d of %d repositories are indexed by Xray.", len(repoKeys)-len(pending), len(repoKeys)))
+			return len(pending) == 0, nil, nil
+		},
+	}
+	if _, err := pollingExecutor.Execute(); err != nil {
+		if len(pending) > 0 {
+			return errorutils.CheckErrorf("Xray didn't index the following repositories within %s: %s", rxic.maxWait, strings.Join(pending, ", "))
+		}
+		return err
+	}
+	log.Info("Xray indexes all the repositories.")
+	return nil
+}
+
+// getIndexedRepoKeys returns the keys of the repositories Xray indexes.
+func (rxic *RepoXrayIndexCommand) getIndexedRepoKeys(servicesManager artifactory.ArtifactoryServicesManager) (map[string]bool, error) {
+	body, err := rxic.sendXrayGet(servicesManager, xrayIndexedReposApi)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to get the repositories indexed by Xray: %s", err.Error())
+	}
+	indexedRepos := struct {
+		IndexedRepos []struct {
+			Name string `json:"name"`
+		} `json:"indexed_repos"`
+	}{}
+	if err = json.Unmarshal(body, &indexedRepos); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the repositories indexed by Xray: %s", err.Error())
+	}
+	indexed := make(map[string]bool, len(indexedRepos.IndexedRepos))
+	for _, repo := range indexedRepos.IndexedRepos {
+		indexed[repo.Name] = true
+	}
+	return indexed, nil
+}
+
+func (rxic *RepoXrayIndexCommand) sendXrayGet(servicesManager artifactory.ArtifactoryServicesManager, api string) ([]byte, error) {
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(rxic.getXrayUrl()+api, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// getXrayUrl returns the configured Xray URL, or derives it from the Artifactory URL of the platform.
+func (rxic *RepoXrayIndexCommand) getXrayUrl() string {
+	if rxic.serverDetails.XrayUrl != "" {
+		return strings.TrimSuffix(rxic.serverDetails.XrayUrl, "/") + "/"
+	}
+	artifactoryUrl := strings.TrimSuffix(rxic.serverDetails.ArtifactoryUrl, "/")
+	return strings.TrimSuffix(artifactoryUrl, "/artifactory") + "/xray/"
+}
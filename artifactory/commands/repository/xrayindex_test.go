@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newXrayIndexTestServer serves the repositories and the Xray APIs. A repository updated with xrayIndex enabled is
+// reported as indexed by Xray after the given number of polls.
+func newXrayIndexTestServer(t *testing.T, licenseType string, pollsUntilIndexed int, updated map[string]bool) *httptest.Server {
+	repos := map[string]map[string]interface{}{
+		"team-maven":   {"rclass": "local", "packageType": "maven", "xrayIndex": false},
+		"team-npm":     {"rclass": "remote", "packageType": "npm", "url": "https://registry.npmjs.org", "xrayIndex": true},
+		"team-virtual": {"rclass": "virtual", "packageType": "maven"},
+	}
+	polls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/system/license":
+			_, _ = w.Write([]byte(`{"type": "` + licenseType + `"}`))
+		case r.URL.Path == "/xray/"+xrayVersionApi:
+			_, _ = w.Write([]byte(`{"xray_version": "3.100.0"}`))
+		case r.URL.Path == "/xray/"+xrayIndexedReposApi:
+			polls++
+			indexedRepos := `{"name": "team-npm"}`
+			if updated["team-maven"] && polls >= pollsUntilIndexed {
+				indexedRepos += `, {"name": "team-maven"}`
+			}
+			_, _ = w.Write([]byte(`{"indexed_repos": [` + indexedRepos + `], "non_indexed_repos": []}`))
+		case r.URL.Path == "/api/repositories" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`[{"key":"team-maven"},{"key":"team-npm"},{"key":"team-virtual"}]`))
+		case strings.HasPrefix(r.URL.Path, "/api/repositories/"):
+			repoKey := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+			if r.Method == http.MethodPost {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				var repoConfigMap map[string]interface{}
+				require.NoError(t, json.Unmarshal(body, &repoConfigMap))
+				updated[repoKey] = repoConfigMap["xrayIndex"].(bool)
+				return
+			}
+			repoConfigMap := map[string]interface{}{"key": repoKey}
+			for key, value := range repos[repoKey] {
+				repoConfigMap[key] = value
+			}
+			content, err := json.Marshal(repoConfigMap)
+			require.NoError(t, err)
+			_, _ = w.Write(content)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func Test_RepoXrayIndexCommand(t *testing.T) {
+	previousInterval := xrayIndexPollingInterval
+	xrayIndexPollingInterval = 10 * time.Millisecond
+	defer func() {
+		xrayIndexPollingInterval = previousInterval
+	}()
+
+	tests := []struct {
+		name              string
+		licenseType       string
+		enabled           bool
+		dryRun            bool
+		wait              bool
+		pollsUntilIndexed int
+		expectedUpdated   map[string]bool
+		expectedErrorMsg  string
+	}{
+		{name: "Enable", licenseType: "Enterprise", enabled: true, expectedUpdated: map[string]bool{"team-maven": true}},
+		{name: "Enable and wait", licenseType: "Enterprise", enabled: true, wait: true, pollsUntilIndexed: 3, expectedUpdated: map[string]bool{"team-maven": true}},
+		{name: "Wait timeout", licenseType: "Enterprise", enabled: true, wait: true, pollsUntilIndexed: 1000, expectedUpdated: map[string]bool{"team-maven": true},
+			expectedErrorMsg: "Xray didn't index the following repositories within 50ms: team-maven"},
+		{name: "Dry run", licenseType: "Enterprise", enabled: true, dryRun: true, wait: true, expectedUpdated: map[string]bool{}},
+		{name: "Disable", licenseType: "Enterprise", enabled: false, expectedUpdated: map[string]bool{"team-npm": false}},
+		{name: "Wait on disable", licenseType: "Enterprise", enabled: false, wait: true, expectedUpdated: map[string]bool{},
+			expectedErrorMsg: "only possible when enabling Xray indexing"},
+		{name: "Edition without Xray", licenseType: "OSS", enabled: true, expectedUpdated: map[string]bool{},
+			expectedErrorMsg: "Xray isn't available in Artifactory OSS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := map[string]bool{}
+			testServer := newXrayIndexTestServer(t, tt.licenseType, tt.pollsUntilIndexed, updated)
+			defer testServer.Close()
+
+			xrayIndexCmd := NewRepoXrayIndexCommand().SetRepoPatterns([]string{"team-*"}).SetEnabled(tt.enabled).SetDryRun(tt.dryRun).
+				SetWait(tt.wait).SetMaxWait(50 * time.Millisecond).SetServerDetails(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"})
+			err := xrayIndexCmd.Run()
+			if tt.expectedErrorMsg != "" {
+				assert.ErrorContains(t, err, tt.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedUpdated, updated)
+		})
+	}
+}
+
+func Test_RepoXrayIndexGetXrayUrl(t *testing.T) {
+	xrayIndexCmd := NewRepoXrayIndexCommand().SetServerDetails(&config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory/"})
+	assert.Equal(t, "https://acme.jfrog.io/xray/", xrayIndexCmd.getXrayUrl())
+
+	xrayIndexCmd.SetServerDetails(&config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory/", XrayUrl: "https://xray.acme.io"})
+	assert.Equal(t, "https://xray.acme.io/", xrayIndexCmd.getXrayUrl())
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Package types whose repositories calculate YUM metadata.
+var yumPackageTypes = []string{Rpm, Yum}
+
+// validateYumSettings validates the YUM metadata settings of RPM and YUM repositories, and writes them with their JSON
+// types, as they may be provided as strings by the templates. A negative root depth is always an error. A root depth
+// without calculating the metadata, which Artifactory doesn't calculate by default, has no effect and is likely a mistake.
+// Such problems are reported as warnings, unless strict is set, in which case they are fatal. When updating, an unset
+// metadata calculation keeps the current setting, so only an explicit disabling is reported.
+func validateYumSettings(repoConfigMaps []map[string]interface{}, isUpdate, strict bool) error {
+	var problems []string
+	for _, repoConfigMap := range repoConfigMaps {
+		if !slices.Contains(yumPackageTypes, fmt.Sprint(repoConfigMap[PackageType])) {
+			continue
+		}
+		problem, err := getYumSettingsProblem(repoConfigMap, isUpdate)
+		if err != nil {
+			return err
+		}
+		if problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if strict {
+		return errorutils.CheckErrorf("YUM settings check failed:\n%s", strings.Join(problems, "\n"))
+	}
+	for _, problem := range problems {
+		log.Warn(problem)
+	}
+	return nil
+}
+
+func getYumSettingsProblem(repoConfigMap map[string]interface{}, isUpdate bool) (string, error) {
+	repoKey := repoConfigMap[Key]
+	calculateMetadata, isCalculateMetadataSet, err := coerceYumSetting(repoConfigMap, CalculateYumMetadata, func(value string) (interface{}, bool) {
+		return parseBoolAnswer(value)
+	})
+	if err != nil {
+		return "", err
+	}
+	rootDepth, isRootDepthSet, err := coerceYumSetting(repoConfigMap, YumRootDepth, func(value string) (interface{}, bool) {
+		intValue, err := strconv.Atoi(strings.TrimSpace(value))
+		return intValue, err == nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if !isRootDepthSet {
+		return "", nil
+	}
+	if rootDepth.(int) < 0 {
+		return "", errorutils.CheckErrorf("repository '%v': invalid value '%d' for '%s': the value must not be negative, use 0 for the repository root", repoKey, rootDepth, YumRootDepth)
+	}
+	if isCalculateMetadataSet && !calculateMetadata.(bool) || !isCalculateMetadataSet && !isUpdate {
+		return fmt.Sprintf("repository '%v': '%s' is set, but '%s' isn't enabled, so the root depth has no effect", repoKey, YumRootDepth, CalculateYumMetadata), nil
+	}
+	return "", nil
+}
+
+// coerceYumSetting parses the value of a setting and writes it back with its JSON type. The setting is reported as unset
+// when it's missing, or when its value has variables which weren't replaced, and is then left unchanged.
+func coerceYumSetting(repoConfigMap map[string]interface{}, key string, parse func(value string) (interface{}, bool)) (interface{}, bool, error) {
+	value, ok := repoConfigMap[key]
+	if !ok || value == nil {
+		return nil, false, nil
+	}
+	stringValue := fmt.Sprint(value)
+	if ioutils.VarPattern.MatchString(stringValue) {
+		return nil, false, nil
+	}
+	if floatValue, isFloat := value.(float64); isFloat {
+		// Numbers in JSON templates are decoded as float64
+		stringValue = strconv.FormatFloat(floatValue, 'f', -1, 64)
+	}
+	parsedValue, ok := parse(stringValue)
+	if !ok {
+		return nil, false, errorutils.CheckErrorf("repository '%v': invalid value '%v' for '%s'", repoConfigMap[Key], value, key)
+	}
+	repoConfigMap[key] = parsedValue
+	return parsedValue, true, nil
+}
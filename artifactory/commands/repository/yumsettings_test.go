@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidateYumSettings(t *testing.T) {
+	tests := []struct {
+		name           string
+		repoConfigMap  map[string]interface{}
+		isUpdate       bool
+		expectedConfig map[string]interface{}
+		expectedError  string
+		expectedFatal  bool
+	}{
+		{
+			name:           "Root depth with metadata calculation",
+			repoConfigMap:  map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "2", CalculateYumMetadata: "yes"},
+			expectedConfig: map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: 2, CalculateYumMetadata: true},
+		},
+		{
+			name:           "Numbers of JSON templates",
+			repoConfigMap:  map[string]interface{}{Key: "yum-local", PackageType: Yum, YumRootDepth: float64(0), CalculateYumMetadata: true},
+			expectedConfig: map[string]interface{}{Key: "yum-local", PackageType: Yum, YumRootDepth: 0, CalculateYumMetadata: true},
+		},
+		{
+			name:           "Root depth with disabled metadata calculation",
+			repoConfigMap:  map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: float64(3), CalculateYumMetadata: "false"},
+			expectedConfig: map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: 3, CalculateYumMetadata: false},
+			expectedError:  "repository 'rpm-local': 'yumRootDepth' is set, but 'calculateYumMetadata' isn't enabled, so the root depth has no effect",
+		},
+		{
+			name:           "Root depth without metadata calculation",
+			repoConfigMap:  map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "3"},
+			expectedConfig: map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: 3},
+			expectedError:  "repository 'rpm-local': 'yumRootDepth' is set, but 'calculateYumMetadata' isn't enabled",
+		},
+		{
+			name:           "Update keeps the current metadata calculation",
+			repoConfigMap:  map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "3"},
+			isUpdate:       true,
+			expectedConfig: map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: 3},
+		},
+		{
+			name:           "Variables are left unchanged",
+			repoConfigMap:  map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "${depth}", CalculateYumMetadata: "${calculate}"},
+			expectedConfig: map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "${depth}", CalculateYumMetadata: "${calculate}"},
+		},
+		{
+			name:           "Negative root depth",
+			repoConfigMap:  map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "-1", CalculateYumMetadata: true},
+			expectedConfig: map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: -1, CalculateYumMetadata: true},
+			expectedError:  "repository 'rpm-local': invalid value '-1' for 'yumRootDepth': the value must not be negative",
+			expectedFatal:  true,
+		},
+		{
+			name:           "Invalid root depth",
+			repoConfigMap:  map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "deep"},
+			expectedConfig: map[string]interface{}{Key: "rpm-local", PackageType: Rpm, YumRootDepth: "deep"},
+			expectedError:  "repository 'rpm-local': invalid value 'deep' for 'yumRootDepth'",
+			expectedFatal:  true,
+		},
+		{
+			name:           "Other package types are ignored",
+			repoConfigMap:  map[string]interface{}{Key: "npm-local", PackageType: Npm, YumRootDepth: "3"},
+			expectedConfig: map[string]interface{}{Key: "npm-local", PackageType: Npm, YumRootDepth: "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoConfigMaps := []map[string]interface{}{tt.repoConfigMap}
+			// Problems are only warnings, unless strict is set or the value is invalid
+			err := validateYumSettings(repoConfigMaps, tt.isUpdate, false)
+			if tt.expectedFatal {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedConfig, tt.repoConfigMap)
+			err = validateYumSettings(repoConfigMaps, tt.isUpdate, true)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
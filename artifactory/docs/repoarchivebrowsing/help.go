@@ -0,0 +1,22 @@
+package repoarchivebrowsing
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rab <true|false> <repository keys or patterns>"}
+
+func GetDescription() string {
+	return "Enable or disable archive browsing on local and federated repositories in Artifactory. Repositories which don't support archive browsing are skipped."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "true|false",
+			Description: "Set to true to enable archive browsing, or to false to disable it.",
+		},
+		{
+			Name:        "repository keys or patterns",
+			Description: "List of comma-separated repository keys or patterns, using the * wildcard or a regular expression matching the whole key prefixed with 'regex:', specifying the repositories to update.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package repoaudit
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt raud <template path>"}
+
+func GetDescription() string {
+	return "Check that the configuration of each repository in a golden template matches the template. Only the fields set by the template are compared. Exits with code 4 and prints a drift report when any repository drifted or doesn't exist."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "template path",
+			Description: "Path to the golden repository template, or to a directory of templates.",
+		},
+	}
+}
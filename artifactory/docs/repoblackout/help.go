@@ -0,0 +1,18 @@
+package repoblackout
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rbo <repository key or pattern>"}
+
+func GetDescription() string {
+	return "Black out repositories in Artifactory, making their artifacts unavailable for download and resolution. Use the repo-unblackout command to restore them."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key or pattern",
+			Description: "Specifies the repositories to black out. You can specify the name of a single repository or a pattern, using the * wildcard or a regular expression matching the whole key prefixed with 'regex:', to black out multiple repositories.",
+		},
+	}
+}
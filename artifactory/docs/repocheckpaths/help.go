@@ -0,0 +1,22 @@
+package repocheckpaths
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rcp <repository key> <path> [<path>...]"}
+
+func GetDescription() string {
+	return "Check which paths are included or excluded by the include/exclude patterns of a repository."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the repository whose include/exclude patterns are checked.",
+		},
+		{
+			Name:        "path",
+			Description: "One or more artifact paths, relative to the repository root, to check against the patterns.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package repochecksupport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rcsu <template path>"}
+
+func GetDescription() string {
+	return "Check that every rclass and package type combination in a repository template is supported by the version and the edition of the Artifactory instance, before applying the template. All the unsupported combinations are reported together."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "template path",
+			Description: "Path to the repository template, or to a directory of templates.",
+		},
+	}
+}
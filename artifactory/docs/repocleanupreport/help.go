@@ -0,0 +1,18 @@
+package repocleanupreport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rclr [repository key or pattern]"}
+
+func GetDescription() string {
+	return "Report the unused artifacts cleanup configuration of remote repositories in Artifactory, and optionally an estimate of the cached artifacts eligible for the cleanup."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key or pattern",
+			Description: "[Optional] Specifies the remote repositories to report. You can specify the name of a single repository or a pattern, using the * wildcard or a regular expression matching the whole key prefixed with 'regex:', to report multiple repositories. If not specified, all the remote repositories are reported.",
+		},
+	}
+}
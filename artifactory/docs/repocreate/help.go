@@ -16,7 +16,10 @@ func GetArguments() []components.Argument {
 		{
 			Name: "template path",
 			Description: "Specifies the local file system path for the template file to be used for the repository creation. " +
-				"The template can be created using the \"" + coreutils.GetCliExecutableName() + " rt rpt\" command.",
+				"The template can be created using the \"" + coreutils.GetCliExecutableName() + " rt rpt\" command. " +
+				"A template file may be in JSON or YAML, according to its .json, .yaml or .yml extension. " +
+				"Use '-' to read the template from the standard input. " +
+				"When a directory is provided, all the .json and .yaml templates in it are applied together, ordered by their file names.",
 		},
 	}
 }
@@ -0,0 +1,18 @@
+package repodescriptions
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rdesc <csv path>"}
+
+func GetDescription() string {
+	return "Update the description, and optionally the notes, of existing repositories in Artifactory from a CSV file. Only the fields which differ are updated, and rows which don't match an existing repository are reported."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "csv path",
+			Description: "Path to a CSV file with the 'key,description[,notes]' columns. The notes of a repository are changed only if its row has a notes column. A first row starting with 'key' is treated as a header.",
+		},
+	}
+}
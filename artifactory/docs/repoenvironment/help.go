@@ -0,0 +1,23 @@
+package repoenvironment
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt renv <repository key or pattern> <environment>",
+	"rt renv <repository key or pattern> --clear"}
+
+func GetDescription() string {
+	return "Assign an environment to repositories in Artifactory, or clear their environment. The environment must exist on the platform. The environment replaces the one already assigned to each repository."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key or pattern",
+			Description: "Specifies the repositories to change. You can specify the name of a single repository or a pattern, using the * wildcard or a regular expression matching the whole key prefixed with 'regex:', to change multiple repositories. Repositories to which environments can't be assigned, such as distribution repositories, are skipped when matching a pattern.",
+		},
+		{
+			Name:        "environment",
+			Description: "The name of the environment to assign. Omitted when --clear is set.",
+		},
+	}
+}
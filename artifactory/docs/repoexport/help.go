@@ -0,0 +1,24 @@
+package repoexport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rexp <output directory> [repository key or pattern]"}
+
+func GetDescription() string {
+	return "Export the configurations of repositories in Artifactory to a directory, as a repository template per repository. " +
+		"The progress is saved as the repositories are exported, so an interrupted export resumes where it stopped when the command is run again with the same output directory."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "output directory",
+			Description: "The directory the templates are written to. It can be used as the template path of the repo-create and repo-update commands.",
+		},
+		{
+			Name: "repository key or pattern",
+			Description: "[Optional] Specifies the repositories to export. The pattern may use the * and ? wildcards, or be a regular expression matching the whole key when prefixed with 'regex:'. " +
+				"If not specified, all the repositories are exported.",
+		},
+	}
+}
@@ -0,0 +1,23 @@
+package repoexportterraform
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+var Usage = []string{"rt rtf <template path>", "rt rtf --repo-keys=<repository keys>"}
+
+func GetDescription() string {
+	return "Print the Terraform HCL of the JFrog Artifactory provider resources equivalent to a repository template, or to existing repositories. " +
+		"Settings with no equivalent provider attribute are printed as comments."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name: "template path",
+			Description: "Specifies the local file system path for the template file of the repositories to export. " +
+				"Use '-' to read the template from the standard input. Not used when --repo-keys is provided.",
+			Optional: true,
+		},
+	}
+}
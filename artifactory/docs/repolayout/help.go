@@ -0,0 +1,18 @@
+package repolayout
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rly <repository key>"}
+
+func GetDescription() string {
+	return "Print the definition of the layout used by a repository in Artifactory, including its artifact and descriptor path patterns, as JSON. Requires admin permissions."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "Specifies the repository whose layout should be printed.",
+		},
+	}
+}
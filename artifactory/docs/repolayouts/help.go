@@ -0,0 +1,13 @@
+package repolayouts
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rlys"}
+
+func GetDescription() string {
+	return "List the repository layouts configured in Artifactory, with their artifact and descriptor path patterns, to pick the repoLayoutRef of repository templates. Requires admin permissions."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
@@ -0,0 +1,19 @@
+package repopackagetypes
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rpkg [rclass]"}
+
+func GetDescription() string {
+	return "List the package types supported for each repository class, or for the given repository class only."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "rclass",
+			Description: "Optional. The repository class whose package types are listed. Acceptable values are: local, remote, virtual and federated.",
+			Optional:    true,
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package repopriorityresolution
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rpr <repository key or pattern>"}
+
+func GetDescription() string {
+	return "Set or clear priority resolution on local, remote and federated repositories in Artifactory. Virtual repositories resolve from members with priority resolution before all their other members. The effective resolution order of the virtual repositories including the changed repositories is reported."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key or pattern",
+			Description: "Specifies the repositories to change. You can specify the name of a single repository or a pattern, using the * wildcard or a regular expression matching the whole key prefixed with 'regex:', to change multiple repositories. Virtual repositories matching a pattern are skipped.",
+		},
+	}
+}
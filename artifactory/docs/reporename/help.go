@@ -0,0 +1,22 @@
+package reporename
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rren <repository key> <new repository key>"}
+
+func GetDescription() string {
+	return "Rename a repository in Artifactory. A repository with the new key is created with the same configuration, the content of a local repository is moved or copied to it and verified, and the original repository is then deleted."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "Specifies the key of the repository to rename. Federated repositories can't be renamed.",
+		},
+		{
+			Name:        "new repository key",
+			Description: "Specifies the new key of the repository. A repository with this key must not exist.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package repostorageusage
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rsu [repository key or pattern]"}
+
+func GetDescription() string {
+	return "Report the used space and the files and folders count of repositories in Artifactory, as calculated by the storage summary. When more than one repository is reported, their total is reported as well."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key or pattern",
+			Description: "[Optional] Specifies the repositories to report. You can specify the name of a single repository or a pattern, using the * wildcard or a regular expression matching the whole key prefixed with 'regex:', to report multiple repositories. If not specified, all the repositories are reported.",
+		},
+	}
+}
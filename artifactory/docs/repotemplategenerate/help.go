@@ -0,0 +1,23 @@
+package repotemplategenerate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rtg <rclass> <package type>"}
+
+func GetDescription() string {
+	return "Print a skeleton template, with all the keys supported for the repository class and package type, for repository creation or update. " +
+		"In YAML, the optional keys are commented out with guidance for their values. In JSON, which has no comments, they are set to empty values and should be set or removed."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "rclass",
+			Description: "The repository class. Valid values are: local, remote, virtual and federated.",
+		},
+		{
+			Name:        "package type",
+			Description: "The package type of the repository, such as maven, npm or docker.",
+		},
+	}
+}
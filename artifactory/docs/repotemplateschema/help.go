@@ -0,0 +1,13 @@
+package repotemplateschema
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rts"}
+
+func GetDescription() string {
+	return "Print all the keys supported in repository templates, with their value types and the repository classes and package types they apply to."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
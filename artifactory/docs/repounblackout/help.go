@@ -0,0 +1,18 @@
+package repounblackout
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rubo <repository key or pattern>"}
+
+func GetDescription() string {
+	return "Restore blacked out repositories in Artifactory."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key or pattern",
+			Description: "Specifies the repositories to restore. You can specify the name of a single repository or a pattern, using the * wildcard or a regular expression matching the whole key prefixed with 'regex:', to restore multiple repositories.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package repousedby
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rub <repository key>"}
+
+func GetDescription() string {
+	return "List the virtual repositories which include a repository as a member. Run it before deleting or reconfiguring a repository, to find the virtual repositories which would be affected."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the member repository.",
+		},
+	}
+}
@@ -17,4 +17,6 @@ const (
 	ReleaseBundleExport       = "release-bundle-export"
 	ReleaseBundleImport       = "release-bundle-import"
 	ReleaseBundleAnnotate     = "release-bundle-annotate"
+	ReleaseBundlePaths        = "release-bundle-paths"
+	ReleaseBundleDiff         = "release-bundle-diff"
 )
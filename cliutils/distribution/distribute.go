@@ -17,12 +17,14 @@ func CreateDefaultDistributionRules(c *components.Context) *spec.DistributionRul
 	}
 }
 
-func ValidateReleaseBundleDistributeCmd(c *components.Context) error {
+// ValidateReleaseBundleDistributeCmd validates the distribute command, whose sync value is resolved from the --sync flag
+// or its environment variable by the caller.
+func ValidateReleaseBundleDistributeCmd(c *components.Context, sync bool) error {
 	if len(c.Arguments) != 2 {
 		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
 	}
-	if c.IsFlagSet("max-wait-minutes") && !c.IsFlagSet("sync") {
-		return pluginsCommon.PrintHelpAndReturnError("The --max-wait-minutes option can't be used without --sync", c)
+	if c.IsFlagSet("max-wait-minutes") && !sync {
+		return pluginsCommon.PrintHelpAndReturnError("The --max-wait-minutes option can't be used with an asynchronous distribution", c)
 	}
 
 	if c.IsFlagSet("dist-rules") && (c.IsFlagSet("site") || c.IsFlagSet("city") || c.IsFlagSet("country-code")) {
@@ -4,6 +4,7 @@ import (
 	"strconv"
 
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/cmddefs"
+	"github.com/jfrog/jfrog-cli-artifactory/commonutils"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
@@ -82,6 +83,28 @@ const (
 	Ping                   = "ping"
 	RtCurl                 = "rt-curl"
 	TemplateConsumer       = "template-consumer"
+	RepoCreate             = "repo-create"
+	RepoTemplateSchema     = "repo-template-schema"
+	RepoTemplateGenerate   = "repo-template-generate"
+	RepoPackageTypes       = "repo-package-types"
+	RepoCheckPaths         = "repo-check-paths"
+	RepoExportTerraform    = "repo-export-terraform"
+	RepoExport             = "repo-export"
+	RepoBlackout           = "repo-blackout"
+	RepoArchiveBrowsing    = "repo-archive-browsing"
+	RepoXrayIndex          = "repo-xray-index"
+	RepoAudit              = "repo-audit"
+	RepoCheckSupport       = "repo-check-support"
+	RepoUsedBy             = "repo-used-by"
+	RepoStorageUsage       = "repo-storage-usage"
+	RepoCleanupReport      = "repo-cleanup-report"
+	RepoLayout             = "repo-layout"
+	RepoLayouts            = "repo-layouts"
+	RepoRename             = "repo-rename"
+	RepoPriorityResolution = "repo-priority-resolution"
+	RepoDescriptions       = "repo-descriptions"
+	RepoEnvironment        = "repo-environment"
+	RepoUpdate             = "repo-update"
 	RepoDelete             = "repo-delete"
 	ReplicationDelete      = "replication-delete"
 	PermissionTargetDelete = "permission-target-delete"
@@ -361,6 +384,89 @@ const (
 	// Template user flags
 	vars = "vars"
 
+	// Unique repo-create and repo-update flags
+	repoValidate      = "validate"
+	repoValidateOnly  = "validate-only"
+	strictPatterns    = "strict-patterns"
+	repoStrict        = "strict"
+	rollbackOnFailure = "rollback-on-failure"
+	repoAnnotate      = "annotate"
+	verifyMembers     = "verify-members"
+	includeKeys       = "include-keys"
+	repoProgress      = "progress"
+	repoQuiet         = "repo-" + quiet
+	repoCheckStorage  = "check-storage"
+	repoCheckLayouts  = "check-layouts"
+	dockerHardening   = "docker-hardening"
+	repoSummaryFormat = "repo-summary-format"
+	onSuccess         = "on-success"
+	failOnHookError   = "fail-on-hook-error"
+
+	// Unique repo-template-schema flags
+	repoTemplateSchemaFormat = "repo-template-schema-format"
+
+	// Unique repo-template-generate flags
+	repoTemplateGenerateFormat = "repo-template-generate-format"
+
+	// Unique repo-package-types flags
+	repoPackageTypesFormat = "repo-package-types-format"
+
+	// Unique repo-export-terraform flags
+	repoKeys                    = "repo-keys"
+	repoExportTerraformRepoKeys = RepoExportTerraform + "-" + repoKeys
+
+	// Unique repo-blackout flags
+	repoBlackoutDryRun = "repo-blackout-dry-run"
+
+	// Unique repo-archive-browsing flags
+	repoArchiveBrowsingDryRun = "repo-archive-browsing-dry-run"
+
+	// Unique repo-xray-index flags
+	repoXrayIndexDryRun         = RepoXrayIndex + "-" + dryRun
+	repoXrayIndexWait           = RepoXrayIndex + "-wait"
+	repoXrayIndexMaxWaitMinutes = RepoXrayIndex + "-" + maxWaitMinutes
+
+	// Unique repo-audit flags
+	repoAuditIgnoreFields = "ignore-fields"
+
+	// Unique repo-storage-usage flags
+	repoStorageUsageFormat  = "repo-storage-usage-format"
+	refresh                 = "refresh"
+	repoStorageUsageRefresh = RepoStorageUsage + "-" + refresh
+
+	// Unique repo-export flags
+	repoExportChunkSize = RepoExport + "-" + chunkSize
+
+	// Unique repo-cleanup-report flags
+	repoCleanupReportFormat   = "repo-cleanup-report-format"
+	estimate                  = "estimate"
+	repoCleanupReportEstimate = RepoCleanupReport + "-" + estimate
+
+	// Unique repo-layouts flags
+	repoLayoutsFormat = "repo-layouts-format"
+
+	// Unique repo-rename flags
+	content           = "content"
+	repoRenameContent = RepoRename + "-" + content
+	repoRenameDryRun  = RepoRename + "-" + dryRun
+	repoRenameQuiet   = RepoRename + "-" + quiet
+	repoPassword      = "repo-password"
+
+	// Unique repo-priority-resolution flags
+	clearFlag                    = "clear"
+	repoPriorityResolutionClear  = RepoPriorityResolution + "-" + clearFlag
+	repoPriorityResolutionDryRun = RepoPriorityResolution + "-" + dryRun
+
+	// Unique repo-descriptions flags
+	repoDescriptionsDryRun = RepoDescriptions + "-" + dryRun
+
+	// Unique repo-environment flags
+	repoEnvironmentClear  = RepoEnvironment + "-" + clearFlag
+	repoEnvironmentDryRun = RepoEnvironment + "-" + dryRun
+
+	// Repository and lifecycle commands error output flag
+	errorFormat = commonutils.ErrorFormatFlag
+
 	// User Management flags
 	csv            = "csv"
 	usersCreateCsv = "users-create-csv"
@@ -467,6 +573,7 @@ const (
 	lcDryRun                 = lifecyclePrefix + dryRun
 	lcIncludeRepos           = lifecyclePrefix + IncludeRepos
 	lcExcludeRepos           = lifecyclePrefix + ExcludeRepos
+	lcPromotionSpec          = lifecyclePrefix + "promotion-" + specFlag
 	PromotionType            = "promotion-type"
 	lcTag                    = lifecyclePrefix + Tag
 	lcProperties             = lifecyclePrefix + Properties
@@ -474,6 +581,11 @@ const (
 	lcDeleteProperties       = lifecyclePrefix + DeleteProperty
 	SourceTypeReleaseBundles = "source-type-release-bundles"
 	SourceTypeBuilds         = "source-type-builds"
+	lcFormat                 = lifecyclePrefix + xrOutput
+	OtherName                = "other-name"
+	lcOtherName              = lifecyclePrefix + OtherName
+	lcDiffFormat             = lifecyclePrefix + "diff-" + xrOutput
+	EdgeStatus               = "edge-status"
 )
 
 var commandFlags = map[string][]string{
@@ -499,32 +611,38 @@ var commandFlags = map[string][]string{
 	},
 	cmddefs.ReleaseBundleCreate: {
 		platformUrl, user, password, accessToken, serverId, lcSigningKey, lcSync, lcProject, lcBuilds, lcReleaseBundles,
-		specFlag, specVars, BuildName, BuildNumber, SourceTypeReleaseBundles, SourceTypeBuilds,
+		specFlag, specVars, BuildName, BuildNumber, SourceTypeReleaseBundles, SourceTypeBuilds, errorFormat,
 	},
 	cmddefs.ReleaseBundlePromote: {
 		platformUrl, user, password, accessToken, serverId, lcSigningKey, lcSync, lcProject, lcIncludeRepos,
-		lcExcludeRepos, PromotionType,
+		lcExcludeRepos, lcPromotionSpec, specVars, PromotionType, errorFormat,
 	},
 	cmddefs.ReleaseBundleDistribute: {
 		platformUrl, user, password, accessToken, serverId, lcProject, DistRules, site, city, countryCodes,
-		lcDryRun, CreateRepo, lcPathMappingPattern, lcPathMappingTarget, lcSync, maxWaitMinutes,
+		lcDryRun, CreateRepo, lcPathMappingPattern, lcPathMappingTarget, lcSync, maxWaitMinutes, EdgeStatus, errorFormat,
 	},
 	cmddefs.ReleaseBundleDeleteLocal: {
-		platformUrl, user, password, accessToken, serverId, deleteQuiet, lcSync, lcProject,
+		platformUrl, user, password, accessToken, serverId, deleteQuiet, lcSync, lcProject, errorFormat,
 	},
 	cmddefs.ReleaseBundleDeleteRemote: {
 		platformUrl, user, password, accessToken, serverId, deleteQuiet, lcDryRun, DistRules, site, city, countryCodes,
-		lcSync, maxWaitMinutes, lcProject,
+		lcSync, maxWaitMinutes, lcProject, errorFormat,
 	},
 	cmddefs.ReleaseBundleExport: {
 		platformUrl, user, password, accessToken, serverId, lcPathMappingTarget, lcPathMappingPattern, Project,
-		downloadMinSplit, downloadSplitCount,
+		downloadMinSplit, downloadSplitCount, errorFormat,
 	},
 	cmddefs.ReleaseBundleImport: {
-		user, password, accessToken, serverId, platformUrl,
+		user, password, accessToken, serverId, platformUrl, errorFormat,
 	},
 	cmddefs.ReleaseBundleAnnotate: {
-		platformUrl, user, password, accessToken, serverId, lcProject, lcTag, lcProperties, lcDeleteProperties, propsRecursive,
+		platformUrl, user, password, accessToken, serverId, lcProject, lcTag, lcProperties, lcDeleteProperties, propsRecursive, errorFormat,
+	},
+	cmddefs.ReleaseBundlePaths: {
+		lcProject, lcFormat, errorFormat,
+	},
+	cmddefs.ReleaseBundleDiff: {
+		platformUrl, user, password, accessToken, serverId, lcProject, lcOtherName, lcDiffFormat, errorFormat,
 	},
 	AddConfig: {
 		interactive, EncPassword, configPlatformUrl, configRtUrl, configDistUrl, configXrUrl, configMcUrl, configPlUrl, configUser, configPassword, configAccessToken, sshKeyPath, sshPassphrase, ClientCertPath,
@@ -743,9 +861,94 @@ var commandFlags = map[string][]string{
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, vars,
 	},
+	RepoCreate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, vars, repoValidate, repoValidateOnly, strictPatterns, repoStrict, rollbackOnFailure, repoAnnotate, verifyMembers, includeKeys, repoProgress, repoQuiet, repoCheckStorage, repoCheckLayouts, dockerHardening, repoSummaryFormat, onSuccess, failOnHookError, errorFormat,
+	},
+	RepoUpdate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, vars, repoValidate, repoValidateOnly, strictPatterns, repoStrict, repoAnnotate, verifyMembers, includeKeys, repoProgress, repoQuiet, repoCheckStorage, repoCheckLayouts, dockerHardening, repoSummaryFormat, onSuccess, failOnHookError, errorFormat,
+	},
+	RepoTemplateSchema: {
+		repoTemplateSchemaFormat,
+	},
+	RepoTemplateGenerate: {
+		repoTemplateGenerateFormat,
+	},
+	RepoPackageTypes: {
+		repoPackageTypesFormat,
+	},
+	RepoCheckPaths: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, errorFormat,
+	},
+	RepoExportTerraform: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, vars, repoExportTerraformRepoKeys, errorFormat,
+	},
+	RepoBlackout: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoBlackoutDryRun, errorFormat,
+	},
+	RepoArchiveBrowsing: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoArchiveBrowsingDryRun, errorFormat,
+	},
+	RepoXrayIndex: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoXrayIndexDryRun, repoXrayIndexWait, repoXrayIndexMaxWaitMinutes, errorFormat,
+	},
+	RepoAudit: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, vars, repoAuditIgnoreFields, errorFormat,
+	},
+	RepoExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoExportChunkSize, errorFormat,
+	},
+	RepoCheckSupport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, vars, errorFormat,
+	},
+	RepoUsedBy: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, errorFormat,
+	},
+	RepoStorageUsage: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, Project, repoStorageUsageFormat, repoStorageUsageRefresh, errorFormat,
+	},
+	RepoCleanupReport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoCleanupReportFormat, repoCleanupReportEstimate, errorFormat,
+	},
+	RepoLayout: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, errorFormat,
+	},
+	RepoLayouts: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoLayoutsFormat, errorFormat,
+	},
+	RepoRename: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoRenameContent, repoPassword, repoRenameDryRun, repoRenameQuiet, errorFormat,
+	},
+	RepoPriorityResolution: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoPriorityResolutionClear, repoPriorityResolutionDryRun, errorFormat,
+	},
+	RepoDescriptions: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoDescriptionsDryRun, errorFormat,
+	},
+	RepoEnvironment: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, repoEnvironmentClear, repoEnvironmentDryRun, errorFormat,
+	},
 	RepoDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
-		ClientCertKeyPath, deleteQuiet,
+		ClientCertKeyPath, deleteQuiet, errorFormat,
 	},
 	ReplicationDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
@@ -1012,6 +1215,74 @@ var flagsMap = map[string]components.Flag{
 	// TemplateConsumer specific commands flags
 	vars: components.NewStringFlag(vars, "[Optional] List of semicolon-separated(;) variables in the form of \"key1=value1;key2=value2;...\" (wrapped by quotes) to be replaced in the template. In the template, the variables should be used as follows: ${key1}.", components.SetMandatoryFalse()),
 
+	// Repo create and update specific commands flags
+	repoValidate:      components.NewBoolFlag(repoValidate, "[Default: false] Set to true to validate that the key pairs referenced by the template exist in Artifactory before creating or updating the repositories.", components.WithBoolDefaultValueFalse()),
+	repoValidateOnly:  components.NewBoolFlag(repoValidateOnly, "[Default: false] Set to true to only validate the template, without creating or updating the repositories. All the problems found are reported, including repositories which already exist when creating, or don't exist when updating, and missing key pairs.", components.WithBoolDefaultValueFalse()),
+	strictPatterns:    components.NewBoolFlag(strictPatterns, "[Default: false] Set to true to fail when the include/exclude patterns of virtual repositories are invalid or conflicting, instead of only printing warnings.", components.WithBoolDefaultValueFalse()),
+	repoStrict:        components.NewBoolFlag(repoStrict, "[Default: false] Set to true to fail when the template enables settings which have no effect on the repository's class or package type, such as downloadRedirect and cdnRedirect, whose remote timeout and cache period settings are inconsistent, which set a YUM root depth without calculating the YUM metadata, or which are deprecated, instead of only printing warnings.", components.WithBoolDefaultValueFalse()),
+	rollbackOnFailure: components.NewBoolFlag(rollbackOnFailure, "[Default: false] Set to true to delete the repositories created during a failed multiple repositories creation. Repositories which existed before the run are never deleted.", components.WithBoolDefaultValueFalse()),
+	verifyMembers:     components.NewBoolFlag(verifyMembers, "[Default: false] Set to true to verify that the member URLs of federated repositories are reachable and point to an Artifactory which supports federated repositories. The members are accessed with the credentials of the server the repositories are created or updated on.", components.WithBoolDefaultValueFalse()),
+	includeKeys:       components.NewStringFlag(includeKeys, "[Optional] Only create or update the repositories of the template whose keys match this pattern. The pattern may use the * and ? wildcards, or be a regular expression matching the whole key when prefixed with 'regex:', e.g. 'regex:(npm|maven)-.*'.", components.SetMandatoryFalse()),
+	repoProgress:      components.NewBoolFlag(repoProgress, "[Default: false] Set to true to report the progress of creating or updating the repositories one by one, even when the standard error isn't a terminal. The progress is reported by default when it is.", components.WithBoolDefaultValueFalse()),
+	repoQuiet:         components.NewBoolFlag(quiet, "[Default: false] Set to true to not report the progress of creating or updating the repositories one by one, nor the summary of the repositories created, updated and skipped.", components.WithBoolDefaultValueFalse()),
+	dockerHardening:   components.NewBoolFlag(dockerHardening, "[Default: false] Set to true to apply the recommended security settings to the Docker repositories of the template which don't set them: blockPushingSchema1, and blockMismatchingMimeTypes on remote repositories. Each applied setting is reported. Values set by the template are never overridden.", components.WithBoolDefaultValueFalse()),
+	repoCheckLayouts:  components.NewBoolFlag(repoCheckLayouts, "[Default: false] Set to true to check that the repository layouts the template refers to with repoLayoutRef exist on the instance. All the missing layouts are reported together. Requires admin permissions.", components.WithBoolDefaultValueFalse()),
+	repoCheckStorage:  components.NewBoolFlag(repoCheckStorage, "[Default: false] Set to true to check that the filestore of the instance is a cloud storage provider when the template enables cdnRedirect, which otherwise has no effect. Fails instead of printing warnings when --strict is set. Requires admin permissions.", components.WithBoolDefaultValueFalse()),
+	repoSummaryFormat: components.NewStringFlag(xrOutput, "[Default: text] Defines the format of the summary of the repositories created, updated and skipped. The json summary is printed to the standard output. Acceptable values are: text and json.", components.SetMandatoryFalse()),
+	onSuccess:         components.NewStringFlag(onSuccess, "[Optional] A hook invoked after each repository is created or updated. Either an HTTP(S) URL, to which the repository key and the action are posted as JSON, e.g. {\"repoKey\": \"npm-local\", \"action\": \"created\"}, or a shell command, which receives them in the JFROG_CLI_REPO_KEY and JFROG_CLI_REPO_ACTION environment variables.", components.SetMandatoryFalse()),
+	failOnHookError:   components.NewBoolFlag(failOnHookError, "[Default: false] Set to true to fail the command when the --on-success hook fails, instead of only printing a warning.", components.WithBoolDefaultValueFalse()),
+	repoAnnotate:      components.NewBoolFlag(repoAnnotate, "[Default: false] Set to true to set the notes of each repository to the CLI version, the time and the template path used to provision it. Repositories whose template sets notes are not annotated.", components.WithBoolDefaultValueFalse()),
+
+	// Repo template schema specific commands flags
+	repoTemplateSchemaFormat: components.NewStringFlag(xrOutput, "[Default: table] Defines the output format of the command. Acceptable values are: table and json.", components.SetMandatoryFalse()),
+
+	// Repo template generate specific commands flags
+	repoTemplateGenerateFormat: components.NewStringFlag(xrOutput, "[Default: yaml] Defines the format of the generated template. Acceptable values are: yaml and json.", components.SetMandatoryFalse()),
+
+	// Repo package types specific commands flags
+	repoPackageTypesFormat: components.NewStringFlag(xrOutput, "[Default: list] Defines the output format of the command. Acceptable values are: list and json.", components.SetMandatoryFalse()),
+
+	// Repo export terraform specific commands flags
+	repoExportTerraformRepoKeys: components.NewStringFlag(repoKeys, "[Optional] List of comma-separated keys of existing repositories to export. When provided, the repositories are read from Artifactory instead of from a template.", components.SetMandatoryFalse()),
+
+	// Repo blackout specific commands flags
+	repoBlackoutDryRun: components.NewBoolFlag(dryRun, "[Default: false] Set to true to only report the repositories which would be changed, without updating them.", components.WithBoolDefaultValueFalse()),
+
+	// Repo archive browsing specific commands flags
+	repoAuditIgnoreFields:       components.NewStringFlag(repoAuditIgnoreFields, "[Optional] List of comma-separated template fields to not compare, such as server-managed defaults. The password is never compared.", components.SetMandatoryFalse()),
+	repoXrayIndexDryRun:         components.NewBoolFlag(dryRun, "[Default: false] Set to true to only report the repositories which would be changed, without updating them.", components.WithBoolDefaultValueFalse()),
+	repoXrayIndexWait:           components.NewBoolFlag("wait", "[Default: false] Set to true to wait until Xray reports the repositories as indexed, after enabling indexing.", components.WithBoolDefaultValueFalse()),
+	repoXrayIndexMaxWaitMinutes: components.NewStringFlag(maxWaitMinutes, "[Default: 10] Max minutes to wait for Xray to index the repositories, when --wait is set.", components.SetMandatoryFalse()),
+	repoArchiveBrowsingDryRun:   components.NewBoolFlag(dryRun, "[Default: false] Set to true to only report the repositories which would be changed, without updating them.", components.WithBoolDefaultValueFalse()),
+
+	// Repo storage usage specific commands flags
+	repoLayoutsFormat:       components.NewStringFlag(xrOutput, "[Default: table] Defines the output format of the command. Acceptable values are: table and json.", components.SetMandatoryFalse()),
+	repoStorageUsageFormat:  components.NewStringFlag(xrOutput, "[Default: table] Defines the output format of the command. Acceptable values are: table and json.", components.SetMandatoryFalse()),
+	repoStorageUsageRefresh: components.NewBoolFlag(refresh, "[Default: false] Set to true to trigger a recalculation of the storage summary before reporting it. Artifactory recalculates the summary in the background, so the reported usage may not reflect the recalculation yet.", components.WithBoolDefaultValueFalse()),
+
+	// Repo export specific commands flags
+	repoExportChunkSize: components.NewStringFlag(chunkSize, "[Default: 50] The number of repositories exported between saves of the export progress. An interrupted export resumes after the last saved repository.", components.SetMandatoryFalse()),
+
+	// Repo cleanup report specific commands flags
+	repoCleanupReportFormat:   components.NewStringFlag(xrOutput, "[Default: table] Defines the output format of the command. Acceptable values are: table and json.", components.SetMandatoryFalse()),
+	repoCleanupReportEstimate: components.NewBoolFlag(estimate, "[Default: false] Set to true to also report the count and size of the cached artifacts which weren't downloaded within the cleanup period, as an estimate of the artifacts the cleanup would remove. The estimate searches the cache of each repository with the cleanup enabled, so it may take a while on large caches.", components.WithBoolDefaultValueFalse()),
+
+	// Repo rename specific commands flags
+	repoRenameContent: components.NewStringFlag(content, "[Default: move] Defines what is done with the content of a local repository. Acceptable values are: move, copy and skip. With skip, the content is deleted along with the original repository.", components.SetMandatoryFalse()),
+	repoPassword:      components.NewStringFlag(repoPassword, "The password of the remote repository, which Artifactory doesn't return with its configuration. Mandatory for renaming a remote repository with a password.", components.SetMandatoryFalse()),
+	repoRenameDryRun:  components.NewBoolFlag(dryRun, "[Default: false] Set to true to only report the steps of the rename, without performing them.", components.WithBoolDefaultValueFalse()),
+	repoRenameQuiet:   components.NewBoolFlag(quiet, "[Default: $CI] Set to true to skip the rename confirmation message.", components.WithBoolDefaultValueFalse()),
+
+	// Repo priority resolution specific commands flags
+	repoPriorityResolutionClear:  components.NewBoolFlag(clearFlag, "[Default: false] Set to true to clear priorityResolution instead of setting it.", components.WithBoolDefaultValueFalse()),
+	repoPriorityResolutionDryRun: components.NewBoolFlag(dryRun, "[Default: false] Set to true to only report the repositories which would be changed and the resulting resolution order, without updating them.", components.WithBoolDefaultValueFalse()),
+	repoDescriptionsDryRun:       components.NewBoolFlag(dryRun, "[Default: false] Set to true to only report the changes which would be made, without updating the repositories.", components.WithBoolDefaultValueFalse()),
+	repoEnvironmentClear:         components.NewBoolFlag(clearFlag, "[Default: false] Set to true to clear the environment of the repositories instead of assigning one.", components.WithBoolDefaultValueFalse()),
+	repoEnvironmentDryRun:        components.NewBoolFlag(dryRun, "[Default: false] Set to true to only report the repositories which would be changed, without updating them.", components.WithBoolDefaultValueFalse()),
+
+	// Repository and lifecycle commands error output flag
+	errorFormat: commonutils.NewErrorFormatFlag(),
+
 	// ArtifactoryAccessTokenCreate specific commands flags
 	rtAtcGroups:      components.NewStringFlag(Groups, "[Default: *] A list of comma-separated(,) groups for the access token to be associated with. Specify * to indicate that this is a 'user-scoped token', i.e., the token provides the same access privileges that the current subject has, and is therefore evaluated dynamically. ", components.SetMandatoryFalse()),
 	rtAtcGrantAdmin:  components.NewBoolFlag(GrantAdmin, "[Default: false] Set to true to provide admin privileges to the access token. This is only available for administrators.", components.WithBoolDefaultValueFalse()),
@@ -1049,7 +1320,7 @@ var flagsMap = map[string]components.Flag{
 	maxWaitMinutes:       components.NewStringFlag(maxWaitMinutes, "Max minutes to wait for sync distribution."),
 	deleteFromDist:       components.NewBoolFlag(deleteFromDist, "Set to true to delete release bundle version in JFrog Distribution itself after deletion is complete.", components.WithBoolDefaultValueFalse()),
 	CreateRepo:           components.NewBoolFlag(CreateRepo, "Set to true to create the repository on the edge if it does not exist.", components.WithBoolDefaultValueFalse()),
-	lcSync:               components.NewBoolFlag(Sync, "Set to false to run asynchronously.", components.WithBoolDefaultValueTrue()),
+	lcSync:               components.NewBoolFlag(Sync, "[Default: true] Set to false to run asynchronously. When not provided, the JFROG_CLI_RB_SYNC environment variable sets the default. The flag always takes precedence over the variable.", components.WithBoolDefaultValueFalse()),
 	lcProject:            components.NewStringFlag(Project, "Project key associated with the Release Bundle version.", components.SetMandatoryFalse()),
 	lcBuilds:             components.NewStringFlag(Builds, "Path to a JSON file containing information of the source builds from which to create a release bundle.", components.SetHiddenStrFlag(), components.SetMandatoryFalse()),
 	lcReleaseBundles:     components.NewStringFlag(ReleaseBundles, "Path to a JSON file containing information of the source release bundles from which to create a release bundle.", components.SetHiddenStrFlag(), components.SetMandatoryFalse()),
@@ -1058,15 +1329,22 @@ var flagsMap = map[string]components.Flag{
 	lcPathMappingTarget: components.NewStringFlag(PathMappingTarget, "The target path for distributed artifacts on the edge node. If not specified, the artifacts will have the same path and name on the edge node, as on the source Artifactory server. "+
 		"For flexibility in specifying the distribution path, you can include placeholders in the form of {1}, {2} which are replaced by corresponding tokens in the pattern path that are enclosed in parenthesis.` `", components.SetMandatoryFalse()),
 	lcDryRun: components.NewBoolFlag(dryRun, "Set to true to only simulate the distribution of the release bundle.", components.WithBoolDefaultValueFalse()),
+	EdgeStatus: components.NewBoolFlag(EdgeStatus, "[Default: false] Set to true to wait for the distribution and report its progress on each edge. The command fails if the distribution fails on any edge. "+
+		"The wait is limited by --"+maxWaitMinutes+", which is provided with --"+Sync+". Can't be used with an asynchronous distribution, set by --"+Sync+"=false or the JFROG_CLI_RB_SYNC environment variable.", components.WithBoolDefaultValueFalse()),
 	lcIncludeRepos: components.NewStringFlag(IncludeRepos, "List of semicolon-separated(;) repositories to include in the promotion. If this property is left undefined, all repositories (except those specifically excluded) are included in the promotion. "+
 		"If one or more repositories are specifically included, all other repositories are excluded.` `", components.SetMandatoryFalse()),
-	lcExcludeRepos:           components.NewStringFlag(ExcludeRepos, "List of semicolon-separated(;) repositories to exclude from the promotion.` `", components.SetMandatoryFalse()),
+	lcExcludeRepos: components.NewStringFlag(ExcludeRepos, "List of semicolon-separated(;) repositories to exclude from the promotion.` `", components.SetMandatoryFalse()),
+	lcPromotionSpec: components.NewStringFlag(specFlag, "Path to a JSON file with the repositories to include in and exclude from the promotion, in the form of {\"includedRepositoryKeys\": [...], \"excludedRepositoryKeys\": [...]}. "+
+		"Variables in the form of ${key} are replaced with the values of --"+specVars+". Can't be used with --"+IncludeRepos+" or --"+ExcludeRepos+".` `", components.SetMandatoryFalse()),
 	platformUrl:              components.NewStringFlag(url, "JFrog platform URL. (example: https://acme.jfrog.io)` `", components.SetMandatoryFalse()),
 	PromotionType:            components.NewStringFlag(PromotionType, "The promotion type. Can be one of 'copy' or 'move'.", components.WithStrDefaultValue("copy")),
 	lcTag:                    components.NewStringFlag(Tag, "Tag to put on Release Bundle version.", components.SetMandatoryFalse()),
 	lcProperties:             components.NewStringFlag(Properties, "Properties to put on the of Manifest Release Bundle version.", components.SetMandatoryFalse()),
 	lcDeleteProperties:       components.NewStringFlag(DeleteProperty, "Properties to be deleted on the of Manifest Release Bundle version.", components.SetMandatoryFalse()),
 	SourceTypeReleaseBundles: components.NewStringFlag(SourceTypeReleaseBundles, "List of semicolon-seperated(;) release bundles in the form of 'name=releaseBundleName1, version=version1; name=releaseBundleName2, version=version2' to be included in the new bundle.", components.SetMandatoryFalse()),
+	lcFormat:                 components.NewStringFlag(xrOutput, "[Default: text] Defines the output format of the command. Acceptable values are: text and json.", components.SetMandatoryFalse()),
+	lcOtherName:              components.NewStringFlag(OtherName, "Name of the release bundle to compare with, when it isn't the same release bundle.", components.SetMandatoryFalse()),
+	lcDiffFormat:             components.NewStringFlag(xrOutput, "[Default: table] Defines the output format of the command. Acceptable values are: table and json.", components.SetMandatoryFalse()),
 	SourceTypeBuilds:         components.NewStringFlag(SourceTypeBuilds, "List of semicolon-separated(;) builds in the form of 'name=buildName1, id=runID1, include-deps=true; name=buildName2, id=runID2' to be included in the new bundle.", components.SetMandatoryFalse()),
 }
 
@@ -0,0 +1,120 @@
+package commonutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	ErrorFormatFlag = "error-format"
+
+	ErrorFormatText = "text"
+	ErrorFormatJson = "json"
+
+	// Code of errors which carry no server response status.
+	commandFailedErrorCode = "COMMAND_FAILED"
+)
+
+// Matches the status line of errors generated by errorutils.GenerateResponseError, e.g. "server response: 404 Not Found".
+var serverResponseStatusRegex = regexp.MustCompile(`server response: (\d{3})\s*([^\n]*)`)
+
+// The writer to which structured errors are printed. Replaced in tests.
+var errorFormatOutput io.Writer = os.Stderr
+
+// A machine-parseable representation of a failed command, printed when --error-format=json is used.
+type StructuredError struct {
+	Code      string            `json:"code"`
+	Message   string            `json:"message"`
+	Command   string            `json:"command,omitempty"`
+	Arguments []string          `json:"arguments,omitempty"`
+	Context   map[string]string `json:"context,omitempty"`
+	Status    *ResponseStatus   `json:"status,omitempty"`
+}
+
+// The status of the server response which caused the error.
+type ResponseStatus struct {
+	Code int    `json:"code"`
+	Text string `json:"text,omitempty"`
+}
+
+func NewErrorFormatFlag() components.StringFlag {
+	return components.NewStringFlag(ErrorFormatFlag, "[Default: "+ErrorFormatText+"] The format of the error printed when the command fails. Supported formats: "+ErrorFormatText+", "+ErrorFormatJson+". "+
+		"With "+ErrorFormatJson+", a structured error object is printed to the standard error.", components.WithStrDefaultValue(ErrorFormatText))
+}
+
+// Wraps a command action so that, when --error-format=json is used, a failure is printed as a StructuredError.
+// The values of contextFlags, when set, are added to the error context, e.g. the subject or the repository of the command.
+func WithErrorFormat(action components.ActionFunc, contextFlags ...string) components.ActionFunc {
+	return func(c *components.Context) error {
+		errorFormat := c.GetStringFlagValue(ErrorFormatFlag)
+		switch errorFormat {
+		case "", ErrorFormatText:
+			return action(c)
+		case ErrorFormatJson:
+		default:
+			return errorutils.CheckErrorf("unsupported error format '%s'. Supported formats: %s, %s", errorFormat, ErrorFormatText, ErrorFormatJson)
+		}
+		err := action(c)
+		if err == nil {
+			return nil
+		}
+		structuredError := NewStructuredError(err)
+		structuredError.Command = c.CommandName
+		structuredError.Arguments = c.Arguments
+		for _, flag := range contextFlags {
+			if value := c.GetStringFlagValue(flag); value != "" {
+				if structuredError.Context == nil {
+					structuredError.Context = make(map[string]string)
+				}
+				structuredError.Context[flag] = value
+			}
+		}
+		return printStructuredError(structuredError, err)
+	}
+}
+
+func NewStructuredError(err error) *StructuredError {
+	structuredError := &StructuredError{Code: commandFailedErrorCode, Message: err.Error()}
+	match := serverResponseStatusRegex.FindStringSubmatch(err.Error())
+	if match == nil {
+		return structuredError
+	}
+	statusCode, convErr := strconv.Atoi(match[1])
+	if convErr != nil {
+		return structuredError
+	}
+	structuredError.Status = &ResponseStatus{Code: statusCode, Text: strings.TrimSpace(match[2])}
+	if statusText := http.StatusText(statusCode); statusText != "" {
+		structuredError.Code = strings.ToUpper(strings.NewReplacer(" ", "_", "-", "_", "'", "").Replace(statusText))
+	}
+	return structuredError
+}
+
+// Prints the structured error and returns an error which fails the command without printing the error again.
+func printStructuredError(structuredError *StructuredError, cause error) error {
+	content, err := json.Marshal(structuredError)
+	if err != nil {
+		return errors.Join(cause, errorutils.CheckError(err))
+	}
+	if _, err = fmt.Fprintln(errorFormatOutput, string(content)); err != nil {
+		return errors.Join(cause, errorutils.CheckError(err))
+	}
+	// Commands which exit with a specific code keep it
+	exitCode := coreutils.ExitCodeError
+	var cliError coreutils.CliError
+	if errors.As(cause, &cliError) && cliError.ExitCode != coreutils.ExitCodeNoError {
+		exitCode = cliError.ExitCode
+	}
+	return coreutils.CliError{ExitCode: exitCode}
+}
@@ -0,0 +1,104 @@
+package commonutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStructuredError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedCode   string
+		expectedStatus *ResponseStatus
+	}{
+		{"no status", errors.New("template file not found"), commandFailedErrorCode, nil},
+		{"not found", errorutils.GenerateResponseError("404 Not Found", "{\"errors\":[]}"), "NOT_FOUND", &ResponseStatus{Code: 404, Text: "Not Found"}},
+		{"server error", errorutils.GenerateResponseError("500 Internal Server Error", ""), "INTERNAL_SERVER_ERROR", &ResponseStatus{Code: 500, Text: "Internal Server Error"}},
+		{"unknown status", errorutils.GenerateResponseError("599", ""), commandFailedErrorCode, &ResponseStatus{Code: 599}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			structuredError := NewStructuredError(tt.err)
+			assert.Equal(t, tt.expectedCode, structuredError.Code)
+			assert.Equal(t, tt.err.Error(), structuredError.Message)
+			assert.Equal(t, tt.expectedStatus, structuredError.Status)
+		})
+	}
+}
+
+func TestWithErrorFormat(t *testing.T) {
+	output := &bytes.Buffer{}
+	previousOutput := errorFormatOutput
+	errorFormatOutput = output
+	defer func() { errorFormatOutput = previousOutput }()
+
+	cause := errorutils.GenerateResponseError("403 Forbidden", "")
+	failingAction := func(c *components.Context) error { return cause }
+	newContext := func(errorFormat string) *components.Context {
+		ctx := &components.Context{CommandName: "repo-delete", Arguments: []string{"generic-local"}}
+		ctx.AddStringFlag(ErrorFormatFlag, errorFormat)
+		ctx.AddStringFlag("project", "proj")
+		ctx.AddStringFlag("build-name", "")
+		return ctx
+	}
+
+	t.Run("text", func(t *testing.T) {
+		output.Reset()
+		assert.Equal(t, cause, WithErrorFormat(failingAction)(newContext(ErrorFormatText)))
+		assert.Empty(t, output.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		output.Reset()
+		err := WithErrorFormat(failingAction, "project", "build-name")(newContext(ErrorFormatJson))
+		var cliError coreutils.CliError
+		require.ErrorAs(t, err, &cliError)
+		assert.Equal(t, coreutils.ExitCodeError, cliError.ExitCode)
+		assert.Empty(t, cliError.Error())
+
+		var structuredError StructuredError
+		require.NoError(t, json.Unmarshal(output.Bytes(), &structuredError))
+		assert.Equal(t, StructuredError{
+			Code:      "FORBIDDEN",
+			Message:   cause.Error(),
+			Command:   "repo-delete",
+			Arguments: []string{"generic-local"},
+			Context:   map[string]string{"project": "proj"},
+			Status:    &ResponseStatus{Code: 403, Text: "Forbidden"},
+		}, structuredError)
+	})
+
+	t.Run("json keeps exit code", func(t *testing.T) {
+		output.Reset()
+		exitCode := coreutils.ExitCode{Code: 4}
+		driftAction := func(c *components.Context) error {
+			return coreutils.CliError{ExitCode: exitCode, ErrorMsg: "drift detected"}
+		}
+		err := WithErrorFormat(driftAction)(newContext(ErrorFormatJson))
+		var cliError coreutils.CliError
+		require.ErrorAs(t, err, &cliError)
+		assert.Equal(t, exitCode, cliError.ExitCode)
+		assert.Contains(t, output.String(), "drift detected")
+	})
+
+	t.Run("json success", func(t *testing.T) {
+		output.Reset()
+		assert.NoError(t, WithErrorFormat(func(c *components.Context) error { return nil })(newContext(ErrorFormatJson)))
+		assert.Empty(t, output.String())
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		output.Reset()
+		assert.EqualError(t, WithErrorFormat(failingAction)(newContext("xml")), "unsupported error format 'xml'. Supported formats: text, json")
+		assert.Empty(t, output.String())
+	})
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/create"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+func backfillEvidence(ctx *components.Context) error {
+	if err := validateCreateEvidenceCommonContext(ctx); err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	rbVersionRange, err := create.ParseVersionRange(ctx.GetStringFlagValue(versionRange))
+	if err != nil {
+		return err
+	}
+	serverDetails, err := evidenceDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	backfillCmd := create.NewBackfillEvidenceReleaseBundles(
+		serverDetails,
+		getCreateEvidenceOptions(ctx),
+		ctx.GetStringFlagValue(project),
+		ctx.GetStringFlagValue(releaseBundle),
+		rbVersionRange,
+		ctx.GetStringFlagValue(releaseBundleRepo))
+	return execFunc(backfillCmd)
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/create"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+func createEvidenceBatch(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if assertValueProvided(ctx, spec) != nil {
+		return errorutils.CheckErrorf("'spec' is a mandatory field for creating evidence in a batch: --%s", spec)
+	}
+	if err := applyEvidenceProfile(ctx); err != nil {
+		return err
+	}
+	// The default key is optional, as the subjects of the spec may provide their own keys. A subject without a key
+	// is reported before any evidence is created.
+	if ensureKeyExists(ctx, key) == nil {
+		if err := decodeBase64KeysIfRequested(ctx); err != nil {
+			return err
+		}
+	}
+	if !ctx.IsFlagSet(keyAlias) {
+		setKeyAliasIfProvided(ctx, keyAlias)
+	}
+	threadsCount, err := pluginsCommon.GetThreadsCount(ctx)
+	if err != nil {
+		return err
+	}
+	serverDetails, err := evidenceDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	batchCmd := create.NewCreateEvidenceSpec(
+		serverDetails,
+		getCreateEvidenceOptions(ctx),
+		ctx.GetStringFlagValue(spec),
+		threadsCount,
+		ctx.GetBoolFlagValue(failFast))
+	return execFunc(batchCmd)
+}
@@ -32,14 +32,11 @@ func (ebc *evidenceBuildCommand) CreateEvidence(ctx *components.Context, serverD
 
 	createCmd := create.NewCreateEvidenceBuild(
 		serverDetails,
-		ebc.ctx.GetStringFlagValue(predicate),
-		ebc.ctx.GetStringFlagValue(predicateType),
-		ebc.ctx.GetStringFlagValue(markdown),
-		ebc.ctx.GetStringFlagValue(key),
-		ebc.ctx.GetStringFlagValue(keyAlias),
+		getCreateEvidenceOptions(ebc.ctx),
 		ebc.ctx.GetStringFlagValue(project),
 		ebc.ctx.GetStringFlagValue(buildName),
-		ebc.ctx.GetStringFlagValue(buildNumber))
+		ebc.ctx.GetStringFlagValue(buildNumber),
+		ebc.ctx.GetStringFlagValue(buildInfoFile))
 	return ebc.execute(createCmd)
 }
 
@@ -55,17 +52,24 @@ func (ebc *evidenceBuildCommand) VerifyEvidence(ctx *components.Context, serverD
 
 	verifyCmd := verify.NewVerifyEvidenceBuild(
 		serverDetails,
+		getClientOptions(ebc.ctx),
 		ebc.ctx.GetStringFlagValue(project),
 		ebc.ctx.GetStringFlagValue(buildName),
 		ebc.ctx.GetStringFlagValue(buildNumber),
 		ebc.ctx.GetStringFlagValue(format),
 		ebc.ctx.GetStringsArrFlagValue(publicKeys),
 		ebc.ctx.GetBoolFlagValue(useArtifactoryKeys),
+		ebc.ctx.GetStringFlagValue(predicateType),
+		getMaxAge(ebc.ctx),
 	)
 	return ebc.execute(verifyCmd)
 }
 
 func (ebc *evidenceBuildCommand) validateEvidenceBuildContext(ctx *components.Context) error {
+	// The build number is read from the build-info file.
+	if ctx.GetStringFlagValue(buildInfoFile) != "" {
+		return nil
+	}
 	if !ctx.IsFlagSet(buildNumber) || assertValueProvided(ctx, buildNumber) != nil {
 		return errorutils.CheckErrorf("--%s is a mandatory field for creating a Release Bundle evidence", buildNumber)
 	}
@@ -3,14 +3,29 @@ package cli
 import (
 	"errors"
 	"fmt"
+	netUrl "net/url"
 	"os"
+	"regexp"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/jfrog/jfrog-cli-artifactory/commonutils"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/backfill"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/batch"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/create"
+	deleteDocs "github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/delete"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/export"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/get"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/keys"
+	preflightDocs "github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/preflight"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/resolve"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/validatekey"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/verify"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cli/docs/verifyenvelope"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
 	jfrogArtClient "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	evidenceVerify "github.com/jfrog/jfrog-cli-artifactory/evidence/verify"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
@@ -19,6 +34,7 @@ import (
 	coreUtils "github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 func GetCommands() []components.Command {
@@ -29,7 +45,7 @@ func GetCommands() []components.Command {
 			Flags:       GetCommandFlags(CreateEvidence),
 			Description: create.GetDescription(),
 			Arguments:   create.GetArguments(),
-			Action:      createEvidence,
+			Action:      commonutils.WithErrorFormat(createEvidence, evidenceContextFlags...),
 		},
 		{
 			Name:        "get-evidence",
@@ -37,7 +53,7 @@ func GetCommands() []components.Command {
 			Flags:       GetCommandFlags(GetEvidence),
 			Description: get.GetDescription(),
 			Arguments:   get.GetArguments(),
-			Action:      getEvidence,
+			Action:      commonutils.WithErrorFormat(getEvidence, evidenceContextFlags...),
 		},
 		{
 			Name:        "verify-evidence",
@@ -45,11 +61,98 @@ func GetCommands() []components.Command {
 			Flags:       GetCommandFlags(VerifyEvidence),
 			Description: verify.GetDescription(),
 			Arguments:   verify.GetArguments(),
-			Action:      verifyEvidence,
+			Action:      commonutils.WithErrorFormat(verifyEvidence, evidenceContextFlags...),
+		},
+		{
+			Name:        "resolve-subject",
+			Aliases:     []string{"resolve"},
+			Flags:       GetCommandFlags(ResolveSubject),
+			Description: resolve.GetDescription(),
+			Arguments:   resolve.GetArguments(),
+			Action:      commonutils.WithErrorFormat(resolveSubject, evidenceContextFlags...),
+		},
+		{
+			Name:        "delete-evidence",
+			Aliases:     []string{"delete"},
+			Flags:       GetCommandFlags(DeleteEvidence),
+			Description: deleteDocs.GetDescription(),
+			Arguments:   deleteDocs.GetArguments(),
+			Action:      commonutils.WithErrorFormat(deleteEvidence, evidenceContextFlags...),
+		},
+		{
+			Name:        "backfill-evidence",
+			Aliases:     []string{"backfill"},
+			Flags:       GetCommandFlags(BackfillEvidence),
+			Description: backfill.GetDescription(),
+			Arguments:   backfill.GetArguments(),
+			Action:      commonutils.WithErrorFormat(backfillEvidence, evidenceContextFlags...),
+		},
+		{
+			Name:        "create-evidence-batch",
+			Aliases:     []string{"create-batch"},
+			Flags:       GetCommandFlags(CreateBatch),
+			Description: batch.GetDescription(),
+			Arguments:   batch.GetArguments(),
+			Action:      commonutils.WithErrorFormat(createEvidenceBatch, evidenceContextFlags...),
+		},
+		{
+			Name:        "get-evidence-keys",
+			Aliases:     []string{"keys"},
+			Flags:       GetCommandFlags(GetEvidenceKeys),
+			Description: keys.GetDescription(),
+			Arguments:   keys.GetArguments(),
+			Action:      commonutils.WithErrorFormat(getEvidenceKeys, evidenceContextFlags...),
+		},
+		{
+			Name:        "export-evidence",
+			Aliases:     []string{"export"},
+			Flags:       GetCommandFlags(ExportEvidence),
+			Description: export.GetDescription(),
+			Arguments:   export.GetArguments(),
+			Action:      commonutils.WithErrorFormat(exportEvidence, evidenceContextFlags...),
+		},
+		{
+			Name:        "verify-envelope",
+			Flags:       GetCommandFlags(VerifyEnvelope),
+			Description: verifyenvelope.GetDescription(),
+			Arguments:   verifyenvelope.GetArguments(),
+			Action:      commonutils.WithErrorFormat(verifyEnvelope),
+		},
+		{
+			Name:        "validate-key",
+			Flags:       GetCommandFlags(ValidateKey),
+			Description: validatekey.GetDescription(),
+			Arguments:   validatekey.GetArguments(),
+			Action:      commonutils.WithErrorFormat(validateKey),
+		},
+		{
+			Name:        "preflight",
+			Flags:       GetCommandFlags(Preflight),
+			Description: preflightDocs.GetDescription(),
+			Arguments:   preflightDocs.GetArguments(),
+			Action:      commonutils.WithErrorFormat(evidencePreflight, evidenceContextFlags...),
 		},
 	}
 }
 
+// Environment variable that can be used instead of the --markdown-text flag.
+const markdownTextEnv = "JFROG_CLI_EVIDENCE_MARKDOWN_TEXT"
+
+// Environment variable that can be used instead of the --evidence-api-path flag.
+const evidenceApiPathEnv = "JFROG_CLI_EVIDENCE_API_PATH"
+
+// Schemes supported by the --proxy flag.
+var supportedProxySchemes = []string{"http", "https", "socks5"}
+
+// Path of the evidence service relative to the platform URL, used unless another path is pinned.
+const defaultEvidenceApiPath = "evidence"
+
+// A segment of the evidence service path. Segments may not start with a dot, which rules out '.' and '..'.
+var evidenceApiPathSegmentRegexp = regexp.MustCompile(`^[A-Za-z0-9_~-][A-Za-z0-9._~-]*$`)
+
+// Flags which identify the subject of an evidence command, added to the error context when --error-format=json is used.
+var evidenceContextFlags = []string{subjectRepoPath, releaseBundle, releaseBundleVersion, buildName, buildNumber, packageName, packageVersion, packageRepoName, project}
+
 var execFunc = commands.Exec
 var ErrUnsupportedSubject = errors.New("unsupported subject")
 
@@ -61,10 +164,22 @@ func createEvidence(ctx *components.Context) error {
 	if err != nil {
 		return err
 	}
+	if ctx.GetStringFlagValue(promotionEnvironment) != "" && evidenceType[0] != releaseBundle {
+		return errorutils.CheckErrorf("--%s is supported only for release bundle evidence", promotionEnvironment)
+	}
+	if ctx.GetStringFlagValue(buildInfoFile) != "" && evidenceType[0] != buildName {
+		return errorutils.CheckErrorf("--%s is supported only for build evidence", buildInfoFile)
+	}
+	if err = validateHeaderFlag(ctx); err != nil {
+		return err
+	}
 	serverDetails, err := evidenceDetailsByFlags(ctx)
 	if err != nil {
 		return err
 	}
+	if err = resolvePlatformKeyIfRequested(ctx, serverDetails); err != nil {
+		return err
+	}
 
 	if slices.Contains(evidenceType, typeFlag) || (slices.Contains(evidenceType, buildName) && slices.Contains(evidenceType, typeFlag)) {
 		return NewEvidenceGitHubCommand(ctx, execFunc).CreateEvidence(ctx, serverDetails)
@@ -137,6 +252,9 @@ func verifyEvidence(ctx *components.Context) error {
 	if err != nil {
 		return err
 	}
+	if err = validateMaxAge(ctx); err != nil {
+		return err
+	}
 	evidenceCommands := map[string]func(*components.Context, execCommandFunc) EvidenceCommands{
 		subjectRepoPath: NewEvidenceCustomCommand,
 		releaseBundle:   NewEvidenceReleaseBundleCommand,
@@ -156,6 +274,27 @@ func verifyEvidence(ctx *components.Context) error {
 	return errors.New("unsupported subject")
 }
 
+// validateMaxAge validates --max-age, which requires --predicate-type, before the subject and its evidence are fetched.
+func validateMaxAge(ctx *components.Context) error {
+	if ctx.GetStringFlagValue(maxAge) == "" {
+		return nil
+	}
+	if ctx.GetStringFlagValue(predicateType) == "" {
+		return errorutils.CheckErrorf("--%s requires --%s, the predicate type of the evidence whose age is checked", maxAge, predicateType)
+	}
+	_, err := evidenceVerify.ParseMaxAge(ctx.GetStringFlagValue(maxAge))
+	return err
+}
+
+// getMaxAge returns the value of --max-age, which is validated by validateMaxAge before the verify commands are created.
+func getMaxAge(ctx *components.Context) time.Duration {
+	if ctx.GetStringFlagValue(maxAge) == "" {
+		return 0
+	}
+	maxAgeValue, _ := evidenceVerify.ParseMaxAge(ctx.GetStringFlagValue(maxAge))
+	return maxAgeValue
+}
+
 func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
 		return err
@@ -165,6 +304,10 @@ func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
 	}
 
+	if ctx.GetBoolFlagValue(force) && ctx.GetBoolFlagValue(failOnExisting) {
+		return errorutils.CheckErrorf("--%s and --%s cannot be used together", force, failOnExisting)
+	}
+
 	if ctx.IsFlagSet(sigstoreBundle) && assertValueProvided(ctx, sigstoreBundle) == nil {
 		if err := validateSigstoreBundleArgsConflicts(ctx); err != nil {
 			return err
@@ -172,6 +315,10 @@ func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 		return nil
 	}
 
+	if err := applyEvidenceProfile(ctx); err != nil {
+		return err
+	}
+
 	if (!ctx.IsFlagSet(predicate) || assertValueProvided(ctx, predicate) != nil) && !ctx.IsFlagSet(typeFlag) {
 		return errorutils.CheckErrorf("'predicate' is a mandatory field for creating evidence: --%s", predicate)
 	}
@@ -180,7 +327,25 @@ func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 		return errorutils.CheckErrorf("'predicate-type' is a mandatory field for creating evidence: --%s", predicateType)
 	}
 
-	if err := ensureKeyExists(ctx, key); err != nil {
+	if ctx.GetStringFlagValue(platformKey) != "" {
+		if ctx.GetStringFlagValue(key) != "" {
+			return errorutils.CheckErrorf("--%s and --%s cannot be used together", key, platformKey)
+		}
+	} else {
+		if err := ensureKeyExists(ctx, key); err != nil {
+			return err
+		}
+
+		if err := decodeBase64KeysIfRequested(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := validateCoSigningArgs(ctx); err != nil {
+		return err
+	}
+
+	if err := setMarkdownTextIfProvided(ctx); err != nil {
 		return err
 	}
 
@@ -190,6 +355,17 @@ func validateCreateEvidenceCommonContext(ctx *components.Context) error {
 	return nil
 }
 
+// validateCoSigningArgs rejects the flags which require a single signature, when multiple keys are provided.
+func validateCoSigningArgs(ctx *components.Context) error {
+	if len(ctx.GetStringsArrFlagValue(key)) < 2 {
+		return nil
+	}
+	if ctx.GetStringFlagValue(detachedSignatureOutput) != "" {
+		return errorutils.CheckErrorf("--%s can't be used when signing with multiple keys", detachedSignatureOutput)
+	}
+	return nil
+}
+
 func validateSigstoreBundleArgsConflicts(ctx *components.Context) error {
 	var conflictingParams []string
 
@@ -202,9 +378,21 @@ func validateSigstoreBundleArgsConflicts(ctx *components.Context) error {
 	if ctx.IsFlagSet(predicate) && ctx.GetStringFlagValue(predicate) != "" {
 		conflictingParams = append(conflictingParams, "--"+predicate)
 	}
+	if ctx.IsFlagSet(predicateHeader) && ctx.GetStringFlagValue(predicateHeader) != "" {
+		conflictingParams = append(conflictingParams, "--"+predicateHeader)
+	}
 	if ctx.IsFlagSet(predicateType) && ctx.GetStringFlagValue(predicateType) != "" {
 		conflictingParams = append(conflictingParams, "--"+predicateType)
 	}
+	if ctx.IsFlagSet(detachedSignatureOutput) && ctx.GetStringFlagValue(detachedSignatureOutput) != "" {
+		conflictingParams = append(conflictingParams, "--"+detachedSignatureOutput)
+	}
+	if ctx.IsFlagSet(tsaUrl) && ctx.GetStringFlagValue(tsaUrl) != "" {
+		conflictingParams = append(conflictingParams, "--"+tsaUrl)
+	}
+	if ctx.IsFlagSet(annotations) && ctx.GetStringFlagValue(annotations) != "" {
+		conflictingParams = append(conflictingParams, "--"+annotations)
+	}
 
 	if len(conflictingParams) > 0 {
 		return errorutils.CheckErrorf("The following parameters cannot be used with --%s: %s. These values are extracted from the bundle itself:", sigstoreBundle, strings.Join(conflictingParams, ", "))
@@ -226,6 +414,40 @@ func ensureKeyExists(ctx *components.Context, key string) error {
 	return nil
 }
 
+// decodeBase64KeysIfRequested replaces the base64 encoded keys with the decoded keys, when --key-base64 is set.
+// Multiple keys are separated by ';'.
+func decodeBase64KeysIfRequested(ctx *components.Context) error {
+	if !ctx.GetBoolFlagValue(keyBase64) {
+		return nil
+	}
+	encodedKeys := strings.Split(ctx.GetStringFlagValue(key), ";")
+	decodedKeys := make([]string, 0, len(encodedKeys))
+	for i, encodedKey := range encodedKeys {
+		decodedKey, err := cryptox.DecodeBase64Key(encodedKey)
+		if err != nil {
+			if len(encodedKeys) > 1 {
+				return fmt.Errorf("signing key %d of %d: %w", i+1, len(encodedKeys), err)
+			}
+			return err
+		}
+		decodedKeys = append(decodedKeys, string(decodedKey))
+	}
+	ctx.AddStringFlag(key, strings.Join(decodedKeys, ";"))
+	return nil
+}
+
+func setMarkdownTextIfProvided(ctx *components.Context) error {
+	if !ctx.IsFlagSet(markdownText) {
+		if markdownTextValue, _ := jfrogArtClient.GetEnvVariable(markdownTextEnv); markdownTextValue != "" {
+			ctx.AddStringFlag(markdownText, markdownTextValue)
+		}
+	}
+	if ctx.GetStringFlagValue(markdown) != "" && ctx.GetStringFlagValue(markdownText) != "" {
+		return errorutils.CheckErrorf("--%s and --%s cannot be used together", markdown, markdownText)
+	}
+	return nil
+}
+
 func setKeyAliasIfProvided(ctx *components.Context, keyAlias string) {
 	evdKeyAliasValue, _ := jfrogArtClient.GetEnvVariable(coreUtils.KeyAlias)
 	if evdKeyAliasValue != "" {
@@ -245,6 +467,10 @@ func getAndValidateSubject(ctx *components.Context) ([]string, error) {
 		if ctx.IsFlagSet(sigstoreBundle) && assertValueProvided(ctx, sigstoreBundle) == nil {
 			return []string{subjectRepoPath}, nil // Return subjectRepoPath as the type for routing
 		}
+		// The build name and number are read from the build-info file, rather than from the environment
+		if ctx.IsFlagSet(buildInfoFile) && assertValueProvided(ctx, buildInfoFile) == nil {
+			return []string{buildName}, nil
+		}
 		// If we have no subject - we will try to create EVD on build
 		if !attemptSetBuildNameAndNumber(ctx) {
 			return nil, errorutils.CheckErrorf("subject must be one of the fields: [%s]", strings.Join(subjectTypes, ", "))
@@ -307,6 +533,9 @@ func validateFoundSubjects(ctx *components.Context, foundSubjects []string) erro
 }
 
 func evidenceDetailsByFlags(ctx *components.Context) (*config.ServerDetails, error) {
+	if err := validateProxyFlag(ctx); err != nil {
+		return nil, err
+	}
 	serverDetails, err := pluginsCommon.CreateServerDetailsWithConfigOffer(ctx, true, commonCliUtils.Platform)
 	if err != nil {
 		return nil, err
@@ -314,7 +543,11 @@ func evidenceDetailsByFlags(ctx *components.Context) (*config.ServerDetails, err
 	if serverDetails.Url == "" {
 		return nil, errors.New("platform URL is mandatory for evidence commands")
 	}
-	platformToEvidenceUrls(serverDetails)
+	apiPath, err := getEvidenceApiPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	platformToEvidenceUrls(serverDetails, apiPath)
 
 	if serverDetails.GetUser() != "" && serverDetails.GetPassword() != "" {
 		return nil, errors.New("evidence service does not support basic authentication")
@@ -323,9 +556,79 @@ func evidenceDetailsByFlags(ctx *components.Context) (*config.ServerDetails, err
 	return serverDetails, nil
 }
 
-func platformToEvidenceUrls(rtDetails *config.ServerDetails) {
+// validateProxyFlag validates the proxy of the --proxy flag, which the clients of the command send their requests through.
+func validateProxyFlag(ctx *components.Context) error {
+	proxyUrl := ctx.GetStringFlagValue(proxy)
+	if proxyUrl == "" {
+		return nil
+	}
+	if err := validateProxyUrl(proxyUrl); err != nil {
+		return err
+	}
+	log.Debug("Using the proxy:", proxyUrl)
+	return nil
+}
+
+// validateHeaderFlag validates the custom headers of the --header flag, which the clients of the command send with their
+// requests.
+func validateHeaderFlag(ctx *components.Context) error {
+	headers, err := jfrogArtClient.ParseCustomHeaders(getStringsArrFlagValueIfProvided(ctx, header))
+	if err != nil {
+		return err
+	}
+	if len(headers) > 0 {
+		log.Debug("Sending the custom headers:", jfrogArtClient.FormatHeadersForLog(headers))
+	}
+	return nil
+}
+
+// validateProxyUrl validates an absolute proxy URL, such as 'http://proxy.example.com:8080'.
+func validateProxyUrl(proxyUrl string) error {
+	parsedUrl, err := netUrl.Parse(proxyUrl)
+	if err != nil {
+		return errorutils.CheckErrorf("invalid proxy URL '%s': %s", proxyUrl, err.Error())
+	}
+	if !slices.Contains(supportedProxySchemes, strings.ToLower(parsedUrl.Scheme)) {
+		return errorutils.CheckErrorf("invalid proxy URL '%s': the scheme must be one of: %s", proxyUrl, strings.Join(supportedProxySchemes, ", "))
+	}
+	if parsedUrl.Hostname() == "" {
+		return errorutils.CheckErrorf("invalid proxy URL '%s': the host is missing", proxyUrl)
+	}
+	return nil
+}
+
+// getEvidenceApiPath returns the path of the evidence service relative to the platform URL, taken from the --evidence-api-path
+// flag, the JFROG_CLI_EVIDENCE_API_PATH environment variable, or the default path, in this order.
+func getEvidenceApiPath(ctx *components.Context) (string, error) {
+	apiPath := ctx.GetStringFlagValue(evidenceApiPath)
+	if apiPath == "" {
+		apiPath, _ = jfrogArtClient.GetEnvVariable(evidenceApiPathEnv)
+	}
+	if apiPath == "" {
+		return defaultEvidenceApiPath, nil
+	}
+	return validateEvidenceApiPath(apiPath)
+}
+
+// validateEvidenceApiPath validates a relative path such as 'evidence' or 'evidence/v2', and returns it without surrounding
+// slashes.
+func validateEvidenceApiPath(apiPath string) (string, error) {
+	trimmedPath := strings.Trim(apiPath, "/")
+	if trimmedPath == "" {
+		return "", errorutils.CheckErrorf("invalid evidence API path '%s': the path must not be empty", apiPath)
+	}
+	for _, segment := range strings.Split(trimmedPath, "/") {
+		if !evidenceApiPathSegmentRegexp.MatchString(segment) {
+			return "", errorutils.CheckErrorf("invalid evidence API path '%s': expected a path relative to the platform URL, such as '%s' or '%s/v2'", apiPath, defaultEvidenceApiPath, defaultEvidenceApiPath)
+		}
+	}
+	return trimmedPath, nil
+}
+
+func platformToEvidenceUrls(rtDetails *config.ServerDetails, apiPath string) {
 	rtDetails.ArtifactoryUrl = utils.AddTrailingSlashIfNeeded(rtDetails.Url) + "artifactory/"
-	rtDetails.EvidenceUrl = utils.AddTrailingSlashIfNeeded(rtDetails.Url) + "evidence/"
+	rtDetails.EvidenceUrl = utils.AddTrailingSlashIfNeeded(rtDetails.Url) + apiPath + "/"
+	log.Debug("Using the evidence service URL:", rtDetails.EvidenceUrl)
 	rtDetails.MetadataUrl = utils.AddTrailingSlashIfNeeded(rtDetails.Url) + "metadata/"
 	rtDetails.OnemodelUrl = utils.AddTrailingSlashIfNeeded(rtDetails.Url) + "onemodel/"
 	rtDetails.LifecycleUrl = utils.AddTrailingSlashIfNeeded(rtDetails.Url) + "lifecycle/"
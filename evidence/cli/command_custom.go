@@ -26,27 +26,44 @@ func (ecc *evidenceCustomCommand) CreateEvidence(_ *components.Context, serverDe
 		return errorutils.CheckErrorf("The parameter --%s cannot be used with --%s. The subject hash is extracted from the bundle itself.", subjectSha256, sigstoreBundle)
 	}
 
+	if err := validateRecursiveArgs(ecc.ctx); err != nil {
+		return err
+	}
+
 	// Single command handles both regular evidence creation and sigstore bundles
 	createCmd := create.NewCreateEvidenceCustom(
 		serverDetails,
-		ecc.ctx.GetStringFlagValue(predicate),
-		ecc.ctx.GetStringFlagValue(predicateType),
-		ecc.ctx.GetStringFlagValue(markdown),
-		ecc.ctx.GetStringFlagValue(key),
-		ecc.ctx.GetStringFlagValue(keyAlias),
+		getCreateEvidenceOptions(ecc.ctx),
 		ecc.ctx.GetStringFlagValue(subjectRepoPath),
 		ecc.ctx.GetStringFlagValue(subjectSha256),
 		ecc.ctx.GetStringFlagValue(sigstoreBundle),
-		ecc.ctx.GetStringFlagValue(providerId))
+		ecc.ctx.GetBoolFlagValue(recursive))
 	return ecc.execute(createCmd)
 }
 
+// validateRecursiveArgs rejects the flags which apply to a single subject file, when evidence is created recursively.
+func validateRecursiveArgs(ctx *components.Context) error {
+	if !ctx.GetBoolFlagValue(recursive) {
+		return nil
+	}
+	for _, flag := range []string{subjectSha256, sigstoreBundle, detachedSignatureOutput} {
+		if ctx.GetStringFlagValue(flag) != "" {
+			return errorutils.CheckErrorf("--%s can't be used with --%s", flag, recursive)
+		}
+	}
+	return nil
+}
+
 func (ecc *evidenceCustomCommand) GetEvidence(_ *components.Context, serverDetails *config.ServerDetails) error {
 	getCmd := get.NewGetEvidenceCustom(
 		serverDetails,
+		getClientOptions(ecc.ctx),
 		ecc.ctx.GetStringFlagValue(subjectRepoPath),
 		ecc.ctx.GetStringFlagValue(format),
 		ecc.ctx.GetStringFlagValue(output),
+		ecc.ctx.GetStringFlagValue(since),
+		ecc.ctx.GetStringFlagValue(until),
+		ecc.ctx.GetStringFlagValue(policy),
 		ecc.ctx.GetBoolFlagValue(includePredicate),
 	)
 
@@ -56,10 +73,13 @@ func (ecc *evidenceCustomCommand) GetEvidence(_ *components.Context, serverDetai
 func (ecc *evidenceCustomCommand) VerifyEvidence(_ *components.Context, serverDetails *config.ServerDetails) error {
 	verifyCmd := verify.NewVerifyEvidenceCustom(
 		serverDetails,
+		getClientOptions(ecc.ctx),
 		ecc.ctx.GetStringFlagValue(subjectRepoPath),
 		ecc.ctx.GetStringFlagValue(format),
 		ecc.ctx.GetStringsArrFlagValue(publicKeys),
 		ecc.ctx.GetBoolFlagValue(useArtifactoryKeys),
+		ecc.ctx.GetStringFlagValue(predicateType),
+		getMaxAge(ecc.ctx),
 	)
 	return ecc.execute(verifyCmd)
 }
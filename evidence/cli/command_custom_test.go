@@ -80,3 +80,52 @@ func TestEvidenceCustomCommand_CreateEvidence_SigstoreBundle(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRecursiveArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		flags         []components.Flag
+		recursive     bool
+		errorContains string
+	}{
+		{
+			name:  "Not_Recursive_With_SubjectSha256",
+			flags: []components.Flag{setDefaultValue(subjectSha256, "abcd1234567890")},
+		},
+		{
+			name:      "Recursive",
+			flags:     []components.Flag{setDefaultValue(subjectRepoPath, "test-repo/folder")},
+			recursive: true,
+		},
+		{
+			name:          "Recursive_With_SubjectSha256",
+			flags:         []components.Flag{setDefaultValue(subjectSha256, "abcd1234567890")},
+			recursive:     true,
+			errorContains: "--subject-sha256 can't be used with --recursive",
+		},
+		{
+			name:          "Recursive_With_Detached_Signature",
+			flags:         []components.Flag{setDefaultValue(detachedSignatureOutput, "/path/to/output")},
+			recursive:     true,
+			errorContains: "--detached-signature-output can't be used with --recursive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := cli.NewApp()
+			app.Commands = []cli.Command{{Name: "create"}}
+			set := flag.NewFlagSet("test", 0)
+			ctx, err := components.ConvertContext(cli.NewContext(app, set, nil), tt.flags...)
+			assert.NoError(t, err)
+			ctx.AddBoolFlag(recursive, tt.recursive)
+
+			err = validateRecursiveArgs(ctx)
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
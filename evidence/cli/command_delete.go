@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/create"
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+func deleteEvidence(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if assertValueProvided(ctx, predicateType) != nil {
+		return errorutils.CheckErrorf("'predicate-type' is a mandatory field for deleting evidence: --%s", predicateType)
+	}
+	subjectType, err := getAndValidateSubject(ctx)
+	if err != nil {
+		return err
+	}
+	serverDetails, err := evidenceDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	evdPredicateType := ctx.GetStringFlagValue(predicateType)
+	evdCreatedAt := ctx.GetStringFlagValue(createdAt)
+	isQuiet := pluginsCommon.GetQuietValue(ctx)
+	var deleteCmd commands.Command
+	switch subjectType[0] {
+	case subjectRepoPath:
+		deleteCmd = create.NewDeleteEvidenceCustom(serverDetails, getClientOptions(ctx), evdPredicateType, evdCreatedAt, isQuiet,
+			ctx.GetStringFlagValue(subjectRepoPath), ctx.GetStringFlagValue(subjectSha256))
+	case releaseBundle:
+		if err = (&evidenceReleaseBundleCommand{ctx: ctx}).validateEvidenceReleaseBundleContext(ctx); err != nil {
+			return err
+		}
+		deleteCmd = create.NewDeleteEvidenceReleaseBundle(serverDetails, getClientOptions(ctx), evdPredicateType, evdCreatedAt, isQuiet,
+			ctx.GetStringFlagValue(project), ctx.GetStringFlagValue(releaseBundle), ctx.GetStringFlagValue(releaseBundleVersion))
+	case buildName:
+		if err = (&evidenceBuildCommand{ctx: ctx}).validateEvidenceBuildContext(ctx); err != nil {
+			return err
+		}
+		deleteCmd = create.NewDeleteEvidenceBuild(serverDetails, getClientOptions(ctx), evdPredicateType, evdCreatedAt, isQuiet,
+			ctx.GetStringFlagValue(project), ctx.GetStringFlagValue(buildName), ctx.GetStringFlagValue(buildNumber))
+	case packageName:
+		if err = (&evidencePackageCommand{ctx: ctx}).validateEvidencePackageContext(ctx); err != nil {
+			return err
+		}
+		deleteCmd = create.NewDeleteEvidencePackage(serverDetails, getClientOptions(ctx), evdPredicateType, evdCreatedAt, isQuiet,
+			ctx.GetStringFlagValue(packageName), ctx.GetStringFlagValue(packageVersion), ctx.GetStringFlagValue(packageRepoName))
+	default:
+		return ErrUnsupportedSubject
+	}
+	return execFunc(deleteCmd)
+}
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/get"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+func exportEvidence(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if assertValueProvided(ctx, subjectRepoPath) != nil || assertValueProvided(ctx, output) != nil {
+		return errorutils.CheckErrorf("--%s and --%s are mandatory fields for exporting the evidence of a subject", subjectRepoPath, output)
+	}
+	serverDetails, err := evidenceDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	return execFunc(get.NewExportEvidenceCustom(
+		serverDetails,
+		getClientOptions(ctx),
+		ctx.GetStringFlagValue(subjectRepoPath),
+		ctx.GetStringFlagValue(output)))
+}
@@ -35,11 +35,7 @@ func (ebc *evidenceGitHubCommand) CreateEvidence(ctx *components.Context, server
 
 	createCmd := create.NewCreateGithub(
 		serverDetails,
-		ebc.ctx.GetStringFlagValue(predicate),
-		ebc.ctx.GetStringFlagValue(predicateType),
-		ebc.ctx.GetStringFlagValue(markdown),
-		ebc.ctx.GetStringFlagValue(key),
-		ebc.ctx.GetStringFlagValue(keyAlias),
+		getCreateEvidenceOptions(ebc.ctx),
 		ebc.ctx.GetStringFlagValue(project),
 		ebc.ctx.GetStringFlagValue(buildName),
 		ebc.ctx.GetStringFlagValue(buildNumber),
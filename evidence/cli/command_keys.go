@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/verify"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+func getEvidenceKeys(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if assertValueProvided(ctx, releaseBundle) != nil || assertValueProvided(ctx, releaseBundleVersion) != nil {
+		return errorutils.CheckErrorf("--%s and --%s are mandatory fields for getting the evidence keys of a Release Bundle", releaseBundle, releaseBundleVersion)
+	}
+	serverDetails, err := evidenceDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	return execFunc(verify.NewGetEvidenceKeysReleaseBundle(
+		serverDetails,
+		getClientOptions(ctx),
+		ctx.GetStringFlagValue(project),
+		ctx.GetStringFlagValue(releaseBundle),
+		ctx.GetStringFlagValue(releaseBundleVersion),
+		ctx.GetStringFlagValue(keyAlias),
+		ctx.GetStringFlagValue(output)))
+}
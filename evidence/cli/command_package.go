@@ -32,14 +32,11 @@ func (epc *evidencePackageCommand) CreateEvidence(ctx *components.Context, serve
 
 	createCmd := create.NewCreateEvidencePackage(
 		serverDetails,
-		epc.ctx.GetStringFlagValue(predicate),
-		epc.ctx.GetStringFlagValue(predicateType),
-		epc.ctx.GetStringFlagValue(markdown),
-		epc.ctx.GetStringFlagValue(key),
-		epc.ctx.GetStringFlagValue(keyAlias),
+		getCreateEvidenceOptions(epc.ctx),
 		epc.ctx.GetStringFlagValue(packageName),
 		epc.ctx.GetStringFlagValue(packageVersion),
-		epc.ctx.GetStringFlagValue(packageRepoName))
+		epc.ctx.GetStringFlagValue(packageRepoName),
+		epc.ctx.GetBoolFlagValue(printDigest))
 	return epc.execute(createCmd)
 }
 
@@ -55,12 +52,15 @@ func (epc *evidencePackageCommand) VerifyEvidence(ctx *components.Context, serve
 
 	verifyCmd := verify.NewVerifyEvidencePackage(
 		serverDetails,
+		getClientOptions(epc.ctx),
 		epc.ctx.GetStringFlagValue(format),
 		epc.ctx.GetStringFlagValue(packageName),
 		epc.ctx.GetStringFlagValue(packageVersion),
 		epc.ctx.GetStringFlagValue(packageRepoName),
 		epc.ctx.GetStringsArrFlagValue(publicKeys),
 		epc.ctx.GetBoolFlagValue(useArtifactoryKeys),
+		epc.ctx.GetStringFlagValue(predicateType),
+		getMaxAge(epc.ctx),
 	)
 	return epc.execute(verifyCmd)
 }
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/create"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+// resolvePlatformKeyIfRequested fetches the key pair of --platform-key from the JFrog Platform keystore, and signs with its
+// private key as if it was provided with --key.
+func resolvePlatformKeyIfRequested(ctx *components.Context, serverDetails *config.ServerDetails) error {
+	platformKeyName := ctx.GetStringFlagValue(platformKey)
+	if platformKeyName == "" {
+		return nil
+	}
+	resolvedKey, err := create.ResolvePlatformKey(serverDetails, getClientOptions(ctx), platformKeyName)
+	if err != nil {
+		return err
+	}
+	ctx.AddStringFlag(key, resolvedKey.Key)
+	if ctx.GetStringFlagValue(keyAlias) == "" {
+		ctx.AddStringFlag(keyAlias, resolvedKey.KeyAlias)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/preflight"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+func evidencePreflight(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	serverDetails, err := evidenceDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+	return execFunc(preflight.NewEvidencePreflight(serverDetails, getClientOptions(ctx), ctx.GetStringFlagValue(format)))
+}
@@ -34,14 +34,12 @@ func (erc *evidenceReleaseBundleCommand) CreateEvidence(ctx *components.Context,
 
 	createCmd := create.NewCreateEvidenceReleaseBundle(
 		serverDetails,
-		erc.ctx.GetStringFlagValue(predicate),
-		erc.ctx.GetStringFlagValue(predicateType),
-		erc.ctx.GetStringFlagValue(markdown),
-		erc.ctx.GetStringFlagValue(key),
-		erc.ctx.GetStringFlagValue(keyAlias),
+		getCreateEvidenceOptions(erc.ctx),
 		erc.ctx.GetStringFlagValue(project),
 		erc.ctx.GetStringFlagValue(releaseBundle),
-		erc.ctx.GetStringFlagValue(releaseBundleVersion))
+		erc.ctx.GetStringFlagValue(releaseBundleVersion),
+		erc.ctx.GetStringFlagValue(promotionEnvironment),
+		erc.ctx.GetStringFlagValue(releaseBundleRepo))
 	return erc.execute(createCmd)
 }
 
@@ -53,12 +51,16 @@ func (erc *evidenceReleaseBundleCommand) GetEvidence(ctx *components.Context, se
 
 	getCmd := get.NewGetEvidenceReleaseBundle(
 		serverDetails,
+		getClientOptions(erc.ctx),
 		erc.ctx.GetStringFlagValue(releaseBundle),
 		erc.ctx.GetStringFlagValue(releaseBundleVersion),
 		erc.ctx.GetStringFlagValue(project),
 		erc.ctx.GetStringFlagValue(format),
 		erc.ctx.GetStringFlagValue(output),
 		erc.ctx.GetStringFlagValue(artifactsLimit),
+		erc.ctx.GetStringFlagValue(since),
+		erc.ctx.GetStringFlagValue(until),
+		erc.ctx.GetStringFlagValue(policy),
 		erc.ctx.GetBoolFlagValue(includePredicate),
 	)
 	return erc.execute(getCmd)
@@ -72,12 +74,15 @@ func (erc *evidenceReleaseBundleCommand) VerifyEvidence(ctx *components.Context,
 
 	verifyCmd := verify.NewVerifyEvidenceReleaseBundle(
 		serverDetails,
+		getClientOptions(erc.ctx),
 		erc.ctx.GetStringFlagValue(format),
 		erc.ctx.GetStringFlagValue(project),
 		erc.ctx.GetStringFlagValue(releaseBundle),
 		erc.ctx.GetStringFlagValue(releaseBundleVersion),
 		erc.ctx.GetStringsArrFlagValue(publicKeys),
 		erc.ctx.GetBoolFlagValue(useArtifactoryKeys),
+		erc.ctx.GetStringFlagValue(predicateType),
+		getMaxAge(erc.ctx),
 	)
 	return erc.execute(verifyCmd)
 }
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/create"
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+func resolveSubject(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	subjectType, err := getAndValidateSubject(ctx)
+	if err != nil {
+		return err
+	}
+	serverDetails, err := evidenceDetailsByFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	var resolveCmd commands.Command
+	switch subjectType[0] {
+	case subjectRepoPath:
+		resolveCmd = create.NewResolveSubjectCustom(serverDetails, getClientOptions(ctx), ctx.GetStringFlagValue(format),
+			ctx.GetStringFlagValue(subjectRepoPath), ctx.GetStringFlagValue(subjectSha256))
+	case releaseBundle:
+		if err = (&evidenceReleaseBundleCommand{ctx: ctx}).validateEvidenceReleaseBundleContext(ctx); err != nil {
+			return err
+		}
+		resolveCmd = create.NewResolveSubjectReleaseBundle(serverDetails, getClientOptions(ctx), ctx.GetStringFlagValue(format),
+			ctx.GetStringFlagValue(project), ctx.GetStringFlagValue(releaseBundle), ctx.GetStringFlagValue(releaseBundleVersion))
+	case buildName:
+		if err = (&evidenceBuildCommand{ctx: ctx}).validateEvidenceBuildContext(ctx); err != nil {
+			return err
+		}
+		resolveCmd = create.NewResolveSubjectBuild(serverDetails, getClientOptions(ctx), ctx.GetStringFlagValue(format),
+			ctx.GetStringFlagValue(project), ctx.GetStringFlagValue(buildName), ctx.GetStringFlagValue(buildNumber))
+	case packageName:
+		if err = (&evidencePackageCommand{ctx: ctx}).validateEvidencePackageContext(ctx); err != nil {
+			return err
+		}
+		resolveCmd = create.NewResolveSubjectPackage(serverDetails, getClientOptions(ctx), ctx.GetStringFlagValue(format),
+			ctx.GetStringFlagValue(packageName), ctx.GetStringFlagValue(packageVersion), ctx.GetStringFlagValue(packageRepoName))
+	default:
+		return ErrUnsupportedSubject
+	}
+	return execFunc(resolveCmd)
+}
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/create"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+func validateKey(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) > 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if err := ensureKeyExists(ctx, key); err != nil {
+		return err
+	}
+	if err := decodeBase64KeysIfRequested(ctx); err != nil {
+		return err
+	}
+	if !ctx.IsFlagSet(keyAlias) {
+		setKeyAliasIfProvided(ctx, keyAlias)
+	}
+	return execFunc(create.NewValidateKey(ctx.GetStringFlagValue(key), ctx.GetStringFlagValue(keyAlias), ctx.GetStringFlagValue(format)))
+}
@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/verify"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+func verifyEnvelope(ctx *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(ctx, ctx.Arguments); show || err != nil {
+		return err
+	}
+	if len(ctx.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(ctx)
+	}
+	if err := validateKeys(ctx); err != nil {
+		return err
+	}
+	return execFunc(verify.NewVerifyEnvelope(ctx.Arguments[0], ctx.GetStringFlagValue(format), ctx.GetStringsArrFlagValue(publicKeys)))
+}
@@ -0,0 +1,12 @@
+package backfill
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Create the same baseline evidence, such as a provenance predicate, for every existing release bundle version which lacks evidence with its predicate type.
+	Filter the release bundles with --release-bundle, which may contain '*' wildcards, --project and --version-range. Versions which already have the evidence are skipped.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
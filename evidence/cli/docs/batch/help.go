@@ -0,0 +1,13 @@
+package batch
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Create evidence for all the subjects listed in a spec file, such as the artifacts, builds, packages and release bundles produced by a pipeline, each with its own predicate.
+	The evidence is created concurrently using --threads, and the outcome for each subject is reported in the order of the spec. A failure doesn't stop the creation for the other subjects, unless --fail-fast is set.
+	Each subject is signed with its own key and keyAlias when the spec sets them, and otherwise with --key and --key-alias. All the keys are loaded before any evidence is created.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
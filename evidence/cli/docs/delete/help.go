@@ -0,0 +1,12 @@
+package delete
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Delete an evidence, identified by its subject and predicate type. The subject is resolved the same way as in the create-evidence command,
+	and can be an artifact, a release bundle, a build or a package. Use --created-at when the subject has several evidence with the same predicate type.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
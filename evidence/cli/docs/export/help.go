@@ -0,0 +1,14 @@
+package export
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Export every evidence of a subject into a single bundle for audit handoffs or long-term storage.
+	The bundle is a zip or tar.gz archive, depending on the extension of --output. It holds the DSSE envelope of each evidence and an 'index.json' manifest
+	listing the subject and, for each evidence, its file, sha256, predicate type, signing key alias and creation time.
+	Each envelope is checked against its sha256 in Artifactory on download, and the export fails on a mismatch.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
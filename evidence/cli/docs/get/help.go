@@ -6,7 +6,9 @@ func GetDescription() string {
 	return ` Fetch evidence based on a specified subject, which can be either an artifact or a release bundle.
                              When retrieving evidence from a release bundle, you will obtain information about the builds contained within it,
                              as well as the artifacts associated with those builds.
-                             Supports JSON and JSONL formats.`
+                             Supports JSON and JSONL formats.
+                             When --policy is provided, the evidence is checked to include every predicate type required by the policy instead,
+                             and the command fails, reporting the missing types, if it doesn't.`
 }
 
 func GetArguments() []components.Argument {
@@ -0,0 +1,12 @@
+package keys
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Get the public keys registered on the platform for verifying the evidence of a release bundle version, in PEM, to provide them to 'jf evd verify --public-keys'.
+	Each key is printed with its alias and fingerprint. To write a key to a file with --output, a single key must match, so use --key-alias when the evidence was signed with several keys.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
@@ -0,0 +1,12 @@
+package preflight
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Check that the Artifactory, evidence and metadata services of the selected server are reachable,
+	and that the configured credentials are accepted. Reports the status of each endpoint.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
@@ -0,0 +1,12 @@
+package resolve
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Resolve the subject of an evidence without creating or uploading it. Prints the subject path and its sha256,
+	computed the same way as the create-evidence command. The subject can be an artifact, a release bundle, a build or a package.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
@@ -0,0 +1,12 @@
+package validatekey
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Validate a signing key before using it to create evidence, without any network access.
+	The key is supplied using the --key flag or the JFROG_CLI_SIGNING_KEY environment variable. Reports the key type, the scheme and the key ID, and signs and verifies a sample payload with the key.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
@@ -0,0 +1,17 @@
+package verifyenvelope
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+func GetDescription() string {
+	return `Verify the signature of a local DSSE envelope file, such as evidence downloaded from Artifactory, without any network access.
+	Keys can be supplied using the --public-keys flag or the JFROG_CLI_SIGNING_KEY environment variable. Reports the payload type, the subject and the verification result.`
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "envelope path",
+			Description: "Path to the DSSE envelope file to verify.",
+		},
+	}
+}
@@ -1,15 +1,25 @@
 package cli
 
 import (
+	"github.com/jfrog/jfrog-cli-artifactory/commonutils"
 	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 )
 
 const (
 	// Evidence commands keys
-	CreateEvidence = "create-evidence"
-	GetEvidence    = "get-evidence"
-	VerifyEvidence = "verify-evidence"
+	CreateEvidence   = "create-evidence"
+	GetEvidence      = "get-evidence"
+	VerifyEvidence   = "verify-evidence"
+	ResolveSubject   = "resolve-subject"
+	DeleteEvidence   = "delete-evidence"
+	Preflight        = "preflight"
+	VerifyEnvelope   = "verify-envelope"
+	BackfillEvidence = "backfill-evidence"
+	CreateBatch      = "create-evidence-batch"
+	GetEvidenceKeys  = "get-evidence-keys"
+	ExportEvidence   = "export-evidence"
+	ValidateKey      = "validate-key"
 )
 
 const (
@@ -25,6 +35,10 @@ const (
 	// RLM flags keys
 	releaseBundle        = "release-bundle"
 	releaseBundleVersion = "release-bundle-version"
+	promotionEnvironment = "promotion-environment"
+	releaseBundleRepo    = "rb-repo"
+	versionRange         = "version-range"
+	buildInfoFile        = "build-info-file"
 	buildName            = "build-name"
 	buildNumber          = "build-number"
 	packageName          = "package-name"
@@ -35,8 +49,10 @@ const (
 	// Unique evidence flags
 	predicate          = "predicate"
 	predicateType      = "predicate-type"
+	predicateHeader    = "predicate-header"
 	includePredicate   = "include-predicate"
 	markdown           = "markdown"
+	markdownText       = "markdown-text"
 	subjectRepoPath    = "subject-repo-path"
 	subjectSha256      = "subject-sha256"
 	key                = "key"
@@ -46,6 +62,32 @@ const (
 	useArtifactoryKeys = "use-artifactory-keys"
 	sigstoreBundle     = "sigstore-bundle"
 	artifactsLimit     = "artifacts-limit"
+	since              = "since"
+	until              = "until"
+	policy             = "policy"
+
+	detachedSignatureOutput = "detached-signature-output"
+	force                   = "force"
+	skipKeyIdCheck          = "skip-key-id-check"
+	keyBase64               = "key-base64"
+	recursive               = "recursive"
+	tsaUrl                  = "tsa-url"
+	annotations             = "annotations"
+	createdAt               = "created-at"
+	quiet                   = "quiet"
+	errorFormat             = commonutils.ErrorFormatFlag
+	evidenceApiPath         = "evidence-api-path"
+	proxy                   = "proxy"
+	header                  = "header"
+	profile                 = "profile"
+	spec                    = "spec"
+	threads                 = "threads"
+	failFast                = "fail-fast"
+	maxAge                  = "max-age"
+	platformKey             = "platform-key"
+	printDigest             = "print-digest"
+	skipIdentical           = "skip-identical"
+	failOnExisting          = "fail-on-existing"
 )
 
 // Flag keys mapped to their corresponding components.Flag definition.
@@ -61,27 +103,58 @@ var flagsMap = map[string]components.Flag{
 
 	releaseBundle:        components.NewStringFlag(releaseBundle, "Release Bundle name.", func(f *components.StringFlag) { f.Mandatory = false }),
 	releaseBundleVersion: components.NewStringFlag(releaseBundleVersion, "Release Bundle version.", func(f *components.StringFlag) { f.Mandatory = false }),
+	promotionEnvironment: components.NewStringFlag(promotionEnvironment, "Environment to which the release bundle version was promoted. When provided, the evidence is bound to the latest completed promotion to this environment.", func(f *components.StringFlag) { f.Mandatory = false }),
+	buildInfoFile:        components.NewStringFlag(buildInfoFile, "Path to a local build-info JSON file. The build name, number and timestamp of the evidence subject are read from it, instead of being queried from the server.", func(f *components.StringFlag) { f.Mandatory = false }),
+	versionRange:         components.NewStringFlag(versionRange, "Comma separated constraints on the release bundle versions, each made of an operator (>=, <=, >, < or =) and a version, e.g. '>=1.0.0,<2.0.0'. If not provided, all the versions are included.", func(f *components.StringFlag) { f.Mandatory = false }),
+	releaseBundleRepo:    components.NewStringFlag(releaseBundleRepo, "Repository of the release bundle manifest. Use it when the release bundle is stored in a repository other than the one derived from --"+project+".", func(f *components.StringFlag) { f.Mandatory = false }),
 	buildName:            components.NewStringFlag(buildName, "Build name.", func(f *components.StringFlag) { f.Mandatory = false }),
 	buildNumber:          components.NewStringFlag(buildNumber, "Build number.", func(f *components.StringFlag) { f.Mandatory = false }),
 	packageName:          components.NewStringFlag(packageName, "Package name.", func(f *components.StringFlag) { f.Mandatory = false }),
 	packageVersion:       components.NewStringFlag(packageVersion, "Package version.", func(f *components.StringFlag) { f.Mandatory = false }),
 	packageRepoName:      components.NewStringFlag(packageRepoName, "Package repository Name.", func(f *components.StringFlag) { f.Mandatory = false }),
+	printDigest:          components.NewBoolFlag(printDigest, "[Default: false] Set to true to print the digest of the Docker or OCI image the evidence was created for to the standard output as JSON, along with the digest of each platform of a multi-architecture image, so the image can be referred to by its digest downstream.", components.WithBoolDefaultValueFalse()),
 	typeFlag:             components.NewStringFlag(typeFlag, "Type can contain 'gh-commiter' value.", func(f *components.StringFlag) { f.Mandatory = false }),
 
-	predicate:        components.NewStringFlag(predicate, "Path to the predicate, arbitrary JSON. Mandatory unless --"+sigstoreBundle+" is used", func(f *components.StringFlag) { f.Mandatory = false }),
+	predicate:        components.NewStringFlag(predicate, "Path or http(s) URL of the predicate, arbitrary JSON. Mandatory unless --"+sigstoreBundle+" is used", func(f *components.StringFlag) { f.Mandatory = false }),
+	predicateHeader:  components.NewStringFlag(predicateHeader, "Header sent when --"+predicate+" is a URL, in the 'Name: value' format, such as 'Authorization: Bearer <token>'.", func(f *components.StringFlag) { f.Mandatory = false }),
 	predicateType:    components.NewStringFlag(predicateType, "Type of the predicate. Mandatory unless --"+sigstoreBundle+" is used", func(f *components.StringFlag) { f.Mandatory = false }),
 	includePredicate: components.NewBoolFlag(includePredicate, "Include the predicate data in the get evidence output.", components.WithBoolDefaultValueFalse()),
 	markdown:         components.NewStringFlag(markdown, "Markdown of the predicate.", func(f *components.StringFlag) { f.Mandatory = false }),
+	markdownText:     components.NewStringFlag(markdownText, "Markdown of the predicate, provided as text. Can also be provided using the "+markdownTextEnv+" environment variable. Incompatible with --"+markdown+".", func(f *components.StringFlag) { f.Mandatory = false }),
 	subjectRepoPath:  components.NewStringFlag(subjectRepoPath, "Full path to some subject location.", func(f *components.StringFlag) { f.Mandatory = false }),
 	subjectSha256:    components.NewStringFlag(subjectSha256, "Subject checksum sha256.", func(f *components.StringFlag) { f.Mandatory = false }),
-	key:              components.NewStringFlag(key, "Path to a private key that will sign the DSSE. Supported keys: 'ecdsa','rsa' and 'ed25519'.", func(f *components.StringFlag) { f.Mandatory = false }),
-	keyAlias:         components.NewStringFlag(keyAlias, "Key alias", func(f *components.StringFlag) { f.Mandatory = false }),
+	key:              components.NewStringFlag(key, "Path to a private key that will sign the DSSE. To co-sign the DSSE, provide multiple keys separated by \";\". Supported keys: 'ecdsa','rsa' and 'ed25519'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	keyBase64:        components.NewBoolFlag(keyBase64, "[Default: false] Set to true if the value of --"+key+" is a base64 encoded key rather than a path or a PEM encoded key, as required by CI systems which only allow single-line secrets. Without it, such values are detected automatically.", components.WithBoolDefaultValueFalse()),
+	keyAlias:         components.NewStringFlag(keyAlias, "Key alias. When co-signing with multiple keys, provide a key alias for each key separated by \";\", in the order of the keys.", func(f *components.StringFlag) { f.Mandatory = false }),
+	platformKey:      components.NewStringFlag(platformKey, "Name or alias of a key pair in the JFrog Platform keystore, whose private key signs the DSSE instead of --"+key+". Requires a platform which returns the private keys of its key pairs. The key pair alias is used as --"+keyAlias+" when it isn't provided.", func(f *components.StringFlag) { f.Mandatory = false }),
 
-	providerId:         components.NewStringFlag(providerId, "Provider ID for the evidence.", func(f *components.StringFlag) { f.Mandatory = false }),
-	publicKeys:         components.NewStringFlag(publicKeys, "Array of paths to public keys for signatures verification with \";\" separator. Supported keys: 'ecdsa','rsa' and 'ed25519'.", func(f *components.StringFlag) { f.Mandatory = false }),
-	sigstoreBundle:     components.NewStringFlag(sigstoreBundle, "Path to a Sigstore bundle file with a pre-signed DSSE envelope. Incompatible with --"+key+", --"+keyAlias+", --"+predicate+", --"+predicateType+" and --"+subjectSha256+".", func(f *components.StringFlag) { f.Mandatory = false }),
-	useArtifactoryKeys: components.NewBoolFlag(useArtifactoryKeys, "Use Artifactory keys for verification. When enabled, the verify command retrieves keys from Artifactory.", components.WithBoolDefaultValueFalse()),
-	artifactsLimit:     components.NewStringFlag(artifactsLimit, "The number of artifacts in a release bundle to be included in the evidences file. The default value is 1000 artifacts", func(f *components.StringFlag) { f.Mandatory = false }),
+	providerId:              components.NewStringFlag(providerId, "Provider ID for the evidence.", func(f *components.StringFlag) { f.Mandatory = false }),
+	publicKeys:              components.NewStringFlag(publicKeys, "Array of paths to public keys for signatures verification with \";\" separator. Supported keys: 'ecdsa','rsa' and 'ed25519'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	sigstoreBundle:          components.NewStringFlag(sigstoreBundle, "Path to a Sigstore bundle file with a pre-signed DSSE envelope. Incompatible with --"+key+", --"+keyAlias+", --"+predicate+", --"+predicateType+" and --"+subjectSha256+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	maxAge:                  components.NewStringFlag(maxAge, "Fail the verification if the latest evidence of --"+predicateType+" was created longer ago than the given age, in days, e.g. '30d', or as a duration, e.g. '12h'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	useArtifactoryKeys:      components.NewBoolFlag(useArtifactoryKeys, "Use Artifactory keys for verification. When enabled, the verify command retrieves keys from Artifactory.", components.WithBoolDefaultValueFalse()),
+	detachedSignatureOutput: components.NewStringFlag(detachedSignatureOutput, "Path to a directory to which the DSSE signature ('evidence.sig') and the payload it was computed over ('evidence.payload') are written, in addition to uploading the evidence. Incompatible with --"+sigstoreBundle+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	skipIdentical:           components.NewBoolFlag(skipIdentical, "[Default: false] Set to true to skip the upload when an evidence with the same subject, predicate type and predicate already exists, such as when a pipeline is retried. Evidence with the same predicate type and a different predicate is handled as without it.", components.WithBoolDefaultValueFalse()),
+	failOnExisting:          components.NewBoolFlag(failOnExisting, "[Default: false] Set to true to fail when an evidence with the same predicate type already exists on the subject, instead of adding the new evidence next to it.", components.WithBoolDefaultValueFalse()),
+	force:                   components.NewBoolFlag(force, "Set to true to replace an existing evidence with the same predicate type on the subject. The new evidence is uploaded before the existing evidence is deleted.", components.WithBoolDefaultValueFalse()),
+	skipKeyIdCheck:          components.NewBoolFlag(skipKeyIdCheck, "Set to true to sign with a key alias in the form of a key ID which doesn't match the signing key, with a warning instead of failing.", components.WithBoolDefaultValueFalse()),
+	recursive:               components.NewBoolFlag(recursive, "[Default: false] Set to true to create evidence for every file under the folder when --"+subjectRepoPath+" is a folder, up to 1000 files. Incompatible with --"+subjectSha256+", --"+sigstoreBundle+" and --"+detachedSignatureOutput+".", components.WithBoolDefaultValueFalse()),
+	tsaUrl:                  components.NewStringFlag(tsaUrl, "URL of an RFC 3161 Time Stamping Authority. When provided, a timestamp over the evidence signature is obtained from it and attached to the evidence envelope. Incompatible with --"+sigstoreBundle+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	annotations:             components.NewStringFlag(annotations, "List of semicolon-separated(;) key=value pairs added to the evidence statement, e.g. 'ci.build.id=1234;git.commit=3f9a2c1'. Keys must not be empty. Incompatible with --"+sigstoreBundle+".", func(f *components.StringFlag) { f.Mandatory = false }),
+	createdAt:               components.NewStringFlag(createdAt, "Creation time of the evidence to delete, as displayed by 'jf evd get'. Required only when the subject has several evidence with the same predicate type.", func(f *components.StringFlag) { f.Mandatory = false }),
+	quiet:                   components.NewBoolFlag(quiet, "[Default: $CI] Set to true to skip the delete confirmation message.", components.WithBoolDefaultValueFalse()),
+	errorFormat:             commonutils.NewErrorFormatFlag(),
+	profile:                 components.NewStringFlag(profile, "Name of an evidence profile in the '"+evidenceProfilesFileName+"' file of the JFrog CLI home directory, supplying default values for --"+predicateType+", --"+key+" and --"+keyAlias+". Flags and environment variables override the profile values. Can also be provided using the "+evidenceProfileEnv+" environment variable.", func(f *components.StringFlag) { f.Mandatory = false }),
+	evidenceApiPath:         components.NewStringFlag(evidenceApiPath, "[Default: "+defaultEvidenceApiPath+"] Path of the evidence service relative to the platform URL, e.g. '"+defaultEvidenceApiPath+"/v2'. Use it to pin the path when the service isn't served at the default path. Can also be provided using the "+evidenceApiPathEnv+" environment variable.", func(f *components.StringFlag) { f.Mandatory = false }),
+	header:                  components.NewStringFlag(header, "Custom headers sent with the JFrog Platform requests of the command, in the 'Name=value' format, with \";\" separator, e.g. 'X-Request-Source=ci;X-Team=platform'. Headers used for authentication, such as 'Authorization', can't be set.", func(f *components.StringFlag) { f.Mandatory = false }),
+	proxy:                   components.NewStringFlag(proxy, "URL of a proxy through which the requests of the command to the JFrog Platform are sent, e.g. 'http://proxy.example.com:8080'. Supported schemes: 'http', 'https' and 'socks5'. Overrides the HTTPS_PROXY and HTTP_PROXY environment variables, which are honored when it isn't provided.", func(f *components.StringFlag) { f.Mandatory = false }),
+	since:                   components.NewStringFlag(since, "Only get evidence created at this time or later, as an RFC3339 timestamp, e.g. '2025-01-31T00:00:00Z'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	until:                   components.NewStringFlag(until, "Only get evidence created before this time, as an RFC3339 timestamp, e.g. '2025-02-28T00:00:00Z'.", func(f *components.StringFlag) { f.Mandatory = false }),
+	policy:                  components.NewStringFlag(policy, "Path to a JSON policy file listing the predicate types the subject's evidence must include, e.g. {\"requiredPredicateTypes\": [\"https://spdx.dev/Document\"]}. When set, the evidence isn't exported, and the command fails and reports the missing types if any are missing.", func(f *components.StringFlag) { f.Mandatory = false }),
+	spec:                    components.NewStringFlag(spec, "Path to a JSON spec file listing the subjects to create evidence for, each with its predicate, e.g. {\"subjects\": [{\"subjectRepoPath\": \"libs-local/app.jar\", \"predicate\": \"provenance.json\", \"predicateType\": \"https://slsa.dev/provenance/v1\"}]}. A subject is identified by subjectRepoPath, buildName and buildNumber, packageName, packageVersion and packageRepoName, or releaseBundle and releaseBundleVersion. A subject may set its own key and keyAlias, which override --key and --key-alias.", func(f *components.StringFlag) { f.Mandatory = false }),
+	threads:                 components.NewStringFlag(threads, "[Default: 3] Number of subjects for which evidence is created concurrently.", func(f *components.StringFlag) { f.Mandatory = false }),
+	failFast:                components.NewBoolFlag(failFast, "[Default: false] Set to true to stop starting the creation for more subjects once it fails for a subject. Without it, the creation continues for all the subjects and the failures are reported at the end.", components.WithBoolDefaultValueFalse()),
+	artifactsLimit:          components.NewStringFlag(artifactsLimit, "The number of artifacts in a release bundle to be included in the evidences file. The default value is 1000 artifacts", func(f *components.StringFlag) { f.Mandatory = false }),
 }
 
 var commandFlags = map[string][]string{
@@ -90,30 +163,53 @@ var commandFlags = map[string][]string{
 		user,
 		accessToken,
 		ServerId,
+		evidenceApiPath,
+		proxy,
+		header,
 		project,
 		releaseBundle,
 		releaseBundleVersion,
+		promotionEnvironment,
+		releaseBundleRepo,
 		buildName,
 		buildNumber,
+		buildInfoFile,
 		packageName,
 		packageVersion,
 		packageRepoName,
+		printDigest,
 		typeFlag,
 		predicate,
+		predicateHeader,
 		predicateType,
 		markdown,
+		markdownText,
 		subjectRepoPath,
 		subjectSha256,
 		key,
+		keyBase64,
 		keyAlias,
+		platformKey,
+		detachedSignatureOutput,
+		tsaUrl,
+		annotations,
+		force,
+		failOnExisting,
+		skipKeyIdCheck,
+		skipIdentical,
+		recursive,
 		providerId,
 		sigstoreBundle,
+		profile,
+		errorFormat,
 	},
 	VerifyEvidence: {
 		url,
 		user,
 		accessToken,
 		ServerId,
+		evidenceApiPath,
+		proxy,
 		publicKeys,
 		format,
 		project,
@@ -126,12 +222,17 @@ var commandFlags = map[string][]string{
 		packageVersion,
 		packageRepoName,
 		useArtifactoryKeys,
+		predicateType,
+		maxAge,
+		errorFormat,
 	},
 	GetEvidence: {
 		url,
 		user,
 		accessToken,
 		ServerId,
+		evidenceApiPath,
+		proxy,
 		format,
 		output,
 		project,
@@ -140,6 +241,145 @@ var commandFlags = map[string][]string{
 		subjectRepoPath,
 		includePredicate,
 		artifactsLimit,
+		since,
+		until,
+		policy,
+		errorFormat,
+	},
+	ResolveSubject: {
+		url,
+		user,
+		accessToken,
+		ServerId,
+		evidenceApiPath,
+		proxy,
+		format,
+		project,
+		releaseBundle,
+		releaseBundleVersion,
+		buildName,
+		buildNumber,
+		packageName,
+		packageVersion,
+		packageRepoName,
+		subjectRepoPath,
+		subjectSha256,
+		errorFormat,
+	},
+	DeleteEvidence: {
+		url,
+		user,
+		accessToken,
+		ServerId,
+		evidenceApiPath,
+		proxy,
+		predicateType,
+		createdAt,
+		quiet,
+		project,
+		releaseBundle,
+		releaseBundleVersion,
+		buildName,
+		buildNumber,
+		packageName,
+		packageVersion,
+		packageRepoName,
+		subjectRepoPath,
+		subjectSha256,
+		errorFormat,
+	},
+	BackfillEvidence: {
+		url,
+		user,
+		accessToken,
+		ServerId,
+		evidenceApiPath,
+		proxy,
+		project,
+		releaseBundle,
+		versionRange,
+		releaseBundleRepo,
+		predicate,
+		predicateHeader,
+		predicateType,
+		markdown,
+		markdownText,
+		key,
+		keyBase64,
+		keyAlias,
+		tsaUrl,
+		annotations,
+		profile,
+		errorFormat,
+	},
+	CreateBatch: {
+		url,
+		user,
+		accessToken,
+		ServerId,
+		evidenceApiPath,
+		proxy,
+		spec,
+		key,
+		keyBase64,
+		keyAlias,
+		tsaUrl,
+		providerId,
+		force,
+		failOnExisting,
+		skipKeyIdCheck,
+		skipIdentical,
+		threads,
+		failFast,
+		profile,
+		errorFormat,
+	},
+	GetEvidenceKeys: {
+		url,
+		user,
+		accessToken,
+		ServerId,
+		evidenceApiPath,
+		proxy,
+		project,
+		releaseBundle,
+		releaseBundleVersion,
+		keyAlias,
+		output,
+		errorFormat,
+	},
+	ExportEvidence: {
+		url,
+		user,
+		accessToken,
+		ServerId,
+		evidenceApiPath,
+		proxy,
+		subjectRepoPath,
+		output,
+		errorFormat,
+	},
+	VerifyEnvelope: {
+		publicKeys,
+		format,
+		errorFormat,
+	},
+	ValidateKey: {
+		key,
+		keyBase64,
+		keyAlias,
+		format,
+		errorFormat,
+	},
+	Preflight: {
+		url,
+		user,
+		accessToken,
+		ServerId,
+		evidenceApiPath,
+		proxy,
+		format,
+		errorFormat,
 	},
 }
 
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	jfrogArtClient "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreUtils "github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"golang.org/x/exp/maps"
+)
+
+// Environment variable that can be used instead of the --profile flag.
+const evidenceProfileEnv = "JFROG_CLI_EVIDENCE_PROFILE"
+
+// The file in the JFrog CLI home directory holding the evidence profiles, keyed by the profile name. For example:
+//
+//	{
+//	  "release": {
+//	    "predicateType": "https://slsa.dev/provenance/v1",
+//	    "key": "/path/to/private.key",
+//	    "keyAlias": "release-signing-key"
+//	  }
+//	}
+const evidenceProfilesFileName = "evidence-profiles.json"
+
+// evidenceProfile holds default values for the create-evidence flags.
+type evidenceProfile struct {
+	PredicateType string `json:"predicateType,omitempty"`
+	Key           string `json:"key,omitempty"`
+	KeyAlias      string `json:"keyAlias,omitempty"`
+}
+
+// applyEvidenceProfile sets the flags which weren't provided, either as flags or through their environment variables, to the
+// values of the evidence profile named by the --profile flag or the JFROG_CLI_EVIDENCE_PROFILE environment variable.
+func applyEvidenceProfile(ctx *components.Context) error {
+	profileName := ctx.GetStringFlagValue(profile)
+	if profileName == "" {
+		profileName, _ = jfrogArtClient.GetEnvVariable(evidenceProfileEnv)
+	}
+	if profileName == "" {
+		return nil
+	}
+	evdProfile, err := loadEvidenceProfile(profileName)
+	if err != nil {
+		return err
+	}
+	log.Debug("Using the evidence profile:", profileName)
+	setFlagFromProfile(ctx, predicateType, "", evdProfile.PredicateType)
+	setFlagFromProfile(ctx, key, coreUtils.SigningKey, evdProfile.Key)
+	setFlagFromProfile(ctx, keyAlias, coreUtils.KeyAlias, evdProfile.KeyAlias)
+	return nil
+}
+
+func setFlagFromProfile(ctx *components.Context, flag, envVar, profileValue string) {
+	if profileValue == "" || ctx.GetStringFlagValue(flag) != "" {
+		return
+	}
+	if envVar != "" {
+		if envValue, _ := jfrogArtClient.GetEnvVariable(envVar); envValue != "" {
+			return
+		}
+	}
+	ctx.AddStringFlag(flag, profileValue)
+}
+
+func loadEvidenceProfile(profileName string) (*evidenceProfile, error) {
+	homeDir, err := coreUtils.GetJfrogHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	profilesFilePath := filepath.Join(homeDir, evidenceProfilesFileName)
+	content, err := os.ReadFile(profilesFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errorutils.CheckErrorf("evidence profile '%s' was not found: the profiles file '%s' doesn't exist", profileName, profilesFilePath)
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	var profiles map[string]evidenceProfile
+	if err = json.Unmarshal(content, &profiles); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the evidence profiles file '%s': %s", profilesFilePath, err.Error())
+	}
+	evdProfile, ok := profiles[profileName]
+	if !ok {
+		profileNames := maps.Keys(profiles)
+		slices.Sort(profileNames)
+		return nil, errorutils.CheckErrorf("evidence profile '%s' was not found in '%s'. Available profiles: [%s]", profileName, profilesFilePath, strings.Join(profileNames, ", "))
+	}
+	return &evdProfile, nil
+}
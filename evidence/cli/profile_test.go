@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	coreUtils "github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func TestApplyEvidenceProfile(t *testing.T) {
+	homeDir := t.TempDir()
+	t.Setenv(coreUtils.HomeDir, homeDir)
+	profiles := `{
+  "release": {"predicateType": "https://slsa.dev/provenance/v1", "key": "/keys/release.key", "keyAlias": "release-key"},
+  "test": {"predicateType": "https://in-toto.io/attestation/test-result/v0.1"}
+}`
+	require.NoError(t, os.WriteFile(filepath.Join(homeDir, evidenceProfilesFileName), []byte(profiles), 0600))
+
+	app := cli.NewApp()
+	app.Commands = []cli.Command{
+		{
+			Name: "create",
+		},
+	}
+	set := flag.NewFlagSet("create", 0)
+	ctx := cli.NewContext(app, set, nil)
+
+	tests := []struct {
+		name          string
+		flags         []components.Flag
+		env           map[string]string
+		expected      map[string]string
+		errorContains string
+	}{
+		{
+			name:     "No_Profile",
+			expected: map[string]string{predicateType: "", key: "", keyAlias: ""},
+		},
+		{
+			name:     "Profile_Flag",
+			flags:    []components.Flag{setDefaultValue(profile, "release")},
+			expected: map[string]string{predicateType: "https://slsa.dev/provenance/v1", key: "/keys/release.key", keyAlias: "release-key"},
+		},
+		{
+			name:     "Profile_Environment_Variable",
+			env:      map[string]string{evidenceProfileEnv: "test"},
+			expected: map[string]string{predicateType: "https://in-toto.io/attestation/test-result/v0.1", key: "", keyAlias: ""},
+		},
+		{
+			name: "Flags_And_Environment_Variables_Override_Profile",
+			flags: []components.Flag{
+				setDefaultValue(profile, "release"),
+				setDefaultValue(predicateType, "https://slsa.dev/provenance/v0.2"),
+			},
+			env:      map[string]string{coreUtils.SigningKey: "env-key"},
+			expected: map[string]string{predicateType: "https://slsa.dev/provenance/v0.2", key: "", keyAlias: "release-key"},
+		},
+		{
+			name:          "Missing_Profile",
+			flags:         []components.Flag{setDefaultValue(profile, "nightly")},
+			errorContains: "evidence profile 'nightly' was not found in '" + filepath.Join(homeDir, evidenceProfilesFileName) + "'. Available profiles: [release, test]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for envVar, value := range tt.env {
+				t.Setenv(envVar, value)
+			}
+			context, err := components.ConvertContext(ctx, tt.flags...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = applyEvidenceProfile(context)
+
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			assert.NoError(t, err)
+			for flagName, expectedValue := range tt.expected {
+				assert.Equal(t, expectedValue, context.GetStringFlagValue(flagName), flagName)
+			}
+		})
+	}
+}
+
+func TestApplyEvidenceProfile_MissingProfilesFile(t *testing.T) {
+	t.Setenv(coreUtils.HomeDir, t.TempDir())
+	t.Setenv(evidenceProfileEnv, "release")
+	context := &components.Context{}
+
+	err := applyEvidenceProfile(context)
+
+	assert.ErrorContains(t, err, "evidence profile 'release' was not found: the profiles file")
+}
@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/create"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 )
 
 type execCommandFunc func(command commands.Command) error
@@ -17,3 +20,41 @@ var subjectTypes = []string{
 	packageName,
 	typeFlag,
 }
+
+// getCreateEvidenceOptions returns the evidence settings of the flags shared by the commands creating evidence.
+func getCreateEvidenceOptions(ctx *components.Context) create.CreateEvidenceOptions {
+	return create.CreateEvidenceOptions{
+		PredicateFilePath:    ctx.GetStringFlagValue(predicate),
+		PredicateHeader:      ctx.GetStringFlagValue(predicateHeader),
+		PredicateType:        ctx.GetStringFlagValue(predicateType),
+		MarkdownFilePath:     ctx.GetStringFlagValue(markdown),
+		MarkdownText:         ctx.GetStringFlagValue(markdownText),
+		Keys:                 getStringsArrFlagValueIfProvided(ctx, key),
+		KeyIds:               getStringsArrFlagValueIfProvided(ctx, keyAlias),
+		DetachedSignatureDir: ctx.GetStringFlagValue(detachedSignatureOutput),
+		TsaUrl:               ctx.GetStringFlagValue(tsaUrl),
+		Annotations:          ctx.GetStringFlagValue(annotations),
+		ProviderId:           ctx.GetStringFlagValue(providerId),
+		Force:                ctx.GetBoolFlagValue(force),
+		FailOnExisting:       ctx.GetBoolFlagValue(failOnExisting),
+		SkipIdentical:        ctx.GetBoolFlagValue(skipIdentical),
+		SkipKeyIdCheck:       ctx.GetBoolFlagValue(skipKeyIdCheck),
+		ClientOptions:        getClientOptions(ctx),
+	}
+}
+
+// getClientOptions returns the settings of the clients the requests of the command are sent with.
+func getClientOptions(ctx *components.Context) evdUtils.ClientOptions {
+	return evdUtils.ClientOptions{
+		ProxyUrl: ctx.GetStringFlagValue(proxy),
+		Headers:  getStringsArrFlagValueIfProvided(ctx, header),
+	}
+}
+
+// getStringsArrFlagValueIfProvided returns the ';' separated values of the flag, or nil when it's unset or empty.
+func getStringsArrFlagValueIfProvided(ctx *components.Context, flagName string) []string {
+	if ctx.GetStringFlagValue(flagName) == "" {
+		return nil
+	}
+	return ctx.GetStringsArrFlagValue(flagName)
+}
@@ -0,0 +1,57 @@
+package create
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	annotationsSeparator     = ";"
+	maxAnnotations           = 50
+	maxAnnotationKeyLength   = 128
+	maxAnnotationValueLength = 1024
+)
+
+func (c *createEvidenceBase) setAnnotations(statement *intoto.Statement) error {
+	annotations, err := parseAnnotations(c.annotations)
+	if err != nil {
+		return err
+	}
+	statement.SetAnnotations(annotations)
+	return nil
+}
+
+// parseAnnotations parses semicolon separated key=value pairs, e.g. "ci.build.id=1234;git.commit=3f9a2c1".
+func parseAnnotations(annotations string) (map[string]string, error) {
+	if strings.TrimSpace(annotations) == "" {
+		return nil, nil
+	}
+	pairs := strings.Split(annotations, annotationsSeparator)
+	if len(pairs) > maxAnnotations {
+		return nil, errorutils.CheckErrorf("%d annotations were provided, while at most %d are allowed", len(pairs), maxAnnotations)
+	}
+	parsed := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !found {
+			return nil, errorutils.CheckErrorf("invalid annotation '%s', expected the format key=value", pair)
+		}
+		if key == "" {
+			return nil, errorutils.CheckErrorf("invalid annotation '%s', the key must not be empty", pair)
+		}
+		if len(key) > maxAnnotationKeyLength {
+			return nil, errorutils.CheckErrorf("the key of annotation '%s' exceeds the maximal length of %d characters", key, maxAnnotationKeyLength)
+		}
+		if len(value) > maxAnnotationValueLength {
+			return nil, errorutils.CheckErrorf("the value of annotation '%s' exceeds the maximal length of %d characters", key, maxAnnotationValueLength)
+		}
+		if _, exists := parsed[key]; exists {
+			return nil, errorutils.CheckErrorf("annotation '%s' is provided more than once", key)
+		}
+		parsed[key] = value
+	}
+	return parsed, nil
+}
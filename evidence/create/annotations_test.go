@@ -0,0 +1,42 @@
+package create
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAnnotations(t *testing.T) {
+	tests := []struct {
+		name          string
+		annotations   string
+		expected      map[string]string
+		expectedError string
+	}{
+		{name: "empty", annotations: "", expected: nil},
+		{
+			name:        "multiple",
+			annotations: "ci.build.id=1234; git.commit=3f9a2c1;url=https://ci.example.com/job?id=1",
+			expected:    map[string]string{"ci.build.id": "1234", "git.commit": "3f9a2c1", "url": "https://ci.example.com/job?id=1"},
+		},
+		{name: "empty value", annotations: "reviewed=", expected: map[string]string{"reviewed": ""}},
+		{name: "missing separator", annotations: "ci.build.id", expectedError: "expected the format key=value"},
+		{name: "empty key", annotations: " =1234", expectedError: "the key must not be empty"},
+		{name: "duplicate key", annotations: "a=1;a=2", expectedError: "annotation 'a' is provided more than once"},
+		{name: "long key", annotations: strings.Repeat("k", maxAnnotationKeyLength+1) + "=1", expectedError: "exceeds the maximal length of 128 characters"},
+		{name: "long value", annotations: "a=" + strings.Repeat("v", maxAnnotationValueLength+1), expectedError: "the value of annotation 'a' exceeds the maximal length"},
+		{name: "too many", annotations: strings.Repeat("a=1;", maxAnnotations) + "b=2", expectedError: "at most 50 are allowed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotations, err := parseAnnotations(tt.annotations)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, annotations)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package create
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/gofrog/version"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	servicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The operators of version range constraints. Two-character operators are listed first, so they are matched before
+// their single-character prefixes.
+var versionRangeOperators = []string{">=", "<=", ">", "<", "="}
+
+type versionConstraint struct {
+	operator string
+	version  string
+}
+
+// VersionRange is a list of constraints a version must satisfy, such as '>=1.0.0,<2.0.0'. An empty range contains
+// every version.
+type VersionRange []versionConstraint
+
+// ParseVersionRange parses comma separated constraints, each made of an operator (>=, <=, >, < or =) and a version.
+func ParseVersionRange(rangeExpr string) (VersionRange, error) {
+	var versionRange VersionRange
+	if strings.TrimSpace(rangeExpr) == "" {
+		return versionRange, nil
+	}
+	for _, constraintExpr := range strings.Split(rangeExpr, ",") {
+		constraintExpr = strings.TrimSpace(constraintExpr)
+		constraint, err := parseVersionConstraint(constraintExpr)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("invalid version range '%s': %s", rangeExpr, err.Error())
+		}
+		versionRange = append(versionRange, constraint)
+	}
+	return versionRange, nil
+}
+
+func parseVersionConstraint(constraintExpr string) (versionConstraint, error) {
+	for _, operator := range versionRangeOperators {
+		if constraintVersion, found := strings.CutPrefix(constraintExpr, operator); found {
+			constraintVersion = strings.TrimSpace(constraintVersion)
+			if constraintVersion == "" {
+				return versionConstraint{}, fmt.Errorf("constraint '%s' has no version", constraintExpr)
+			}
+			return versionConstraint{operator: operator, version: constraintVersion}, nil
+		}
+	}
+	return versionConstraint{}, fmt.Errorf("constraint '%s' must start with one of: %s", constraintExpr, strings.Join(versionRangeOperators, ", "))
+}
+
+// Contains returns whether the version satisfies all the constraints of the range.
+func (vr VersionRange) Contains(rbVersion string) bool {
+	for _, constraint := range vr {
+		comparison := compareVersions(rbVersion, constraint.version)
+		satisfied := false
+		switch constraint.operator {
+		case ">=":
+			satisfied = comparison >= 0
+		case "<=":
+			satisfied = comparison <= 0
+		case ">":
+			satisfied = comparison > 0
+		case "<":
+			satisfied = comparison < 0
+		case "=":
+			satisfied = comparison == 0
+		}
+		if !satisfied {
+			return false
+		}
+	}
+	return true
+}
+
+// compareVersions returns a negative number if first is lower than second, zero if they are equal and a positive number
+// otherwise.
+func compareVersions(first, second string) int {
+	return -version.NewVersion(first).Compare(second)
+}
+
+type releaseBundleVersion struct {
+	name    string
+	version string
+}
+
+type backfillEvidenceReleaseBundles struct {
+	createEvidenceBase
+	project string
+	// Filters the release bundles by name. May contain '*' wildcards, and matches every release bundle when empty.
+	releaseBundle string
+	versionRange  VersionRange
+	// When set, overrides the repository derived from the project, for release bundles stored in a non-standard repository.
+	releaseBundleRepo string
+}
+
+// NewBackfillEvidenceReleaseBundles creates the predicate as evidence for every existing release bundle version which
+// matches the filters and has no evidence with the predicate type yet.
+func NewBackfillEvidenceReleaseBundles(serverDetails *config.ServerDetails, options CreateEvidenceOptions, project, releaseBundle string,
+	versionRange VersionRange, releaseBundleRepo string) evidence.Command {
+	return &backfillEvidenceReleaseBundles{
+		createEvidenceBase: newCreateEvidenceBase(serverDetails, options),
+		project:            project,
+		releaseBundle:      releaseBundle,
+		versionRange:       versionRange,
+		releaseBundleRepo:  releaseBundleRepo,
+	}
+}
+
+func (b *backfillEvidenceReleaseBundles) CommandName() string {
+	return "backfill-release-bundle-evidence"
+}
+
+func (b *backfillEvidenceReleaseBundles) ServerDetails() (*config.ServerDetails, error) {
+	return b.serverDetails, nil
+}
+
+// Run creates the evidence for each release bundle version in turn. A failure for a version doesn't stop the backfill
+// of the other versions, and all the failures are reported at the end.
+func (b *backfillEvidenceReleaseBundles) Run() error {
+	if _, err := path.Match(b.releaseBundle, ""); err != nil {
+		return errorutils.CheckErrorf("invalid release bundle name pattern '%s': %s", b.releaseBundle, err.Error())
+	}
+	artifactoryClient, err := b.createArtifactoryClient()
+	if err != nil {
+		return err
+	}
+	releaseBundles, err := b.listReleaseBundleVersions(artifactoryClient)
+	if err != nil {
+		return err
+	}
+	if len(releaseBundles) == 0 {
+		clientlog.Info("No release bundle versions match the provided filters")
+		return nil
+	}
+	onemodelClient, err := evdUtils.CreateOnemodelServiceManager(b.serverDetails, b.clientOptions)
+	if err != nil {
+		return err
+	}
+	clientlog.Info(fmt.Sprintf("Backfilling '%s' evidence for %d release bundle versions", b.predicateType, len(releaseBundles)))
+	created, skipped := 0, 0
+	var failed []string
+	for _, rb := range releaseBundles {
+		rbName := rb.name + ":" + rb.version
+		isCreated, err := b.backfillReleaseBundle(artifactoryClient, onemodelClient, rb)
+		switch {
+		case err != nil:
+			clientlog.Error(fmt.Sprintf("Failed to create evidence for release bundle %s: %s", rbName, err.Error()))
+			failed = append(failed, rbName)
+		case isCreated:
+			created++
+		default:
+			clientlog.Info(fmt.Sprintf("Skipping release bundle %s, which already has '%s' evidence", rbName, b.predicateType))
+			skipped++
+		}
+	}
+	clientlog.Info(fmt.Sprintf("Backfill completed: %d created, %d skipped, %d failed", created, skipped, len(failed)))
+	if len(failed) > 0 {
+		return errorutils.CheckErrorf("failed to create evidence for %d of %d release bundle versions:\n%s", len(failed), len(releaseBundles), strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+func (b *backfillEvidenceReleaseBundles) listReleaseBundleVersions(artifactoryClient artifactory.ArtifactoryServicesManager) ([]releaseBundleVersion, error) {
+	repoKey, err := b.newReleaseBundleCommand(releaseBundleVersion{}).getReleaseBundleRepoKey(artifactoryClient)
+	if err != nil {
+		return nil, err
+	}
+	fileList, err := artifactoryClient.FileList(repoKey, servicesUtils.FileListParams{Deep: true})
+	if err != nil {
+		return nil, err
+	}
+	return filterReleaseBundleVersions(fileList.Files, b.releaseBundle, b.versionRange), nil
+}
+
+// backfillReleaseBundle creates the evidence for the release bundle version, unless it already has evidence with the
+// predicate type. Returns whether the evidence was created.
+func (b *backfillEvidenceReleaseBundles) backfillReleaseBundle(artifactoryClient artifactory.ArtifactoryServicesManager, onemodelClient onemodel.Manager, rb releaseBundleVersion) (bool, error) {
+	rbCommand := b.newReleaseBundleCommand(rb)
+	subject, sha256, err := rbCommand.buildReleaseBundleSubjectPath(artifactoryClient)
+	if err != nil {
+		return false, err
+	}
+	existingEvidence, err := findExistingEvidence(onemodelClient, subject, b.predicateType)
+	if err != nil {
+		return false, err
+	}
+	if len(existingEvidence) > 0 {
+		return false, nil
+	}
+	clientlog.Info("Creating DSSE envelope for subject:", subject)
+	rbCommand.stage = getReleaseBundleStage(b.serverDetails, b.clientOptions, rb.name, rb.version, b.project)
+	envelope, err := rbCommand.createEnvelope(subject, sha256)
+	if err != nil {
+		return false, err
+	}
+	return true, rbCommand.uploadEvidence(envelope, subject)
+}
+
+func (b *backfillEvidenceReleaseBundles) newReleaseBundleCommand(rb releaseBundleVersion) *createEvidenceReleaseBundle {
+	return &createEvidenceReleaseBundle{
+		createEvidenceBase:   b.createEvidenceBase,
+		project:              b.project,
+		releaseBundle:        rb.name,
+		releaseBundleVersion: rb.version,
+		releaseBundleRepo:    b.releaseBundleRepo,
+	}
+}
+
+// filterReleaseBundleVersions returns the release bundle versions whose manifests are in a deep file list of the release
+// bundles repository, and which match the name pattern and the version range. The versions are sorted by name, and
+// then by version.
+func filterReleaseBundleVersions(files []servicesUtils.FileListFile, namePattern string, versionRange VersionRange) []releaseBundleVersion {
+	var releaseBundles []releaseBundleVersion
+	for _, file := range files {
+		// The manifest of a release bundle version is stored at '/<name>/<version>/release-bundle.json.evd'.
+		segments := strings.Split(strings.Trim(file.Uri, "/"), "/")
+		if file.Folder || len(segments) != 3 || segments[2] != releaseBundleManifestName {
+			continue
+		}
+		rb := releaseBundleVersion{name: segments[0], version: segments[1]}
+		if namePattern != "" {
+			if matched, _ := path.Match(namePattern, rb.name); !matched {
+				continue
+			}
+		}
+		if versionRange.Contains(rb.version) {
+			releaseBundles = append(releaseBundles, rb)
+		}
+	}
+	sort.SliceStable(releaseBundles, func(i, j int) bool {
+		if releaseBundles[i].name != releaseBundles[j].name {
+			return releaseBundles[i].name < releaseBundles[j].name
+		}
+		return compareVersions(releaseBundles[i].version, releaseBundles[j].version) < 0
+	})
+	return releaseBundles
+}
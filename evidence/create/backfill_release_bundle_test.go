@@ -0,0 +1,156 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersionRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		rangeExpr     string
+		contained     []string
+		notContained  []string
+		errorContains string
+	}{
+		{
+			name:      "Empty range",
+			contained: []string{"0.1", "1.0.0", "10.2.3"},
+		},
+		{
+			name:         "Bounded range",
+			rangeExpr:    ">=1.0.0, <2.0.0",
+			contained:    []string{"1.0.0", "1.2", "1.10.1"},
+			notContained: []string{"0.9.9", "2.0.0", "2.1"},
+		},
+		{
+			name:         "Exclusive lower bound",
+			rangeExpr:    ">1.0",
+			contained:    []string{"1.0.1", "1.10"},
+			notContained: []string{"1.0", "0.9"},
+		},
+		{
+			name:         "Upper bound",
+			rangeExpr:    "<=1.5",
+			contained:    []string{"1.5", "1.4.9"},
+			notContained: []string{"1.5.1", "2"},
+		},
+		{
+			name:         "Exact version",
+			rangeExpr:    "=1.2.3",
+			contained:    []string{"1.2.3"},
+			notContained: []string{"1.2.4"},
+		},
+		{
+			name:          "Missing operator",
+			rangeExpr:     ">=1.0.0,2.0.0",
+			errorContains: "constraint '2.0.0' must start with one of: >=, <=, >, <, =",
+		},
+		{
+			name:          "Missing version",
+			rangeExpr:     ">=",
+			errorContains: "constraint '>=' has no version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versionRange, err := ParseVersionRange(tt.rangeExpr)
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			for _, rbVersion := range tt.contained {
+				assert.True(t, versionRange.Contains(rbVersion), rbVersion)
+			}
+			for _, rbVersion := range tt.notContained {
+				assert.False(t, versionRange.Contains(rbVersion), rbVersion)
+			}
+		})
+	}
+}
+
+func TestFilterReleaseBundleVersions(t *testing.T) {
+	files := []utils.FileListFile{
+		{Uri: "/app", Folder: true},
+		{Uri: "/app/1.10.0", Folder: true},
+		{Uri: "/app/1.10.0/release-bundle.json.evd"},
+		{Uri: "/app/1.2.0/release-bundle.json.evd"},
+		{Uri: "/app/2.0.0/release-bundle.json.evd"},
+		{Uri: "/app/1.2.0/other.json"},
+		{Uri: "/app-ui/1.0.0/release-bundle.json.evd"},
+		{Uri: "/lib/1.5.0/release-bundle.json.evd"},
+		{Uri: "/.evidence/app/1.2.0/release-bundle.json.evd"},
+	}
+	versionRange, err := ParseVersionRange("<2.0.0")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		namePattern      string
+		versionRange     VersionRange
+		expectedVersions []releaseBundleVersion
+	}{
+		{
+			name: "All release bundles",
+			expectedVersions: []releaseBundleVersion{
+				{name: "app", version: "1.2.0"},
+				{name: "app", version: "1.10.0"},
+				{name: "app", version: "2.0.0"},
+				{name: "app-ui", version: "1.0.0"},
+				{name: "lib", version: "1.5.0"},
+			},
+		},
+		{
+			name:        "Exact name",
+			namePattern: "app",
+			expectedVersions: []releaseBundleVersion{
+				{name: "app", version: "1.2.0"},
+				{name: "app", version: "1.10.0"},
+				{name: "app", version: "2.0.0"},
+			},
+		},
+		{
+			name:         "Name pattern and version range",
+			namePattern:  "app*",
+			versionRange: versionRange,
+			expectedVersions: []releaseBundleVersion{
+				{name: "app", version: "1.2.0"},
+				{name: "app", version: "1.10.0"},
+				{name: "app-ui", version: "1.0.0"},
+			},
+		},
+		{
+			name:        "No match",
+			namePattern: "web",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedVersions, filterReleaseBundleVersions(files, tt.namePattern, tt.versionRange))
+		})
+	}
+}
+
+func TestBackfillReleaseBundle_SkipsExistingEvidence(t *testing.T) {
+	backfillCommand := &backfillEvidenceReleaseBundles{
+		createEvidenceBase: createEvidenceBase{
+			serverDetails: &config.ServerDetails{Url: "http://test.com"},
+			predicateType: "https://slsa.dev/provenance/v1",
+		},
+		project: "myproj",
+	}
+	onemodelClient := &mockOnemodelManager{response: []byte(subjectEvidenceResponseJson)}
+
+	isCreated, err := backfillCommand.backfillReleaseBundle(&mockReleaseBundleArtifactoryServicesManager{}, onemodelClient, releaseBundleVersion{name: "app", version: "1.0.0"})
+
+	assert.NoError(t, err)
+	assert.False(t, isCreated)
+	assert.Equal(t, map[string]string{"repositoryKey": "myproj-release-bundles-v2", "path": "app/1.0.0", "name": "release-bundle.json.evd"}, onemodelClient.getRequest(t).Variables)
+}
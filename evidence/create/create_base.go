@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/sign"
 
@@ -14,26 +16,111 @@ import (
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	evidenceService "github.com/jfrog/jfrog-client-go/evidence/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type createEvidenceBase struct {
 	serverDetails     *config.ServerDetails
 	predicateFilePath string
-	predicateType     string
-	markdownFilePath  string
-	key               string
-	keyId             string
-	providerId        string
-	stage             string
-	flagType          FlagType
+	// A "Name: value" header sent when the predicate is fetched from a URL, such as an authorization header.
+	predicateHeader  string
+	predicateType    string
+	markdownFilePath string
+	markdownText     string
+	// The keys signing the envelope, and their optional key aliases, paired by position.
+	keys   []string
+	keyIds []string
+	// When set, the signature and the payload it was computed over are also written to this directory.
+	detachedSignatureDir string
+	// When set, an RFC 3161 timestamp over the signature is obtained from this TSA and attached to the envelope.
+	tsaUrl string
+	// Semicolon separated key=value pairs, added to the statement as annotations.
+	annotations string
+	providerId  string
+	stage       string
+	// When set, the evidence is bound to this release bundle promotion.
+	promotion *intoto.Promotion
+	// When set, an existing evidence with the same predicate type on the subject is replaced by the new evidence.
+	force bool
+	// When set, creating an evidence fails if an evidence with the same predicate type already exists on the subject.
+	failOnExisting bool
+	// When set, the upload is skipped if an evidence with the same subject, predicate type and predicate already exists.
+	skipIdentical bool
+	// When set, a key alias in the form of a key ID which doesn't match the signing key is only warned about.
+	skipKeyIdCheck bool
+	// The existing evidence with the predicate type of each subject, when looked up for all the subjects of the run at once.
+	existingEvidence map[string][]subjectEvidence
+	// The settings of the clients the requests of the command are sent with.
+	clientOptions evdUtils.ClientOptions
+	flagType      FlagType
 }
 
-const EvdDefaultUser = "JFrog CLI"
+// CreateEvidenceOptions holds the settings shared by the commands creating evidence, whatever the subject.
+type CreateEvidenceOptions struct {
+	PredicateFilePath string
+	// A "Name: value" header sent when the predicate is fetched from a URL, such as an authorization header.
+	PredicateHeader  string
+	PredicateType    string
+	MarkdownFilePath string
+	MarkdownText     string
+	// The keys signing the envelope, co-signing it when there are several.
+	Keys []string
+	// The key aliases of the keys, paired with the keys by position. Optional.
+	KeyIds []string
+	// When set, the signature and the payload it was computed over are also written to this directory.
+	DetachedSignatureDir string
+	// When set, an RFC 3161 timestamp over the signature is obtained from this TSA and attached to the envelope.
+	TsaUrl string
+	// Semicolon separated key=value pairs, added to the statement as annotations.
+	Annotations string
+	ProviderId  string
+	// When set, an existing evidence with the same predicate type on the subject is replaced by the new evidence.
+	Force bool
+	// When set, creating an evidence fails if an evidence with the same predicate type already exists on the subject.
+	FailOnExisting bool
+	// When set, the upload is skipped if an evidence with the same subject, predicate type and predicate already exists.
+	SkipIdentical bool
+	// When set, a key alias in the form of a key ID which doesn't match the signing key is only warned about.
+	SkipKeyIdCheck bool
+	// The settings of the clients the requests of the command are sent with.
+	ClientOptions evdUtils.ClientOptions
+}
+
+func newCreateEvidenceBase(serverDetails *config.ServerDetails, options CreateEvidenceOptions) createEvidenceBase {
+	return createEvidenceBase{
+		serverDetails:        serverDetails,
+		predicateFilePath:    options.PredicateFilePath,
+		predicateHeader:      options.PredicateHeader,
+		predicateType:        options.PredicateType,
+		markdownFilePath:     options.MarkdownFilePath,
+		markdownText:         options.MarkdownText,
+		keys:                 options.Keys,
+		keyIds:               options.KeyIds,
+		detachedSignatureDir: options.DetachedSignatureDir,
+		tsaUrl:               options.TsaUrl,
+		annotations:          options.Annotations,
+		providerId:           options.ProviderId,
+		force:                options.Force,
+		failOnExisting:       options.FailOnExisting,
+		skipIdentical:        options.SkipIdentical,
+		skipKeyIdCheck:       options.SkipKeyIdCheck,
+		clientOptions:        options.ClientOptions,
+	}
+}
+
+// A key ID derived from a key is the hex encoded sha256 of the key.
+var derivedKeyIdRegexp = regexp.MustCompile("^[0-9a-fA-F]{64}$")
+
+const (
+	EvdDefaultUser = "JFrog CLI"
+	// The maximal size of the markdown attached to an evidence, either read from a file or provided as text.
+	maxMarkdownSize = 1024 * 1024
+)
 
 func (c *createEvidenceBase) createEnvelope(subject, subjectSha256 string) ([]byte, error) {
 	statementJson, err := c.buildIntotoStatementJson(subject, subjectSha256)
@@ -41,10 +128,16 @@ func (c *createEvidenceBase) createEnvelope(subject, subjectSha256 string) ([]by
 		return nil, err
 	}
 
-	signedEnvelope, err := createAndSignEnvelope(statementJson, c.key, c.keyId)
+	signedEnvelope, err := createAndSignEnvelope(statementJson, c.keys, c.keyIds, c.skipKeyIdCheck)
 	if err != nil {
 		return nil, err
 	}
+	if err = c.writeDetachedSignature(signedEnvelope); err != nil {
+		return nil, err
+	}
+	if err = c.timestampEnvelope(signedEnvelope); err != nil {
+		return nil, err
+	}
 
 	envelopeBytes, err := json.Marshal(signedEnvelope)
 	if err != nil {
@@ -61,10 +154,16 @@ func (c *createEvidenceBase) createEnvelopeWithPredicateAndPredicateType(subject
 		return nil, err
 	}
 
-	signedEnvelope, err := createAndSignEnvelope(statementJson, c.key, c.keyId)
+	signedEnvelope, err := createAndSignEnvelope(statementJson, c.keys, c.keyIds, c.skipKeyIdCheck)
 	if err != nil {
 		return nil, err
 	}
+	if err = c.writeDetachedSignature(signedEnvelope); err != nil {
+		return nil, err
+	}
+	if err = c.timestampEnvelope(signedEnvelope); err != nil {
+		return nil, err
+	}
 
 	// Encode signedEnvelope into a byte slice
 	envelopeBytes, err := json.Marshal(signedEnvelope)
@@ -75,9 +174,8 @@ func (c *createEvidenceBase) createEnvelopeWithPredicateAndPredicateType(subject
 }
 
 func (c *createEvidenceBase) buildIntotoStatementJson(subject, subjectSha256 string) ([]byte, error) {
-	predicate, err := os.ReadFile(c.predicateFilePath)
+	predicate, err := c.readPredicate()
 	if err != nil {
-		log.Warn(fmt.Sprintf("failed to read predicate file '%s'", predicate))
 		return nil, err
 	}
 
@@ -96,12 +194,16 @@ func (c *createEvidenceBase) buildIntotoStatementJson(subject, subjectSha256 str
 	if err != nil {
 		return nil, err
 	}
+	if err = c.setAnnotations(statement); err != nil {
+		return nil, err
+	}
 
 	err = statement.SetSubject(artifactoryClient, subject, subjectSha256)
 	if err != nil {
 		return nil, err
 	}
 	statement.SetStage(c.stage)
+	statement.SetPromotion(c.promotion)
 	statementJson, err := statement.Marshal()
 	if err != nil {
 		log.Error("failed marshaling statement json file", err)
@@ -121,6 +223,9 @@ func (c *createEvidenceBase) buildIntotoStatementJsonWithPredicateAndPredicateTy
 	if err != nil {
 		return nil, err
 	}
+	if err = c.setAnnotations(statement); err != nil {
+		return nil, err
+	}
 
 	err = statement.SetSubject(artifactoryClient, subject, subjectSha256)
 	if err != nil {
@@ -135,22 +240,55 @@ func (c *createEvidenceBase) buildIntotoStatementJsonWithPredicateAndPredicateTy
 }
 
 func (c *createEvidenceBase) setMarkdown(statement *intoto.Statement) error {
-	if c.markdownFilePath != "" {
+	if c.markdownFilePath != "" && c.markdownText != "" {
+		return errorutils.CheckErrorf("markdown can be provided either as a file or as text, but not both")
+	}
+	var markdown []byte
+	switch {
+	case c.markdownFilePath != "":
 		if !strings.HasSuffix(c.markdownFilePath, ".md") {
 			return fmt.Errorf("file '%s' does not have a .md extension", c.markdownFilePath)
 		}
-		markdown, err := os.ReadFile(c.markdownFilePath)
+		var err error
+		markdown, err = os.ReadFile(c.markdownFilePath)
 		if err != nil {
 			log.Warn(fmt.Sprintf("failed to read markdown file '%s'", c.markdownFilePath))
 			return err
 		}
-		statement.SetMarkdown(markdown)
+	case c.markdownText != "":
+		markdown = []byte(c.markdownText)
+	default:
+		return nil
+	}
+	if err := validateMarkdown(markdown); err != nil {
+		return err
+	}
+	statement.SetMarkdown(markdown)
+	return nil
+}
+
+func validateMarkdown(markdown []byte) error {
+	if len(markdown) > maxMarkdownSize {
+		return errorutils.CheckErrorf("markdown size %d bytes exceeds the maximal allowed size of %d bytes", len(markdown), maxMarkdownSize)
+	}
+	if !utf8.Valid(markdown) {
+		return errorutils.CheckErrorf("markdown must be a valid UTF-8 text")
 	}
 	return nil
 }
 
 func (c *createEvidenceBase) uploadEvidence(evidencePayload []byte, repoPath string) error {
-	evidenceManager, err := utils.CreateEvidenceServiceManager(c.serverDetails, false)
+	supersededEvidence, alreadyPresent, err := c.handleExistingEvidence(repoPath, evidencePayload)
+	if err != nil || alreadyPresent {
+		return err
+	}
+	return c.supersedeEvidence(supersededEvidence, func() error {
+		return c.sendEvidence(evidencePayload, repoPath)
+	})
+}
+
+func (c *createEvidenceBase) sendEvidence(evidencePayload []byte, repoPath string) error {
+	evidenceManager, err := evdUtils.CreateEvidenceServiceManager(c.serverDetails, c.clientOptions)
 	if err != nil {
 		return err
 	}
@@ -181,7 +319,7 @@ func (c *createEvidenceBase) uploadEvidence(evidencePayload []byte, repoPath str
 }
 
 func (c *createEvidenceBase) createArtifactoryClient() (artifactory.ArtifactoryServicesManager, error) {
-	return utils.CreateUploadServiceManager(c.serverDetails, 1, 0, 0, false, nil)
+	return evdUtils.CreateArtifactoryServiceManager(c.serverDetails, 0, c.clientOptions)
 }
 
 func (c *createEvidenceBase) getFileChecksum(path string, artifactoryClient artifactory.ArtifactoryServicesManager) (string, error) {
@@ -193,14 +331,74 @@ func (c *createEvidenceBase) getFileChecksum(path string, artifactoryClient arti
 	return res.Checksums.Sha256, nil
 }
 
-func createAndSignEnvelope(payloadJson []byte, key string, keyId string) (*dsse.Envelope, error) {
-	// Load private key from file if ec.key is not a path to a file then try to load it as a key
-	keyFile := []byte(key)
-	if _, err := os.Stat(key); err == nil {
-		keyFile, err = os.ReadFile(key)
+// createAndSignEnvelope signs the payload with every key, to co-sign the envelope. All the keys are loaded before signing,
+// so a single invalid key fails the command without signing.
+func createAndSignEnvelope(payloadJson []byte, keys []string, keyIds []string, skipKeyIdCheck bool) (*dsse.Envelope, error) {
+	signers, err := loadEnvelopeSigners(keys, keyIds, skipKeyIdCheck)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the signers to create an envelope signer
+	envelopeSigner, err := sign.NewEnvelopeSigner(signers...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Iterate over all the signers and sign the dsse envelope
+	signedEnvelope, err := envelopeSigner.SignPayload(intoto.PayloadType, payloadJson)
+	if err != nil {
+		return nil, err
+	}
+	return signedEnvelope, nil
+}
+
+// loadEnvelopeSigners loads the signers of the keys, along with their key aliases. With skipKeyIdCheck, a key ID which
+// doesn't match its key is only warned about.
+func loadEnvelopeSigners(keys []string, keyIds []string, skipKeyIdCheck bool) ([]dsse.Signer, error) {
+	if len(keys) == 0 {
+		return nil, errorutils.CheckErrorf("no signing key was provided")
+	}
+	pairedKeyIds, err := getKeyIds(keyIds, len(keys))
+	if err != nil {
+		return nil, err
+	}
+
+	var signers []dsse.Signer
+	for i, k := range keys {
+		keySigners, err := loadSigners(strings.TrimSpace(k), pairedKeyIds[i], skipKeyIdCheck)
 		if err != nil {
+			if len(keys) > 1 {
+				// The key is not included in the error, as it may be the content of a private key rather than a path.
+				return nil, fmt.Errorf("failed to load signing key %d of %d: %w", i+1, len(keys), err)
+			}
 			return nil, err
 		}
+		signers = append(signers, keySigners...)
+	}
+	return signers, nil
+}
+
+// getKeyIds returns the key alias of each of the keys, pairing the key aliases with the keys by position: the first
+// alias names the first key, and so on. Key aliases are optional, but when provided, there must be one per key.
+func getKeyIds(keyIds []string, keysCount int) ([]string, error) {
+	if len(keyIds) == 0 {
+		return make([]string, keysCount), nil
+	}
+	if len(keyIds) != keysCount {
+		return nil, errorutils.CheckErrorf("%d key aliases were provided for %d keys. Provide a key alias for each key, in the order of the keys", len(keyIds), keysCount)
+	}
+	pairedKeyIds := make([]string, keysCount)
+	for i := range keyIds {
+		pairedKeyIds[i] = strings.TrimSpace(keyIds[i])
+	}
+	return pairedKeyIds, nil
+}
+
+func loadSigners(key, keyId string, skipKeyIdCheck bool) ([]dsse.Signer, error) {
+	keyFile, err := readKeyContent(key)
+	if err != nil {
+		return nil, err
 	}
 
 	privateKey, err := cryptox.ReadKey(keyFile)
@@ -212,25 +410,41 @@ func createAndSignEnvelope(payloadJson []byte, key string, keyId string) (*dsse.
 		return nil, errors.New("failed to load private key. please verify provided key")
 	}
 
-	privateKey.KeyID = keyId
-
-	signers, err := createSigners(privateKey)
-	if err != nil {
+	if err = checkKeyIdMatchesKey(privateKey, keyId, skipKeyIdCheck); err != nil {
 		return nil, err
 	}
+	privateKey.KeyID = keyId
+	return createSigners(privateKey)
+}
 
-	// Use the signers to create an envelope signer
-	envelopeSigner, err := sign.NewEnvelopeSigner(signers...)
+// checkKeyIdMatchesKey verifies that a key ID in the form of a derived key ID, a hex encoded sha256, is the ID derived from
+// the key, as an envelope declaring another key's ID fails verification. Other key IDs are key aliases, which name the
+// key in the platform, and can't be checked locally. With skipKeyIdCheck, a mismatch is only warned about.
+func checkKeyIdMatchesKey(privateKey *cryptox.SSLibKey, keyId string, skipKeyIdCheck bool) error {
+	if !derivedKeyIdRegexp.MatchString(keyId) {
+		return nil
+	}
+	derivedKeyId, err := cryptox.CalculateKeyID(privateKey)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	if strings.EqualFold(keyId, derivedKeyId) {
+		return nil
+	}
+	if skipKeyIdCheck {
+		clientlog.Warn(fmt.Sprintf("The key ID '%s' doesn't match the ID derived from the signing key '%s'. The evidence may fail verification.", keyId, derivedKeyId))
+		return nil
+	}
+	return errorutils.CheckErrorf("the key ID '%s' doesn't match the ID derived from the signing key '%s'. Provide the matching key ID, or use --skip-key-id-check to sign anyway", keyId, derivedKeyId)
+}
 
-	// Iterate over all the signers and sign the dsse envelope
-	signedEnvelope, err := envelopeSigner.SignPayload(intoto.PayloadType, payloadJson)
-	if err != nil {
-		return nil, err
+// readKeyContent reads the key from the file at the provided path. If the key isn't a path to a file, it is the key
+// itself, which may be base64 encoded.
+func readKeyContent(key string) ([]byte, error) {
+	if _, err := os.Stat(key); err == nil {
+		return os.ReadFile(key)
 	}
-	return signedEnvelope, nil
+	return cryptox.DecodeKeyIfBase64([]byte(key)), nil
 }
 
 func createSigners(privateKey *cryptox.SSLibKey) ([]dsse.Signer, error) {
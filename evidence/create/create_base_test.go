@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -147,7 +148,7 @@ func TestCreateAndSignEnvelope(t *testing.T) {
 				t.Fatalf("failed to read key file: %v", err)
 			}
 
-			envelope, err := createAndSignEnvelope(tt.payloadJson, string(keyContent), tt.keyId)
+			envelope, err := createAndSignEnvelope(tt.payloadJson, []string{string(keyContent)}, []string{tt.keyId}, false)
 			if tt.expectError {
 				assert.Error(t, err)
 				assert.Nil(t, envelope)
@@ -166,3 +167,123 @@ func TestCreateAndSignEnvelope(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAndSignEnvelope_MultipleKeys(t *testing.T) {
+	ed25519Key := filepath.Join("..", "cryptox", "testdata", "ed25519-test-key-pem")
+	ecdsaKey := filepath.Join("..", "cryptox", "testdata", "ecdsa-test-key-pem")
+	publicKey := filepath.Join("../..", "tests/testdata/public_key.pem")
+	payload := []byte(`{"foo": "bar"}`)
+
+	envelope, err := createAndSignEnvelope(payload, []string{ed25519Key, ecdsaKey}, []string{"release-key", "qa-key"}, false)
+	assert.NoError(t, err)
+	if assert.Len(t, envelope.Signatures, 2) {
+		assert.Equal(t, "release-key", envelope.Signatures[0].KeyId)
+		assert.Equal(t, "qa-key", envelope.Signatures[1].KeyId)
+		assert.NotEqual(t, envelope.Signatures[0].Sig, envelope.Signatures[1].Sig)
+	}
+
+	envelope, err = createAndSignEnvelope(payload, []string{ed25519Key, ecdsaKey}, nil, false)
+	assert.NoError(t, err)
+	assert.Len(t, envelope.Signatures, 2)
+
+	_, err = createAndSignEnvelope(payload, []string{ed25519Key, ecdsaKey}, []string{"release-key"}, false)
+	assert.EqualError(t, err, "1 key aliases were provided for 2 keys. Provide a key alias for each key, in the order of the keys")
+
+	// The key aliases are paired with the keys by position
+	envelope, err = createAndSignEnvelope(payload, []string{ecdsaKey, ed25519Key}, []string{"qa-key", "release-key"}, false)
+	assert.NoError(t, err)
+	if assert.Len(t, envelope.Signatures, 2) {
+		assert.Equal(t, "qa-key", envelope.Signatures[0].KeyId)
+		assert.Equal(t, "release-key", envelope.Signatures[1].KeyId)
+	}
+
+	_, err = createAndSignEnvelope(payload, nil, nil, false)
+	assert.EqualError(t, err, "no signing key was provided")
+
+	_, err = createAndSignEnvelope(payload, []string{ed25519Key, publicKey}, nil, false)
+	assert.EqualError(t, err, "failed to load signing key 2 of 2: failed to load private key. please verify provided key")
+}
+
+func TestCreateAndSignEnvelope_KeyIdMismatch(t *testing.T) {
+	keyPath := filepath.Join("..", "cryptox", "testdata", "ed25519-test-key-pem")
+	keyContent, err := os.ReadFile(keyPath)
+	assert.NoError(t, err)
+	privateKey, err := cryptox.ReadKey(keyContent)
+	assert.NoError(t, err)
+	derivedKeyId, err := cryptox.CalculateKeyID(privateKey)
+	assert.NoError(t, err)
+	otherKeyId := strings.Repeat("ab", 32)
+	payload := []byte(`{"foo": "bar"}`)
+
+	// The derived key ID and key aliases are accepted
+	envelope, err := createAndSignEnvelope(payload, []string{keyPath}, []string{derivedKeyId}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, derivedKeyId, envelope.Signatures[0].KeyId)
+	_, err = createAndSignEnvelope(payload, []string{keyPath}, []string{"release-key"}, false)
+	assert.NoError(t, err)
+
+	_, err = createAndSignEnvelope(payload, []string{keyPath}, []string{otherKeyId}, false)
+	assert.EqualError(t, err, "the key ID '"+otherKeyId+"' doesn't match the ID derived from the signing key '"+derivedKeyId+"'. Provide the matching key ID, or use --skip-key-id-check to sign anyway")
+
+	// With the key ID check skipped, the mismatch is only warned about
+	envelope, err = createAndSignEnvelope(payload, []string{keyPath}, []string{otherKeyId}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, otherKeyId, envelope.Signatures[0].KeyId)
+}
+
+func TestSetMarkdown(t *testing.T) {
+	markdownFile := filepath.Join(t.TempDir(), "notes.md")
+	assert.NoError(t, os.WriteFile(markdownFile, []byte("# From file"), 0600))
+
+	tests := []struct {
+		name             string
+		markdownFilePath string
+		markdownText     string
+		expectedMarkdown string
+		expectedError    string
+	}{
+		{
+			name:             "Markdown from file",
+			markdownFilePath: markdownFile,
+			expectedMarkdown: "# From file",
+		},
+		{
+			name:             "Markdown from text",
+			markdownText:     "# From text",
+			expectedMarkdown: "# From text",
+		},
+		{
+			name: "No markdown",
+		},
+		{
+			name:             "Both file and text",
+			markdownFilePath: markdownFile,
+			markdownText:     "# From text",
+			expectedError:    "not both",
+		},
+		{
+			name:          "Invalid UTF-8 text",
+			markdownText:  string([]byte{0xff, 0xfe}),
+			expectedError: "valid UTF-8",
+		},
+		{
+			name:          "Text exceeds maximal size",
+			markdownText:  strings.Repeat("a", maxMarkdownSize+1),
+			expectedError: "exceeds the maximal allowed size",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &createEvidenceBase{markdownFilePath: tt.markdownFilePath, markdownText: tt.markdownText}
+			statement := &intoto.Statement{}
+			err := c.setMarkdown(statement)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMarkdown, statement.Markdown)
+		})
+	}
+}
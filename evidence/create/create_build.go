@@ -1,9 +1,12 @@
 package create
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 
+	buildinfo "github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -18,22 +21,17 @@ type createEvidenceBuild struct {
 	project     string
 	buildName   string
 	buildNumber string
+	// When set, the build identity is read from this local build-info file instead of being queried from the server.
+	buildInfoFile string
 }
 
-func NewCreateEvidenceBuild(serverDetails *config.ServerDetails,
-	predicateFilePath, predicateType, markdownFilePath, key, keyId, project, buildName, buildNumber string) evidence.Command {
+func NewCreateEvidenceBuild(serverDetails *config.ServerDetails, options CreateEvidenceOptions, project, buildName, buildNumber, buildInfoFile string) evidence.Command {
 	return &createEvidenceBuild{
-		createEvidenceBase: createEvidenceBase{
-			serverDetails:     serverDetails,
-			predicateFilePath: predicateFilePath,
-			predicateType:     predicateType,
-			markdownFilePath:  markdownFilePath,
-			key:               key,
-			keyId:             keyId,
-		},
-		project:     project,
-		buildName:   buildName,
-		buildNumber: buildNumber,
+		createEvidenceBase: newCreateEvidenceBase(serverDetails, options),
+		project:            project,
+		buildName:          buildName,
+		buildNumber:        buildNumber,
+		buildInfoFile:      buildInfoFile,
 	}
 }
 
@@ -68,7 +66,7 @@ func (c *createEvidenceBuild) Run() error {
 }
 
 func (c *createEvidenceBuild) buildBuildInfoSubjectPath(artifactoryClient artifactory.ArtifactoryServicesManager) (string, string, error) {
-	timestamp, err := getBuildLatestTimestamp(c.buildName, c.buildNumber, c.project, artifactoryClient)
+	timestamp, err := c.getBuildTimestamp(artifactoryClient)
 	if err != nil {
 		return "", "", err
 	}
@@ -82,6 +80,54 @@ func (c *createEvidenceBuild) buildBuildInfoSubjectPath(artifactoryClient artifa
 	return buildInfoPath, buildInfoChecksum, nil
 }
 
+func (c *createEvidenceBuild) getBuildTimestamp(artifactoryClient artifactory.ArtifactoryServicesManager) (string, error) {
+	if c.buildInfoFile == "" {
+		return getBuildLatestTimestamp(c.buildName, c.buildNumber, c.project, artifactoryClient)
+	}
+	buildInfo, err := readBuildInfoFile(c.buildInfoFile)
+	if err != nil {
+		return "", err
+	}
+	if err = c.setBuildFromBuildInfo(buildInfo); err != nil {
+		return "", err
+	}
+	timestamp, err := utils.ParseIsoTimestamp(buildInfo.Started)
+	if err != nil {
+		return "", errorutils.CheckErrorf("invalid 'started' timestamp '%s' in the build-info file '%s': %s", buildInfo.Started, c.buildInfoFile, err.Error())
+	}
+	return fmt.Sprintf("%d", timestamp.UnixMilli()), nil
+}
+
+// setBuildFromBuildInfo takes the build name and number from the build-info file, and rejects the file if they
+// conflict with the ones provided explicitly.
+func (c *createEvidenceBuild) setBuildFromBuildInfo(buildInfo *buildinfo.BuildInfo) error {
+	if c.buildName != "" && c.buildName != buildInfo.Name {
+		return errorutils.CheckErrorf("the build name '%s' doesn't match the build name '%s' in the build-info file '%s'", c.buildName, buildInfo.Name, c.buildInfoFile)
+	}
+	if c.buildNumber != "" && c.buildNumber != buildInfo.Number {
+		return errorutils.CheckErrorf("the build number '%s' doesn't match the build number '%s' in the build-info file '%s'", c.buildNumber, buildInfo.Number, c.buildInfoFile)
+	}
+	c.buildName, c.buildNumber = buildInfo.Name, buildInfo.Number
+	return nil
+}
+
+func readBuildInfoFile(buildInfoFile string) (*buildinfo.BuildInfo, error) {
+	content, err := os.ReadFile(buildInfoFile)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to read the build-info file '%s': %s", buildInfoFile, err.Error())
+	}
+	buildInfo := &buildinfo.BuildInfo{}
+	if err = json.Unmarshal(content, buildInfo); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the build-info file '%s': %s", buildInfoFile, err.Error())
+	}
+	for _, field := range []struct{ name, value string }{{"name", buildInfo.Name}, {"number", buildInfo.Number}, {"started", buildInfo.Started}} {
+		if field.value == "" {
+			return nil, errorutils.CheckErrorf("the build-info file '%s' is missing the '%s' field", buildInfoFile, field.name)
+		}
+	}
+	return buildInfo, nil
+}
+
 func getBuildLatestTimestamp(name string, number string, project string, artifactoryClient artifactory.ArtifactoryServicesManager) (string, error) {
 	buildInfo := services.BuildInfoParams{
 		BuildName:   name,
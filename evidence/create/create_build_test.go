@@ -3,6 +3,8 @@ package create
 import (
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -89,3 +91,57 @@ func TestBuildInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildInfoFromFile(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		buildName     string
+		expectedPath  string
+		expectedError string
+	}{
+		{
+			name:         "Build identity from file",
+			content:      `{"name": "fileBuild", "number": "7", "started": "2024-02-01T10:00:00.000+0000"}`,
+			expectedPath: "artifactory-build-info/fileBuild/7-1706781600000.json",
+		},
+		{
+			name:         "Matching build name",
+			content:      `{"name": "fileBuild", "number": "7", "started": "2024-02-01T10:00:00.000+0000"}`,
+			buildName:    "fileBuild",
+			expectedPath: "artifactory-build-info/fileBuild/7-1706781600000.json",
+		},
+		{
+			name:          "Conflicting build name",
+			content:       `{"name": "fileBuild", "number": "7", "started": "2024-02-01T10:00:00.000+0000"}`,
+			buildName:     "otherBuild",
+			expectedError: "the build name 'otherBuild' doesn't match the build name 'fileBuild'",
+		},
+		{
+			name:          "Missing started",
+			content:       `{"name": "fileBuild", "number": "7"}`,
+			expectedError: "is missing the 'started' field",
+		},
+		{
+			name:          "Invalid JSON",
+			content:       `not json`,
+			expectedError: "failed to parse the build-info file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buildInfoFile := filepath.Join(t.TempDir(), "build-info.json")
+			assert.NoError(t, os.WriteFile(buildInfoFile, []byte(tt.content), 0600))
+			c := &createEvidenceBuild{buildName: tt.buildName, buildInfoFile: buildInfoFile}
+			path, sha256, err := c.buildBuildInfoSubjectPath(&mockArtifactoryServicesManagerBuild{})
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPath, path)
+			assert.Equal(t, "dummy_sha256", sha256)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package create
 
 import (
 	"encoding/json"
+	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	clientLog "github.com/jfrog/jfrog-client-go/utils/log"
@@ -12,32 +14,32 @@ import (
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/sigstore"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
+// The maximum number of files under a folder subject for which evidence is created with the recursive option.
+const maxRecursiveSubjects = 1000
+
 type createEvidenceCustom struct {
 	createEvidenceBase
 	subjectRepoPath       string
 	subjectSha256         string
 	sigstoreBundlePath    string
 	autoSubjectResolution bool
+	// When set and the subject is a folder, evidence is created for every file under it.
+	recursive bool
 }
 
-func NewCreateEvidenceCustom(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, subjectRepoPath,
-	subjectSha256, sigstoreBundlePath, providerId string) evidence.Command {
+func NewCreateEvidenceCustom(serverDetails *config.ServerDetails, options CreateEvidenceOptions, subjectRepoPath, subjectSha256, sigstoreBundlePath string,
+	recursive bool) evidence.Command {
 	return &createEvidenceCustom{
-		createEvidenceBase: createEvidenceBase{
-			serverDetails:     serverDetails,
-			predicateFilePath: predicateFilePath,
-			predicateType:     predicateType,
-			providerId:        providerId,
-			markdownFilePath:  markdownFilePath,
-			key:               key,
-			keyId:             keyId,
-		},
+		createEvidenceBase: newCreateEvidenceBase(serverDetails, options),
 		subjectRepoPath:    subjectRepoPath,
 		subjectSha256:      subjectSha256,
 		sigstoreBundlePath: sigstoreBundlePath,
+		recursive:          recursive,
 	}
 }
 
@@ -53,6 +55,16 @@ func (c *createEvidenceCustom) Run() error {
 	var evidencePayload []byte
 	var err error
 
+	if c.recursive {
+		isFolder, err := c.isFolderSubject()
+		if err != nil {
+			return err
+		}
+		if isFolder {
+			return c.createFolderEvidence()
+		}
+	}
+
 	if c.sigstoreBundlePath != "" {
 		clientLog.Info("Reading sigstore bundle from path:", c.sigstoreBundlePath)
 		evidencePayload, err = c.processSigstoreBundle()
@@ -78,6 +90,87 @@ func (c *createEvidenceCustom) Run() error {
 	return nil
 }
 
+// isFolderSubject reports whether the subject is a folder. Unlike files, folders have no checksums.
+func (c *createEvidenceCustom) isFolderSubject() (bool, error) {
+	if err := c.validateSubject(); err != nil {
+		return false, err
+	}
+	artifactoryClient, err := c.createArtifactoryClient()
+	if err != nil {
+		return false, err
+	}
+	info, err := artifactoryClient.FileInfo(c.subjectRepoPath)
+	if err != nil {
+		return false, c.handleSubjectNotFound(err)
+	}
+	return info.Checksums.Sha256 == "", nil
+}
+
+// createFolderEvidence creates evidence for every file under the folder subject. A failure for a file doesn't stop the
+// creation for the other files, and all the failures are reported at the end.
+func (c *createEvidenceCustom) createFolderEvidence() error {
+	subjects, err := c.getFolderSubjects()
+	if err != nil {
+		return err
+	}
+	if err = c.prefetchExistingEvidence(subjects); err != nil {
+		return err
+	}
+	clientLog.Info(fmt.Sprintf("Creating evidence for %d files under '%s'", len(subjects), c.subjectRepoPath))
+	var failedSubjects []string
+	for _, subject := range subjects {
+		clientLog.Info("Creating DSSE envelope for subject:", subject)
+		if err = c.createSubjectEvidence(subject); err != nil {
+			clientLog.Error(fmt.Sprintf("Failed to create evidence for '%s': %s", subject, err.Error()))
+			failedSubjects = append(failedSubjects, subject)
+		}
+	}
+	clientLog.Info(fmt.Sprintf("Evidence created for %d of %d files under '%s'", len(subjects)-len(failedSubjects), len(subjects), c.subjectRepoPath))
+	if len(failedSubjects) > 0 {
+		return errorutils.CheckErrorf("failed to create evidence for %d of %d files under '%s':\n%s", len(failedSubjects), len(subjects), c.subjectRepoPath, strings.Join(failedSubjects, "\n"))
+	}
+	return nil
+}
+
+func (c *createEvidenceCustom) getFolderSubjects() ([]string, error) {
+	artifactoryClient, err := c.createArtifactoryClient()
+	if err != nil {
+		return nil, err
+	}
+	fileList, err := artifactoryClient.FileList(c.subjectRepoPath, utils.FileListParams{Deep: true})
+	if err != nil {
+		return nil, err
+	}
+	return getFolderSubjectPaths(c.subjectRepoPath, fileList.Files)
+}
+
+// getFolderSubjectPaths returns the sorted paths of the files of a deep file list of the folder, failing if there are
+// no files or too many files.
+func getFolderSubjectPaths(folder string, files []utils.FileListFile) ([]string, error) {
+	var subjects []string
+	for _, file := range files {
+		if !file.Folder {
+			subjects = append(subjects, strings.TrimSuffix(folder, "/")+file.Uri)
+		}
+	}
+	if len(subjects) == 0 {
+		return nil, errorutils.CheckErrorf("folder '%s' contains no files", folder)
+	}
+	if len(subjects) > maxRecursiveSubjects {
+		return nil, errorutils.CheckErrorf("folder '%s' contains %d files, more than the %d files evidence can be created for at once. Please provide a narrower path", folder, len(subjects), maxRecursiveSubjects)
+	}
+	sort.Strings(subjects)
+	return subjects, nil
+}
+
+func (c *createEvidenceCustom) createSubjectEvidence(subject string) error {
+	envelope, err := c.createEnvelope(subject, "")
+	if err != nil {
+		return err
+	}
+	return c.uploadEvidence(envelope, subject)
+}
+
 func (c *createEvidenceCustom) processSigstoreBundle() ([]byte, error) {
 	sigstoreBundle, err := sigstore.ParseBundle(c.sigstoreBundlePath)
 	if err != nil {
@@ -120,6 +213,20 @@ func (c *createEvidenceCustom) createDSSEEnvelope() ([]byte, error) {
 	return envelope, nil
 }
 
+func (c *createEvidenceCustom) buildCustomSubjectPath(artifactoryClient artifactory.ArtifactoryServicesManager) (string, string, error) {
+	if err := c.validateSubject(); err != nil {
+		return "", "", err
+	}
+	checksum, err := c.getFileChecksum(c.subjectRepoPath, artifactoryClient)
+	if err != nil {
+		return "", "", c.handleSubjectNotFound(err)
+	}
+	if c.subjectSha256 != "" && checksum != c.subjectSha256 {
+		return "", "", errorutils.CheckErrorf("provided sha256 does not match the file's sha256")
+	}
+	return c.subjectRepoPath, checksum, nil
+}
+
 func (c *createEvidenceCustom) validateSubject() error {
 	// Pattern: must have at least one slash with non-empty sections
 	if matched, _ := regexp.MatchString(`^[^/]+(/[^/]+)+$`, c.subjectRepoPath); !matched {
@@ -3,12 +3,14 @@ package create
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,15 +24,18 @@ func TestNewCreateEvidenceCustom(t *testing.T) {
 	// Test with regular evidence creation (no sigstore bundle)
 	cmd := NewCreateEvidenceCustom(
 		serverDetails,
-		"predicate.json",
-		"https://example.com/predicate/v1",
-		"markdown.md",
-		"key.pem",
-		"key-alias",
+		CreateEvidenceOptions{
+			PredicateFilePath: "predicate.json",
+			PredicateType:     "https://example.com/predicate/v1",
+			MarkdownFilePath:  "markdown.md",
+			Keys:              []string{"key.pem"},
+			KeyIds:            []string{"key-alias"},
+			ProviderId:        "test-provider",
+		},
 		"test-repo/test-artifact",
 		"abcd1234",
-		"", // No sigstore bundle
-		"test-provider",
+		"",    // No sigstore bundle
+		false, // Not recursive
 	)
 
 	assert.NotNil(t, cmd)
@@ -100,15 +105,13 @@ func TestCreateEvidenceCustom_WithSigstoreBundle(t *testing.T) {
 	}
 	cmd := NewCreateEvidenceCustom(
 		serverDetails,
-		"", // No predicate file
-		"", // No predicate type
-		"", // No markdown
-		"", // No key
-		"", // No key alias
+		CreateEvidenceOptions{
+			ProviderId: "test-provider",
+		},
 		"",
 		"",         // No sha256 (will be extracted from bundle)
 		bundlePath, // Sigstore bundle path
-		"test-provider",
+		false,      // Not recursive
 	)
 
 	// Verify command setup
@@ -126,15 +129,13 @@ func TestCreateEvidenceCustom_MissingSigstoreBundle(t *testing.T) {
 	// Create command with non-existent bundle file
 	cmd := NewCreateEvidenceCustom(
 		serverDetails,
-		"", // No predicate file
-		"", // No predicate type
-		"", // No markdown
-		"", // No key
-		"", // No key alias
+		CreateEvidenceOptions{
+			ProviderId: "test-provider",
+		},
 		"test-repo/test-artifact",
 		"",
 		"/non/existent/bundle.json", // Non-existent bundle
-		"test-provider",
+		false,                       // Not recursive
 	)
 
 	// Run should fail
@@ -195,15 +196,13 @@ func TestCreateEvidenceCustom_SigstoreBundleWithSubjectPath(t *testing.T) {
 	}
 	cmd := NewCreateEvidenceCustom(
 		serverDetails,
-		"",                                // No predicate file
-		"",                                // No predicate type
-		"",                                // No markdown
-		"",                                // No key
-		"",                                // No key alias
+		CreateEvidenceOptions{
+			ProviderId: "test-provider",
+		},
 		"provided-repo/provided-artifact", // This should be used as fallback
 		"",
 		bundlePath,
-		"test-provider",
+		false, // Not recursive
 	)
 
 	// Verify the command would use the provided subject path
@@ -223,15 +222,18 @@ func TestCreateEvidenceCustom_NewSubjectError_AutoSubjectResolution(t *testing.T
 
 	cmd := NewCreateEvidenceCustom(
 		serverDetails,
-		"predicate.json",
-		"https://example.com/predicate/v1",
-		"markdown.md",
-		"key.pem",
-		"key-alias",
+		CreateEvidenceOptions{
+			PredicateFilePath: "predicate.json",
+			PredicateType:     "https://example.com/predicate/v1",
+			MarkdownFilePath:  "markdown.md",
+			Keys:              []string{"key.pem"},
+			KeyIds:            []string{"key-alias"},
+			ProviderId:        "test-provider",
+		},
 		"",
 		"abcd1234",
 		"/path/to/sigstore-bundle.json",
-		"test-provider",
+		false, // Not recursive
 	)
 
 	custom, ok := cmd.(*createEvidenceCustom)
@@ -258,15 +260,18 @@ func TestCreateEvidenceCustom_NewSubjectError_RegularExecution(t *testing.T) {
 
 	cmd := NewCreateEvidenceCustom(
 		serverDetails,
-		"predicate.json",
-		"https://example.com/predicate/v1",
-		"markdown.md",
-		"key.pem",
-		"key-alias",
+		CreateEvidenceOptions{
+			PredicateFilePath: "predicate.json",
+			PredicateType:     "https://example.com/predicate/v1",
+			MarkdownFilePath:  "markdown.md",
+			Keys:              []string{"key.pem"},
+			KeyIds:            []string{"key-alias"},
+			ProviderId:        "test-provider",
+		},
 		"test-repo/test-artifact",
 		"abcd1234",
 		"",
-		"test-provider",
+		false, // Not recursive
 	)
 
 	custom, ok := cmd.(*createEvidenceCustom)
@@ -282,3 +287,23 @@ func TestCreateEvidenceCustom_NewSubjectError_RegularExecution(t *testing.T) {
 	assert.False(t, ok, "error should not be of type CliError when autoSubjectResolution is disabled")
 	assert.Contains(t, err.Error(), testMessage, "error message should contain the test message")
 }
+
+func TestGetFolderSubjectPaths(t *testing.T) {
+	subjects, err := getFolderSubjectPaths("test-repo/build/", []utils.FileListFile{
+		{Uri: "/lib/b.jar"},
+		{Uri: "/lib", Folder: true},
+		{Uri: "/a.txt"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test-repo/build/a.txt", "test-repo/build/lib/b.jar"}, subjects)
+
+	_, err = getFolderSubjectPaths("test-repo/build", []utils.FileListFile{{Uri: "/lib", Folder: true}})
+	assert.EqualError(t, err, "folder 'test-repo/build' contains no files")
+
+	tooManyFiles := make([]utils.FileListFile, maxRecursiveSubjects+1)
+	for i := range tooManyFiles {
+		tooManyFiles[i].Uri = fmt.Sprintf("/file-%d.txt", i)
+	}
+	_, err = getFolderSubjectPaths("test-repo/build", tooManyFiles)
+	assert.ErrorContains(t, err, "folder 'test-repo/build' contains 1001 files, more than the 1000 files evidence can be created for at once. Please provide a narrower path")
+}
@@ -39,21 +39,14 @@ type createGitHubEvidence struct {
 	buildNumber string
 }
 
-func NewCreateGithub(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, project, buildName, buildNumber, typeFlag string) evidence.Command {
-	flagType := getFlagType(typeFlag)
+func NewCreateGithub(serverDetails *config.ServerDetails, options CreateEvidenceOptions, project, buildName, buildNumber, typeFlag string) evidence.Command {
+	createEvidenceBase := newCreateEvidenceBase(serverDetails, options)
+	createEvidenceBase.flagType = getFlagType(typeFlag)
 	return &createGitHubEvidence{
-		createEvidenceBase: createEvidenceBase{
-			serverDetails:     serverDetails,
-			predicateFilePath: predicateFilePath,
-			predicateType:     predicateType,
-			markdownFilePath:  markdownFilePath,
-			key:               key,
-			keyId:             keyId,
-			flagType:          flagType,
-		},
-		project:     project,
-		buildName:   buildName,
-		buildNumber: buildNumber,
+		createEvidenceBase: createEvidenceBase,
+		project:            project,
+		buildName:          buildName,
+		buildNumber:        buildNumber,
 	}
 }
 
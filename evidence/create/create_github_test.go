@@ -34,7 +34,8 @@ func (m *MockBuildAndVcsDetails) GetLastBuildLink(serverDetails *config.ServerDe
 
 func TestNewCreateGithub(t *testing.T) {
 	serverDetails := &config.ServerDetails{}
-	command := NewCreateGithub(serverDetails, "path/to/predicate.json", "predicateType", "path/to/markdown.md", "key", "keyId", "myProject", "myBuild", "123", "gh-commiter")
+	command := NewCreateGithub(serverDetails, CreateEvidenceOptions{PredicateFilePath: "path/to/predicate.json", PredicateType: "predicateType",
+		MarkdownFilePath: "path/to/markdown.md", Keys: []string{"key"}, KeyIds: []string{"keyId"}}, "myProject", "myBuild", "123", "gh-commiter")
 
 	assert.NotNil(t, command)
 
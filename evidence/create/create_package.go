@@ -1,29 +1,28 @@
 package create
 
 import (
+	"fmt"
+
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type createEvidencePackage struct {
 	createEvidenceBase
 	packageService evidence.PackageService
+	// When set, the digest of a Docker image is printed to the standard output as JSON.
+	printDigest bool
 }
 
-func NewCreateEvidencePackage(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, packageName,
-	packageVersion, packageRepoName string) evidence.Command {
+func NewCreateEvidencePackage(serverDetails *config.ServerDetails, options CreateEvidenceOptions, packageName, packageVersion, packageRepoName string,
+	printDigest bool) evidence.Command {
 	return &createEvidencePackage{
-		createEvidenceBase: createEvidenceBase{
-			serverDetails:     serverDetails,
-			predicateFilePath: predicateFilePath,
-			predicateType:     predicateType,
-			markdownFilePath:  markdownFilePath,
-			key:               key,
-			keyId:             keyId,
-		},
-		packageService: evidence.NewPackageService(packageName, packageVersion, packageRepoName),
+		createEvidenceBase: newCreateEvidenceBase(serverDetails, options),
+		packageService:     evidence.NewPackageService(packageName, packageVersion, packageRepoName),
+		printDigest:        printDigest,
 	}
 }
 
@@ -41,33 +40,59 @@ func (c *createEvidencePackage) Run() error {
 		log.Error("failed to create Artifactory client", err)
 		return err
 	}
-	metadataClient, err := utils.CreateMetadataServiceManager(c.serverDetails, false)
+	packageType, err := c.packageService.GetPackageType(artifactoryClient)
 	if err != nil {
 		return err
 	}
-
-	packageType, err := c.packageService.GetPackageType(artifactoryClient)
+	leadArtifactPath, leadArtifactChecksum, err := c.buildPackageSubjectPathOfType(packageType, artifactoryClient)
 	if err != nil {
 		return err
 	}
-
-	leadArtifactPath, err := c.packageService.GetPackageVersionLeadArtifact(packageType, metadataClient, artifactoryClient)
+	envelope, err := c.createEnvelope(leadArtifactPath, leadArtifactChecksum)
 	if err != nil {
 		return err
 	}
-
-	leadArtifactChecksum, err := c.getFileChecksum(leadArtifactPath, artifactoryClient)
+	err = c.uploadEvidence(envelope, leadArtifactPath)
 	if err != nil {
 		return err
 	}
-	envelope, err := c.createEnvelope(leadArtifactPath, leadArtifactChecksum)
+
+	if !isImagePackageType(packageType) {
+		if c.printDigest {
+			log.Warn(fmt.Sprintf("The digest is only printed for Docker and OCI images, and package '%s' is a %s package.", c.packageService.GetPackageName(), packageType))
+		}
+		return nil
+	}
+	// The evidence is attached to the manifest of the tag, whose sha256 is the digest of the image
+	imageDigest, err := getImageDigest(artifactoryClient, c.packageService.GetPackageName(), c.packageService.GetPackageVersion(), leadArtifactPath, leadArtifactChecksum)
 	if err != nil {
 		return err
 	}
-	err = c.uploadEvidence(envelope, leadArtifactPath)
+	return reportImageDigest(imageDigest, c.printDigest)
+}
+
+func (c *createEvidencePackage) buildPackageSubjectPath(artifactoryClient artifactory.ArtifactoryServicesManager) (string, string, error) {
+	packageType, err := c.packageService.GetPackageType(artifactoryClient)
 	if err != nil {
-		return err
+		return "", "", err
+	}
+	return c.buildPackageSubjectPathOfType(packageType, artifactoryClient)
+}
+
+func (c *createEvidencePackage) buildPackageSubjectPathOfType(packageType string, artifactoryClient artifactory.ArtifactoryServicesManager) (string, string, error) {
+	metadataClient, err := evdUtils.CreateMetadataServiceManager(c.serverDetails, c.clientOptions)
+	if err != nil {
+		return "", "", err
 	}
 
-	return nil
+	leadArtifactPath, err := c.packageService.GetPackageVersionLeadArtifact(packageType, metadataClient, artifactoryClient)
+	if err != nil {
+		return "", "", err
+	}
+
+	leadArtifactChecksum, err := c.getFileChecksum(leadArtifactPath, artifactoryClient)
+	if err != nil {
+		return "", "", err
+	}
+	return leadArtifactPath, leadArtifactChecksum, nil
 }
@@ -18,7 +18,8 @@ func TestNewCreateEvidencePackage(t *testing.T) {
 	packageVersion := "1.0.0"
 	packageRepoName := "test-repo"
 
-	cmd := NewCreateEvidencePackage(serverDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, packageName, packageVersion, packageRepoName)
+	cmd := NewCreateEvidencePackage(serverDetails, CreateEvidenceOptions{PredicateFilePath: predicateFilePath, PredicateType: predicateType,
+		MarkdownFilePath: markdownFilePath, Keys: []string{key}, KeyIds: []string{keyId}}, packageName, packageVersion, packageRepoName, false)
 	createCmd, ok := cmd.(*createEvidencePackage)
 	assert.True(t, ok)
 
@@ -27,8 +28,8 @@ func TestNewCreateEvidencePackage(t *testing.T) {
 	assert.Equal(t, predicateFilePath, createCmd.predicateFilePath)
 	assert.Equal(t, predicateType, createCmd.predicateType)
 	assert.Equal(t, markdownFilePath, createCmd.markdownFilePath)
-	assert.Equal(t, key, createCmd.key)
-	assert.Equal(t, keyId, createCmd.keyId)
+	assert.Equal(t, []string{key}, createCmd.keys)
+	assert.Equal(t, []string{keyId}, createCmd.keyIds)
 
 	// Test packageService fields
 	assert.Equal(t, packageName, createCmd.packageService.GetPackageName())
@@ -4,11 +4,12 @@ import (
 	"fmt"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
-	artifactoryUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	lifecycleServices "github.com/jfrog/jfrog-client-go/lifecycle/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
@@ -17,23 +18,26 @@ type createEvidenceReleaseBundle struct {
 	project              string
 	releaseBundle        string
 	releaseBundleVersion string
+	// When set, the evidence is bound to the promotion of the release bundle version to this environment.
+	promotionEnvironment string
+	// When set, overrides the repository derived from the project, for release bundles stored in a non-standard repository.
+	releaseBundleRepo string
 }
 
-func NewCreateEvidenceReleaseBundle(serverDetails *config.ServerDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, project, releaseBundle,
-	releaseBundleVersion string) evidence.Command {
+func NewCreateEvidenceReleaseBundle(serverDetails *config.ServerDetails, options CreateEvidenceOptions, project, releaseBundle, releaseBundleVersion,
+	promotionEnvironment, releaseBundleRepo string) evidence.Command {
+	createEvidenceBase := newCreateEvidenceBase(serverDetails, options)
+	createEvidenceBase.stage = promotionEnvironment
+	if createEvidenceBase.stage == "" {
+		createEvidenceBase.stage = getReleaseBundleStage(serverDetails, options.ClientOptions, releaseBundle, releaseBundleVersion, project)
+	}
 	return &createEvidenceReleaseBundle{
-		createEvidenceBase: createEvidenceBase{
-			serverDetails:     serverDetails,
-			predicateFilePath: predicateFilePath,
-			predicateType:     predicateType,
-			markdownFilePath:  markdownFilePath,
-			key:               key,
-			keyId:             keyId,
-			stage:             getReleaseBundleStage(serverDetails, releaseBundle, releaseBundleVersion, project),
-		},
+		createEvidenceBase:   createEvidenceBase,
 		project:              project,
 		releaseBundle:        releaseBundle,
 		releaseBundleVersion: releaseBundleVersion,
+		promotionEnvironment: promotionEnvironment,
+		releaseBundleRepo:    releaseBundleRepo,
 	}
 }
 
@@ -55,6 +59,11 @@ func (c *createEvidenceReleaseBundle) Run() error {
 	if err != nil {
 		return err
 	}
+	if c.promotionEnvironment != "" {
+		if err = c.setPromotion(); err != nil {
+			return err
+		}
+	}
 	envelope, err := c.createEnvelope(subject, sha256)
 	if err != nil {
 		return err
@@ -68,7 +77,10 @@ func (c *createEvidenceReleaseBundle) Run() error {
 }
 
 func (c *createEvidenceReleaseBundle) buildReleaseBundleSubjectPath(artifactoryClient artifactory.ArtifactoryServicesManager) (string, string, error) {
-	repoKey := utils.BuildReleaseBundleRepoKey(c.project)
+	repoKey, err := c.getReleaseBundleRepoKey(artifactoryClient)
+	if err != nil {
+		return "", "", err
+	}
 	manifestPath := buildManifestPath(repoKey, c.releaseBundle, c.releaseBundleVersion)
 
 	manifestChecksum, err := c.getFileChecksum(manifestPath, artifactoryClient)
@@ -79,13 +91,66 @@ func (c *createEvidenceReleaseBundle) buildReleaseBundleSubjectPath(artifactoryC
 	return manifestPath, manifestChecksum, nil
 }
 
+// getReleaseBundleRepoKey returns the repository of the release bundle manifest, which is derived from the project unless
+// provided explicitly. An explicitly provided repository must exist.
+func (c *createEvidenceReleaseBundle) getReleaseBundleRepoKey(artifactoryClient artifactory.ArtifactoryServicesManager) (string, error) {
+	if c.releaseBundleRepo == "" {
+		return utils.BuildReleaseBundleRepoKey(c.project), nil
+	}
+	exists, err := artifactoryClient.IsRepoExists(c.releaseBundleRepo)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", errorutils.CheckErrorf("release bundle repository '%s' doesn't exist", c.releaseBundleRepo)
+	}
+	return c.releaseBundleRepo, nil
+}
+
+func (c *createEvidenceReleaseBundle) setPromotion() error {
+	lifecycleServiceManager, err := utils.CreateLifecycleServiceManager(c.serverDetails, c.clientOptions)
+	if err != nil {
+		return err
+	}
+	rbDetails, queryParams := initReleaseBundlePromotionDetails(c.releaseBundle, c.releaseBundleVersion, c.project)
+	promotionDetails, err := lifecycleServiceManager.GetReleaseBundleVersionPromotions(rbDetails, queryParams)
+	if err != nil {
+		return err
+	}
+	promotion, err := findCompletedPromotion(promotionDetails, c.promotionEnvironment)
+	if err != nil {
+		return errorutils.CheckErrorf("release bundle %s:%s %s", c.releaseBundle, c.releaseBundleVersion, err.Error())
+	}
+	log.Info("Binding the evidence to the promotion to", promotion.Environment, "created at", promotion.Created)
+	c.promotion = &intoto.Promotion{
+		Environment:   promotion.Environment,
+		CreatedMillis: promotion.CreatedMillis.String(),
+		CreatedBy:     promotion.CreatedBy,
+	}
+	c.stage = promotion.Environment
+	return nil
+}
+
+// findCompletedPromotion returns the latest completed promotion to the environment. Promotions are listed from the latest.
+func findCompletedPromotion(promotionDetails lifecycleServices.RbPromotionsResponse, environment string) (*lifecycleServices.RbPromotion, error) {
+	for i, promotion := range promotionDetails.Promotions {
+		if promotion.Environment == environment && promotion.Status == "COMPLETED" {
+			return &promotionDetails.Promotions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("has no completed promotion to the '%s' environment", environment)
+}
+
+// The name of the release bundle version manifest, which is the subject of release bundle evidence.
+const releaseBundleManifestName = "release-bundle.json.evd"
+
 func buildManifestPath(repoKey, name, version string) string {
-	return fmt.Sprintf("%s/%s/%s/release-bundle.json.evd", repoKey, name, version)
+	return fmt.Sprintf("%s/%s/%s/%s", repoKey, name, version, releaseBundleManifestName)
 }
 
-func getReleaseBundleStage(serverDetails *config.ServerDetails, releaseBundle, releaseBundleVersion, project string) string {
+func getReleaseBundleStage(serverDetails *config.ServerDetails, clientOptions utils.ClientOptions, releaseBundle, releaseBundleVersion, project string) string {
 	log.Debug("fetching release bundle %s:%s stage", releaseBundle, releaseBundleVersion)
-	lifecycleServiceManager, err := artifactoryUtils.CreateLifecycleServiceManager(serverDetails, false)
+	lifecycleServiceManager, err := utils.CreateLifecycleServiceManager(serverDetails, clientOptions)
 	if err != nil {
 		log.Warn("Failed to create lifecycle service manager:", err)
 		return ""
@@ -3,9 +3,11 @@ package create
 import (
 	"testing"
 
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	lifecycleServices "github.com/jfrog/jfrog-client-go/lifecycle/services"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,14 +28,18 @@ func (m *mockReleaseBundleArtifactoryServicesManager) FileInfo(_ string) (*utils
 	return fi, nil
 }
 
+func (m *mockReleaseBundleArtifactoryServicesManager) IsRepoExists(repoKey string) (bool, error) {
+	return repoKey == "custom-rb-repo", nil
+}
+
 func createTestReleaseBundleCommand() *createEvidenceReleaseBundle {
 	return &createEvidenceReleaseBundle{
 		createEvidenceBase: createEvidenceBase{
 			serverDetails:     &config.ServerDetails{Url: "http://test.com"},
 			predicateFilePath: "/test/predicate.json",
 			predicateType:     "test-type",
-			key:               "test-key",
-			keyId:             "test-key-id",
+			keys:              []string{"test-key"},
+			keyIds:            []string{"test-key-id"},
 			stage:             "test-stage",
 		},
 		project:              "test-project",
@@ -53,7 +59,8 @@ func TestNewCreateEvidenceReleaseBundle(t *testing.T) {
 	releaseBundle := "test-bundle"
 	releaseBundleVersion := "1.0.0"
 
-	cmd := NewCreateEvidenceReleaseBundle(serverDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, project, releaseBundle, releaseBundleVersion)
+	options := CreateEvidenceOptions{PredicateFilePath: predicateFilePath, PredicateType: predicateType, MarkdownFilePath: markdownFilePath, Keys: []string{key}, KeyIds: []string{keyId}}
+	cmd := NewCreateEvidenceReleaseBundle(serverDetails, options, project, releaseBundle, releaseBundleVersion, "", "")
 	createCmd, ok := cmd.(*createEvidenceReleaseBundle)
 	assert.True(t, ok)
 
@@ -61,8 +68,8 @@ func TestNewCreateEvidenceReleaseBundle(t *testing.T) {
 	assert.Equal(t, predicateFilePath, createCmd.predicateFilePath)
 	assert.Equal(t, predicateType, createCmd.predicateType)
 	assert.Equal(t, markdownFilePath, createCmd.markdownFilePath)
-	assert.Equal(t, key, createCmd.key)
-	assert.Equal(t, keyId, createCmd.keyId)
+	assert.Equal(t, []string{key}, createCmd.keys)
+	assert.Equal(t, []string{keyId}, createCmd.keyIds)
 
 	assert.Equal(t, project, createCmd.project)
 	assert.Equal(t, releaseBundle, createCmd.releaseBundle)
@@ -165,16 +172,16 @@ func TestGetReleaseBundleStage_Integration(t *testing.T) {
 	t.Run("Error_Handling_Empty_Parameters", func(t *testing.T) {
 		serverDetails := &config.ServerDetails{Url: "http://test.com"}
 
-		result := getReleaseBundleStage(serverDetails, "", "1.0.0", "test-project")
+		result := getReleaseBundleStage(serverDetails, evdUtils.ClientOptions{}, "", "1.0.0", "test-project")
 		assert.Equal(t, "", result, "Should return empty string when bundle name is empty")
 
-		result = getReleaseBundleStage(serverDetails, "test-bundle", "", "test-project")
+		result = getReleaseBundleStage(serverDetails, evdUtils.ClientOptions{}, "test-bundle", "", "test-project")
 		assert.Equal(t, "", result, "Should return empty string when version is empty")
 	})
 
 	t.Run("Service_Error_Handling", func(t *testing.T) {
 		serverDetails := &config.ServerDetails{Url: "invalid-url"}
-		result := getReleaseBundleStage(serverDetails, "test-bundle", "1.0.0", "test-project")
+		result := getReleaseBundleStage(serverDetails, evdUtils.ClientOptions{}, "test-bundle", "1.0.0", "test-project")
 
 		assert.Equal(t, "", result, "Should return empty string when service creation fails")
 	})
@@ -204,7 +211,8 @@ func TestStageIntegrationInConstructor(t *testing.T) {
 		releaseBundle := "test-bundle"
 		releaseBundleVersion := "1.0.0"
 
-		cmd := NewCreateEvidenceReleaseBundle(serverDetails, predicateFilePath, predicateType, markdownFilePath, key, keyId, project, releaseBundle, releaseBundleVersion)
+		options := CreateEvidenceOptions{PredicateFilePath: predicateFilePath, PredicateType: predicateType, MarkdownFilePath: markdownFilePath, Keys: []string{key}, KeyIds: []string{keyId}}
+		cmd := NewCreateEvidenceReleaseBundle(serverDetails, options, project, releaseBundle, releaseBundleVersion, "", "")
 		createCmd, ok := cmd.(*createEvidenceReleaseBundle)
 		assert.True(t, ok)
 
@@ -275,3 +283,42 @@ func TestReleaseBundle(t *testing.T) {
 		})
 	}
 }
+
+func TestFindCompletedPromotion(t *testing.T) {
+	promotionDetails := lifecycleServices.RbPromotionsResponse{
+		Promotions: []lifecycleServices.RbPromotion{
+			{Environment: "PROD", Status: "FAILED", CreatedMillis: "300"},
+			{Environment: "QA", Status: "COMPLETED", CreatedMillis: "200"},
+			{Environment: "PROD", Status: "COMPLETED", CreatedMillis: "100", CreatedBy: "admin"},
+		},
+	}
+
+	promotion, err := findCompletedPromotion(promotionDetails, "PROD")
+	assert.NoError(t, err)
+	assert.Equal(t, "100", promotion.CreatedMillis.String())
+	assert.Equal(t, "admin", promotion.CreatedBy)
+
+	_, err = findCompletedPromotion(promotionDetails, "DEV")
+	assert.EqualError(t, err, "has no completed promotion to the 'DEV' environment")
+}
+
+func TestNewCreateEvidenceReleaseBundle_PromotionEnvironment(t *testing.T) {
+	cmd := NewCreateEvidenceReleaseBundle(&config.ServerDetails{}, CreateEvidenceOptions{}, "myProject", "bundle", "1.0.0", "PROD", "")
+	createCmd, ok := cmd.(*createEvidenceReleaseBundle)
+	assert.True(t, ok)
+	assert.Equal(t, "PROD", createCmd.promotionEnvironment)
+	assert.Equal(t, "PROD", createCmd.stage)
+}
+
+func TestReleaseBundle_CustomRepo(t *testing.T) {
+	cmd := createTestReleaseBundleCommand()
+	cmd.releaseBundleRepo = "custom-rb-repo"
+	path, sha256, err := cmd.buildReleaseBundleSubjectPath(&mockReleaseBundleArtifactoryServicesManager{})
+	assert.NoError(t, err)
+	assert.Equal(t, "custom-rb-repo/test-bundle/1.0.0/release-bundle.json.evd", path)
+	assert.Equal(t, "dummy_sha256", sha256)
+
+	cmd.releaseBundleRepo = "missing-repo"
+	_, _, err = cmd.buildReleaseBundleSubjectPath(&mockReleaseBundleArtifactoryServicesManager{})
+	assert.EqualError(t, err, "release bundle repository 'missing-repo' doesn't exist")
+}
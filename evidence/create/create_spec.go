@@ -0,0 +1,321 @@
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// EvidenceSpec lists the subjects to create evidence for in a single run, each with its own predicate. For example:
+// {"subjects": [{"subjectRepoPath": "libs-local/app.jar", "predicate": "provenance.json", "predicateType": "https://slsa.dev/provenance/v1"}]}
+type EvidenceSpec struct {
+	Subjects []EvidenceSpecSubject `json:"subjects"`
+}
+
+// EvidenceSpecSubject is a subject of an evidence spec. Exactly one of subjectRepoPath, buildName, packageName and
+// releaseBundle must be set, along with the other fields identifying a subject of its type. The evidence is signed with
+// the key and keyAlias of the subject when set, and otherwise with the keys of the command. A subject key is used with
+// its own keyAlias only, never with the key alias of the command.
+type EvidenceSpecSubject struct {
+	SubjectRepoPath      string `json:"subjectRepoPath,omitempty"`
+	SubjectSha256        string `json:"subjectSha256,omitempty"`
+	BuildName            string `json:"buildName,omitempty"`
+	BuildNumber          string `json:"buildNumber,omitempty"`
+	PackageName          string `json:"packageName,omitempty"`
+	PackageVersion       string `json:"packageVersion,omitempty"`
+	PackageRepoName      string `json:"packageRepoName,omitempty"`
+	ReleaseBundle        string `json:"releaseBundle,omitempty"`
+	ReleaseBundleVersion string `json:"releaseBundleVersion,omitempty"`
+	Project              string `json:"project,omitempty"`
+	Predicate            string `json:"predicate"`
+	PredicateType        string `json:"predicateType"`
+	Markdown             string `json:"markdown,omitempty"`
+	Annotations          string `json:"annotations,omitempty"`
+	Key                  string `json:"key,omitempty"`
+	KeyAlias             string `json:"keyAlias,omitempty"`
+}
+
+// String describes the subject in the report of the run.
+func (s EvidenceSpecSubject) String() string {
+	switch {
+	case s.SubjectRepoPath != "":
+		return fmt.Sprintf("artifact '%s'", s.SubjectRepoPath)
+	case s.BuildName != "":
+		return fmt.Sprintf("build '%s:%s'", s.BuildName, s.BuildNumber)
+	case s.PackageName != "":
+		return fmt.Sprintf("package '%s:%s'", s.PackageName, s.PackageVersion)
+	default:
+		return fmt.Sprintf("release bundle '%s:%s'", s.ReleaseBundle, s.ReleaseBundleVersion)
+	}
+}
+
+// specField is a field of a spec subject, by its name in the spec.
+type specField struct {
+	name  string
+	value string
+}
+
+func (s EvidenceSpecSubject) validate() error {
+	var setSubjectFields []string
+	for _, field := range []specField{{"subjectRepoPath", s.SubjectRepoPath}, {"buildName", s.BuildName}, {"packageName", s.PackageName}, {"releaseBundle", s.ReleaseBundle}} {
+		if field.value != "" {
+			setSubjectFields = append(setSubjectFields, field.name)
+		}
+	}
+	if len(setSubjectFields) != 1 {
+		return fmt.Errorf("exactly one of subjectRepoPath, buildName, packageName and releaseBundle must be set, got: %s", strings.Join(setSubjectFields, ", "))
+	}
+	requiredFields := []specField{{"predicate", s.Predicate}, {"predicateType", s.PredicateType}}
+	switch {
+	case s.BuildName != "":
+		requiredFields = append(requiredFields, specField{"buildNumber", s.BuildNumber})
+	case s.PackageName != "":
+		requiredFields = append(requiredFields, specField{"packageVersion", s.PackageVersion}, specField{"packageRepoName", s.PackageRepoName})
+	case s.ReleaseBundle != "":
+		requiredFields = append(requiredFields, specField{"releaseBundleVersion", s.ReleaseBundleVersion})
+	}
+	var missingFields []string
+	for _, field := range requiredFields {
+		if field.value == "" {
+			missingFields = append(missingFields, field.name)
+		}
+	}
+	if len(missingFields) > 0 {
+		return fmt.Errorf("missing required fields: %s", strings.Join(missingFields, ", "))
+	}
+	return nil
+}
+
+func loadEvidenceSpec(specPath string) (*EvidenceSpec, error) {
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to read the evidence spec file '%s': %s", specPath, err.Error())
+	}
+	spec := &EvidenceSpec{}
+	if err = json.Unmarshal(content, spec); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the evidence spec file '%s': %s", specPath, err.Error())
+	}
+	if len(spec.Subjects) == 0 {
+		return nil, errorutils.CheckErrorf("the evidence spec file '%s' has no subjects", specPath)
+	}
+	var problems []string
+	for i, subject := range spec.Subjects {
+		if err = subject.validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("subject %d: %s", i+1, err.Error()))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, errorutils.CheckErrorf("the evidence spec file '%s' is invalid:\n%s", specPath, strings.Join(problems, "\n"))
+	}
+	return spec, nil
+}
+
+// specSubjectResult is the outcome of the evidence creation for a subject of the spec.
+type specSubjectResult struct {
+	// False when the creation was canceled before it started, after another subject failed.
+	started bool
+	err     error
+}
+
+type createEvidenceSpec struct {
+	serverDetails *config.ServerDetails
+	specPath      string
+	// The settings of the evidence of every subject. The signing key applies to the subjects which don't set their own.
+	options CreateEvidenceOptions
+	threads int
+	// The existing evidence of the subjects with repository paths, by predicate type and subject.
+	existingEvidence map[string]map[string][]subjectEvidence
+	// When set, no more subjects are started once the creation for a subject fails.
+	failFast bool
+	// Creates the command creating the evidence for a subject. Replaced in tests.
+	newSubjectCommand func(subject EvidenceSpecSubject) evidence.Command
+}
+
+// NewCreateEvidenceSpec creates evidence for all the subjects of a spec file concurrently, using up to the given number of threads.
+func NewCreateEvidenceSpec(serverDetails *config.ServerDetails, options CreateEvidenceOptions, specPath string, threads int, failFast bool) evidence.Command {
+	c := &createEvidenceSpec{
+		serverDetails: serverDetails,
+		specPath:      specPath,
+		options:       options,
+		threads:       threads,
+		failFast:      failFast,
+	}
+	c.newSubjectCommand = c.createSubjectCommand
+	return c
+}
+
+func (c *createEvidenceSpec) CommandName() string {
+	return "create-evidence-batch"
+}
+
+func (c *createEvidenceSpec) ServerDetails() (*config.ServerDetails, error) {
+	return c.serverDetails, nil
+}
+
+func (c *createEvidenceSpec) Run() error {
+	spec, err := loadEvidenceSpec(c.specPath)
+	if err != nil {
+		return err
+	}
+	if err = c.validateSubjectsKeys(spec.Subjects); err != nil {
+		return err
+	}
+	if err = c.prefetchExistingEvidence(spec.Subjects); err != nil {
+		return err
+	}
+	clientlog.Info(fmt.Sprintf("Creating evidence for %d subjects using %d threads", len(spec.Subjects), c.threads))
+	return c.reportSpecResults(spec.Subjects, c.createSubjectsEvidence(spec.Subjects))
+}
+
+// prefetchExistingEvidence looks up the existing evidence of the subjects with repository paths in batches, one per
+// predicate type, rather than a query per subject. The other subjects are looked up by their own commands, once their
+// repository paths are resolved, as are the subjects with invalid paths, which their commands report.
+func (c *createEvidenceSpec) prefetchExistingEvidence(subjects []EvidenceSpecSubject) error {
+	subjectsByPredicateType := make(map[string][]string)
+	for _, subject := range subjects {
+		if _, _, _, err := splitSubjectRepoPath(subject.SubjectRepoPath); err == nil {
+			subjectsByPredicateType[subject.PredicateType] = append(subjectsByPredicateType[subject.PredicateType], subject.SubjectRepoPath)
+		}
+	}
+	c.existingEvidence = make(map[string]map[string][]subjectEvidence, len(subjectsByPredicateType))
+	for predicateType, subjectRepoPaths := range subjectsByPredicateType {
+		options := c.options
+		options.PredicateType = predicateType
+		base := newCreateEvidenceBase(c.serverDetails, options)
+		if err := base.prefetchExistingEvidence(subjectRepoPaths); err != nil {
+			return err
+		}
+		c.existingEvidence[predicateType] = base.existingEvidence
+	}
+	return nil
+}
+
+// getSubjectKey returns the signing keys and key aliases of the subject, falling back to those of the command.
+func (c *createEvidenceSpec) getSubjectKey(subject EvidenceSpecSubject) (keys, keyAliases []string) {
+	if subject.KeyAlias != "" {
+		keyAliases = []string{subject.KeyAlias}
+	}
+	if subject.Key != "" {
+		return []string{subject.Key}, keyAliases
+	}
+	if subject.KeyAlias != "" {
+		return c.options.Keys, keyAliases
+	}
+	return c.options.Keys, c.options.KeyIds
+}
+
+// validateSubjectsKeys loads the signing keys of all the subjects before any evidence is created, so an invalid key
+// doesn't leave the spec partially processed. Keys shared by several subjects are loaded once.
+func (c *createEvidenceSpec) validateSubjectsKeys(subjects []EvidenceSpecSubject) error {
+	type subjectKey struct{ keys, keyAliases string }
+	keyErrors := make(map[subjectKey]error)
+	var problems []string
+	for i, subject := range subjects {
+		keys, keyAliases := c.getSubjectKey(subject)
+		if len(keys) == 0 {
+			problems = append(problems, fmt.Sprintf("subject %d: no signing key. Set 'key' for the subject, or provide a key for all the subjects", i+1))
+			continue
+		}
+		loadedKey := subjectKey{strings.Join(keys, "\x00"), strings.Join(keyAliases, "\x00")}
+		err, loaded := keyErrors[loadedKey]
+		if !loaded {
+			_, err = loadEnvelopeSigners(keys, keyAliases, c.options.SkipKeyIdCheck)
+			keyErrors[loadedKey] = err
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("subject %d: %s", i+1, err.Error()))
+		}
+	}
+	if len(problems) > 0 {
+		return errorutils.CheckErrorf("failed to load the signing keys of the evidence spec:\n%s", strings.Join(problems, "\n"))
+	}
+	return nil
+}
+
+// describeSubjectKey describes the key signing the evidence of the subject, without the key itself, which may be the
+// content of a private key rather than a path.
+func (c *createEvidenceSpec) describeSubjectKey(subject EvidenceSpecSubject) string {
+	_, keyAliases := c.getSubjectKey(subject)
+	switch {
+	case len(keyAliases) == 1:
+		return fmt.Sprintf("key alias '%s'", keyAliases[0])
+	case len(keyAliases) > 1:
+		return fmt.Sprintf("key aliases '%s'", strings.Join(keyAliases, "', '"))
+	case subject.Key != "":
+		return "the key of the subject"
+	default:
+		return "the default key"
+	}
+}
+
+// createSubjectsEvidence runs the creation for each subject, and returns the results in the order of the subjects.
+func (c *createEvidenceSpec) createSubjectsEvidence(subjects []EvidenceSpecSubject) []specSubjectResult {
+	results := make([]specSubjectResult, len(subjects))
+	// All the tasks are queued before the runner starts, so its capacity must fit them all.
+	runner := parallel.NewRunner(c.threads, uint(len(subjects)), c.failFast)
+	for i, subject := range subjects {
+		// Each task writes only its own result, so the results need no locking.
+		_, err := runner.AddTask(func(int) error {
+			results[i].started = true
+			results[i].err = c.newSubjectCommand(subject).Run()
+			return results[i].err
+		})
+		if err != nil {
+			break
+		}
+	}
+	runner.Done()
+	runner.Run()
+	return results
+}
+
+func (c *createEvidenceSpec) createSubjectCommand(subject EvidenceSpecSubject) evidence.Command {
+	options := c.options
+	options.PredicateFilePath = subject.Predicate
+	options.PredicateType = subject.PredicateType
+	options.MarkdownFilePath = subject.Markdown
+	options.Annotations = subject.Annotations
+	options.Keys, options.KeyIds = c.getSubjectKey(subject)
+	switch {
+	case subject.SubjectRepoPath != "":
+		cmd := NewCreateEvidenceCustom(c.serverDetails, options, subject.SubjectRepoPath, subject.SubjectSha256, "", false)
+		cmd.(*createEvidenceCustom).existingEvidence = c.existingEvidence[subject.PredicateType]
+		return cmd
+	case subject.BuildName != "":
+		return NewCreateEvidenceBuild(c.serverDetails, options, subject.Project, subject.BuildName, subject.BuildNumber, "")
+	case subject.PackageName != "":
+		return NewCreateEvidencePackage(c.serverDetails, options, subject.PackageName, subject.PackageVersion, subject.PackageRepoName, false)
+	default:
+		return NewCreateEvidenceReleaseBundle(c.serverDetails, options, subject.Project, subject.ReleaseBundle, subject.ReleaseBundleVersion, "", "")
+	}
+}
+
+// reportSpecResults logs the outcome of each subject in the order of the spec, so the report doesn't depend on the order
+// in which the threads completed, and fails if the creation failed for any subject.
+func (c *createEvidenceSpec) reportSpecResults(subjects []EvidenceSpecSubject, results []specSubjectResult) error {
+	created := 0
+	var failures []string
+	for i, result := range results {
+		switch {
+		case !result.started:
+			clientlog.Warn(fmt.Sprintf("Subject %d (%s): skipped, as the creation for another subject failed", i+1, subjects[i]))
+		case result.err != nil:
+			failures = append(failures, fmt.Sprintf("subject %d (%s): %s", i+1, subjects[i], result.err.Error()))
+		default:
+			created++
+			clientlog.Info(fmt.Sprintf("Subject %d (%s): evidence created, signed with %s", i+1, subjects[i], c.describeSubjectKey(subjects[i])))
+		}
+	}
+	clientlog.Info(fmt.Sprintf("Evidence created for %d of %d subjects", created, len(subjects)))
+	if len(failures) > 0 {
+		return errorutils.CheckErrorf("failed to create evidence for %d of %d subjects:\n%s", len(failures), len(subjects), strings.Join(failures, "\n"))
+	}
+	return nil
+}
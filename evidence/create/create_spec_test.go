@@ -0,0 +1,198 @@
+package create
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockSubjectCommand struct {
+	err error
+	run func()
+}
+
+func (m *mockSubjectCommand) CommandName() string {
+	return "mock-subject"
+}
+
+func (m *mockSubjectCommand) ServerDetails() (*config.ServerDetails, error) {
+	return nil, nil
+}
+
+func (m *mockSubjectCommand) Run() error {
+	if m.run != nil {
+		m.run()
+	}
+	return m.err
+}
+
+func writeEvidenceSpec(t *testing.T, content string) string {
+	specPath := filepath.Join(t.TempDir(), "spec.json")
+	require.NoError(t, os.WriteFile(specPath, []byte(content), 0600))
+	return specPath
+}
+
+func TestLoadEvidenceSpec(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedCount int
+		errorContains string
+	}{
+		{
+			name: "Mixed subjects",
+			content: `{"subjects": [
+				{"subjectRepoPath": "libs-local/app.jar", "predicate": "p.json", "predicateType": "https://slsa.dev/provenance/v1"},
+				{"buildName": "app", "buildNumber": "1", "predicate": "p.json", "predicateType": "https://slsa.dev/provenance/v1"},
+				{"packageName": "app", "packageVersion": "1.0.0", "packageRepoName": "npm-local", "predicate": "p.json", "predicateType": "https://slsa.dev/provenance/v1"},
+				{"releaseBundle": "app", "releaseBundleVersion": "1.0.0", "predicate": "p.json", "predicateType": "https://slsa.dev/provenance/v1"}
+			]}`,
+			expectedCount: 4,
+		},
+		{
+			name:          "No subjects",
+			content:       `{"subjects": []}`,
+			errorContains: "has no subjects",
+		},
+		{
+			name:          "Invalid JSON",
+			content:       `{"subjects": [`,
+			errorContains: "failed to parse the evidence spec file",
+		},
+		{
+			name: "Invalid subjects",
+			content: `{"subjects": [
+				{"subjectRepoPath": "libs-local/app.jar", "buildName": "app", "predicate": "p.json", "predicateType": "t"},
+				{"packageName": "app", "predicate": "p.json"}
+			]}`,
+			errorContains: "subject 1: exactly one of subjectRepoPath, buildName, packageName and releaseBundle must be set, got: subjectRepoPath, buildName\n" +
+				"subject 2: missing required fields: predicateType, packageVersion, packageRepoName",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := loadEvidenceSpec(writeEvidenceSpec(t, tt.content))
+			if tt.errorContains != "" {
+				assert.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, spec.Subjects, tt.expectedCount)
+		})
+	}
+}
+
+func TestCreateSubjectsEvidence(t *testing.T) {
+	subjects := []EvidenceSpecSubject{
+		{SubjectRepoPath: "libs-local/a.jar"},
+		{SubjectRepoPath: "libs-local/b.jar"},
+		{SubjectRepoPath: "libs-local/c.jar"},
+	}
+	var mutex sync.Mutex
+	var runSubjects []string
+	c := &createEvidenceSpec{threads: 2}
+	c.newSubjectCommand = func(subject EvidenceSpecSubject) evidence.Command {
+		command := &mockSubjectCommand{run: func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+			runSubjects = append(runSubjects, subject.SubjectRepoPath)
+		}}
+		if subject.SubjectRepoPath == "libs-local/b.jar" {
+			command.err = errors.New("upload failed")
+		}
+		return command
+	}
+
+	results := c.createSubjectsEvidence(subjects)
+
+	assert.ElementsMatch(t, []string{"libs-local/a.jar", "libs-local/b.jar", "libs-local/c.jar"}, runSubjects)
+	assert.Equal(t, []specSubjectResult{{started: true}, {started: true, err: errors.New("upload failed")}, {started: true}}, results)
+	assert.EqualError(t, c.reportSpecResults(subjects, results), "failed to create evidence for 1 of 3 subjects:\nsubject 2 (artifact 'libs-local/b.jar'): upload failed")
+}
+
+func TestCreateSubjectsEvidence_FailFast(t *testing.T) {
+	subjects := []EvidenceSpecSubject{
+		{BuildName: "app", BuildNumber: "1"},
+		{BuildName: "app", BuildNumber: "2"},
+		{BuildName: "app", BuildNumber: "3"},
+	}
+	c := &createEvidenceSpec{threads: 1, failFast: true}
+	c.newSubjectCommand = func(EvidenceSpecSubject) evidence.Command {
+		return &mockSubjectCommand{err: errors.New("build not found")}
+	}
+
+	results := c.createSubjectsEvidence(subjects)
+
+	// With a single thread, the first failure cancels the subjects which weren't started.
+	assert.Equal(t, []specSubjectResult{{started: true, err: errors.New("build not found")}, {}, {}}, results)
+	assert.EqualError(t, c.reportSpecResults(subjects, results), "failed to create evidence for 1 of 3 subjects:\nsubject 1 (build 'app:1'): build not found")
+}
+
+func TestGetSubjectKey(t *testing.T) {
+	c := &createEvidenceSpec{options: CreateEvidenceOptions{Keys: []string{"default.key"}, KeyIds: []string{"default-alias"}}}
+	tests := []struct {
+		name               string
+		subject            EvidenceSpecSubject
+		expectedKeys       []string
+		expectedKeyAliases []string
+		expectedDesc       string
+	}{
+		{name: "Default key", subject: EvidenceSpecSubject{}, expectedKeys: []string{"default.key"}, expectedKeyAliases: []string{"default-alias"}, expectedDesc: "key alias 'default-alias'"},
+		{name: "Subject key alias", subject: EvidenceSpecSubject{KeyAlias: "qa-key"}, expectedKeys: []string{"default.key"}, expectedKeyAliases: []string{"qa-key"}, expectedDesc: "key alias 'qa-key'"},
+		{name: "Subject key", subject: EvidenceSpecSubject{Key: "release.key", KeyAlias: "release-key"}, expectedKeys: []string{"release.key"}, expectedKeyAliases: []string{"release-key"}, expectedDesc: "key alias 'release-key'"},
+		{name: "Subject key without alias", subject: EvidenceSpecSubject{Key: "release.key"}, expectedKeys: []string{"release.key"}, expectedDesc: "the key of the subject"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, keyAliases := c.getSubjectKey(tt.subject)
+			assert.Equal(t, tt.expectedKeys, keys)
+			assert.Equal(t, tt.expectedKeyAliases, keyAliases)
+			assert.Equal(t, tt.expectedDesc, c.describeSubjectKey(tt.subject))
+		})
+	}
+	assert.Equal(t, "the default key", (&createEvidenceSpec{options: CreateEvidenceOptions{Keys: []string{"default.key"}}}).describeSubjectKey(EvidenceSpecSubject{}))
+	coSigning := &createEvidenceSpec{options: CreateEvidenceOptions{Keys: []string{"release.key", "qa.key"}, KeyIds: []string{"release-key", "qa-key"}}}
+	assert.Equal(t, "key aliases 'release-key', 'qa-key'", coSigning.describeSubjectKey(EvidenceSpecSubject{}))
+}
+
+func TestCreateSubjectCommand(t *testing.T) {
+	c := &createEvidenceSpec{options: CreateEvidenceOptions{Keys: []string{"default.key"}, KeyIds: []string{"default-alias"}, TsaUrl: "https://tsa.example.com", ProviderId: "provider", Force: true}}
+	cmd := c.createSubjectCommand(EvidenceSpecSubject{SubjectRepoPath: "libs-local/a.jar", Predicate: "predicate.json", PredicateType: "https://slsa.dev/provenance/v1",
+		Markdown: "notes.md", Annotations: "team=build", KeyAlias: "qa-key"})
+
+	custom, ok := cmd.(*createEvidenceCustom)
+	require.True(t, ok)
+	assert.Equal(t, "libs-local/a.jar", custom.subjectRepoPath)
+	assert.Equal(t, newCreateEvidenceBase(nil, CreateEvidenceOptions{PredicateFilePath: "predicate.json", PredicateType: "https://slsa.dev/provenance/v1",
+		MarkdownFilePath: "notes.md", Keys: []string{"default.key"}, KeyIds: []string{"qa-key"}, TsaUrl: "https://tsa.example.com", Annotations: "team=build", ProviderId: "provider", Force: true}),
+		custom.createEvidenceBase)
+}
+
+func TestValidateSubjectsKeys(t *testing.T) {
+	ed25519Key := filepath.Join("..", "cryptox", "testdata", "ed25519-test-key-pem")
+	ecdsaKey := filepath.Join("..", "cryptox", "testdata", "ecdsa-test-key-pem")
+
+	c := &createEvidenceSpec{options: CreateEvidenceOptions{Keys: []string{ed25519Key}}}
+	assert.NoError(t, c.validateSubjectsKeys([]EvidenceSpecSubject{
+		{SubjectRepoPath: "libs-local/a.jar"},
+		{SubjectRepoPath: "libs-local/b.jar", Key: ecdsaKey, KeyAlias: "release-key"},
+	}))
+
+	c = &createEvidenceSpec{}
+	err := c.validateSubjectsKeys([]EvidenceSpecSubject{
+		{SubjectRepoPath: "libs-local/a.jar", Key: ecdsaKey},
+		{SubjectRepoPath: "libs-local/b.jar"},
+		{SubjectRepoPath: "libs-local/c.jar", Key: "not-a-key"},
+	})
+	assert.ErrorContains(t, err, "failed to load the signing keys of the evidence spec:\n"+
+		"subject 2: no signing key. Set 'key' for the subject, or provide a key for all the subjects\n"+
+		"subject 3: ")
+}
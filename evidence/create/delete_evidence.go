@@ -0,0 +1,135 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type deleteEvidenceCommand struct {
+	createEvidenceBase
+	// Optional, used to choose between several evidence with the same predicate type on the subject.
+	createdAt string
+	quiet     bool
+	resolve   subjectResolver
+}
+
+func NewDeleteEvidenceCustom(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, predicateType, createdAt string, quiet bool, subjectRepoPath, subjectSha256 string) evidence.Command {
+	custom := &createEvidenceCustom{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		subjectRepoPath:    subjectRepoPath,
+		subjectSha256:      subjectSha256,
+	}
+	return newDeleteEvidence(serverDetails, clientOptions, predicateType, createdAt, quiet, custom.buildCustomSubjectPath)
+}
+
+func NewDeleteEvidenceReleaseBundle(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, predicateType, createdAt string, quiet bool, project, releaseBundle, releaseBundleVersion string) evidence.Command {
+	rb := &createEvidenceReleaseBundle{
+		createEvidenceBase:   createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		project:              project,
+		releaseBundle:        releaseBundle,
+		releaseBundleVersion: releaseBundleVersion,
+	}
+	return newDeleteEvidence(serverDetails, clientOptions, predicateType, createdAt, quiet, rb.buildReleaseBundleSubjectPath)
+}
+
+func NewDeleteEvidenceBuild(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, predicateType, createdAt string, quiet bool, project, buildName, buildNumber string) evidence.Command {
+	build := &createEvidenceBuild{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		project:            project,
+		buildName:          buildName,
+		buildNumber:        buildNumber,
+	}
+	return newDeleteEvidence(serverDetails, clientOptions, predicateType, createdAt, quiet, build.buildBuildInfoSubjectPath)
+}
+
+func NewDeleteEvidencePackage(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, predicateType, createdAt string, quiet bool, packageName, packageVersion, packageRepoName string) evidence.Command {
+	pkg := &createEvidencePackage{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		packageService:     evidence.NewPackageService(packageName, packageVersion, packageRepoName),
+	}
+	return newDeleteEvidence(serverDetails, clientOptions, predicateType, createdAt, quiet, pkg.buildPackageSubjectPath)
+}
+
+func newDeleteEvidence(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, predicateType, createdAt string, quiet bool, resolve subjectResolver) *deleteEvidenceCommand {
+	return &deleteEvidenceCommand{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions, predicateType: predicateType},
+		createdAt:          createdAt,
+		quiet:              quiet,
+		resolve:            resolve,
+	}
+}
+
+func (d *deleteEvidenceCommand) CommandName() string {
+	return "delete-evidence"
+}
+
+func (d *deleteEvidenceCommand) ServerDetails() (*config.ServerDetails, error) {
+	return d.serverDetails, nil
+}
+
+func (d *deleteEvidenceCommand) Run() error {
+	artifactoryClient, err := d.createArtifactoryClient()
+	if err != nil {
+		log.Error("failed to create Artifactory client", err)
+		return err
+	}
+	subject, _, err := d.resolve(artifactoryClient)
+	if err != nil {
+		return err
+	}
+	onemodelClient, err := evdUtils.CreateOnemodelServiceManager(d.serverDetails, d.clientOptions)
+	if err != nil {
+		return err
+	}
+	existingEvidence, err := findExistingEvidence(onemodelClient, subject, d.predicateType)
+	if err != nil {
+		return err
+	}
+	evidenceToDelete, err := selectEvidenceToDelete(existingEvidence, subject, d.predicateType, d.createdAt)
+	if err != nil {
+		return err
+	}
+	if !d.quiet && !coreutils.AskYesNo(fmt.Sprintf("Are you sure you want to delete the evidence '%s'?\n"+
+		"You can avoid this confirmation message by adding --quiet to the command.", evidenceToDelete.DownloadPath), false) {
+		return nil
+	}
+	if err = deleteEvidence(artifactoryClient, evidenceToDelete.DownloadPath); err != nil {
+		return err
+	}
+	log.Info("Evidence successfully deleted:", evidenceToDelete.DownloadPath)
+	return nil
+}
+
+// selectEvidenceToDelete returns the single evidence matching the creation time, if provided.
+// It fails if no evidence matches, or if several do and the creation time is needed to choose between them.
+func selectEvidenceToDelete(existingEvidence []subjectEvidence, subject, predicateType, createdAt string) (subjectEvidence, error) {
+	var matches []subjectEvidence
+	for _, evd := range existingEvidence {
+		if createdAt == "" || evd.CreatedAt == createdAt {
+			matches = append(matches, evd)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		if createdAt != "" {
+			return subjectEvidence{}, errorutils.CheckErrorf("no evidence with predicate type '%s' created at '%s' was found for subject '%s'", predicateType, createdAt, subject)
+		}
+		return subjectEvidence{}, errorutils.CheckErrorf("no evidence with predicate type '%s' was found for subject '%s'", predicateType, subject)
+	case 1:
+		return matches[0], nil
+	default:
+		createdAtValues := make([]string, 0, len(matches))
+		for _, evd := range matches {
+			createdAtValues = append(createdAtValues, evd.CreatedAt)
+		}
+		return subjectEvidence{}, errorutils.CheckErrorf("found %d evidence with predicate type '%s' for subject '%s', use --created-at to choose one of: %s",
+			len(matches), predicateType, subject, strings.Join(createdAtValues, ", "))
+	}
+}
@@ -0,0 +1,59 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectEvidenceToDelete(t *testing.T) {
+	first := subjectEvidence{PredicateType: "type", DownloadPath: "repo/.evidence/file/first.json", CreatedAt: "2025-01-01T10:00:00.000Z"}
+	second := subjectEvidence{PredicateType: "type", DownloadPath: "repo/.evidence/file/second.json", CreatedAt: "2025-02-01T10:00:00.000Z"}
+
+	tests := []struct {
+		name             string
+		existingEvidence []subjectEvidence
+		createdAt        string
+		expected         subjectEvidence
+		expectedError    string
+	}{
+		{
+			name:             "Single evidence",
+			existingEvidence: []subjectEvidence{first},
+			expected:         first,
+		},
+		{
+			name:             "Several evidence disambiguated by creation time",
+			existingEvidence: []subjectEvidence{first, second},
+			createdAt:        second.CreatedAt,
+			expected:         second,
+		},
+		{
+			name:             "Several evidence without creation time",
+			existingEvidence: []subjectEvidence{first, second},
+			expectedError:    "found 2 evidence with predicate type 'type' for subject 'repo/file', use --created-at to choose one of: 2025-01-01T10:00:00.000Z, 2025-02-01T10:00:00.000Z",
+		},
+		{
+			name:          "No evidence",
+			expectedError: "no evidence with predicate type 'type' was found for subject 'repo/file'",
+		},
+		{
+			name:             "No evidence created at the given time",
+			existingEvidence: []subjectEvidence{first},
+			createdAt:        second.CreatedAt,
+			expectedError:    "no evidence with predicate type 'type' created at '2025-02-01T10:00:00.000Z' was found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := selectEvidenceToDelete(tt.existingEvidence, "repo/file", "type", tt.createdAt)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, selected)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package create
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	detachedSignatureFileName = "evidence.sig"
+	detachedPayloadFileName   = "evidence.payload"
+)
+
+// getDetachedSignature returns the raw signature of the envelope, and the canonicalized payload it was computed over.
+// Following the DSSE protocol (https://github.com/secure-systems-lab/dsse/blob/master/protocol.md), the signed
+// payload is the Pre-Authentication Encoding (PAE) of the in-toto statement:
+//
+//	"DSSEv1" SP LEN(payloadType) SP payloadType SP LEN(payload) SP payload
+//
+// where SP is a single space, LEN is the length in bytes as an ASCII decimal, payloadType is
+// "application/vnd.in-toto+json", and payload is the statement JSON exactly as embedded (base64 encoded) in the envelope.
+// An external verifier can therefore check the signature against the payload file without any knowledge of DSSE,
+// e.g. 'openssl dgst -sha256 -verify public.pem -signature evidence.sig evidence.payload' for P-256 ECDSA keys.
+func getDetachedSignature(envelope *dsse.Envelope) (signature, signedPayload []byte, err error) {
+	if len(envelope.Signatures) != 1 {
+		return nil, nil, errorutils.CheckErrorf("expected the envelope to contain a single signature, found %d", len(envelope.Signatures))
+	}
+	signature, err = base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	return signature, dsse.PAE(envelope.PayloadType, payload), nil
+}
+
+func (c *createEvidenceBase) writeDetachedSignature(envelope *dsse.Envelope) error {
+	if c.detachedSignatureDir == "" {
+		return nil
+	}
+	signature, signedPayload, err := getDetachedSignature(envelope)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(c.detachedSignatureDir, 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	signaturePath := filepath.Join(c.detachedSignatureDir, detachedSignatureFileName)
+	if err = os.WriteFile(signaturePath, signature, 0644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	payloadPath := filepath.Join(c.detachedSignatureDir, detachedPayloadFileName)
+	if err = os.WriteFile(payloadPath, signedPayload, 0644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	clientlog.Info("Detached signature written to:", signaturePath, "signed payload written to:", payloadPath)
+	return nil
+}
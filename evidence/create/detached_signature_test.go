@@ -0,0 +1,49 @@
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteDetachedSignature(t *testing.T) {
+	keyContent, err := os.ReadFile(filepath.Join("../..", "tests/testdata/ecdsa_key.pem"))
+	require.NoError(t, err)
+	payload := []byte(`{"foo": "bar"}`)
+	envelope, err := createAndSignEnvelope(payload, []string{string(keyContent)}, []string{"test-key-id"}, false)
+	require.NoError(t, err)
+
+	outputDir := filepath.Join(t.TempDir(), "detached")
+	c := &createEvidenceBase{detachedSignatureDir: outputDir}
+	require.NoError(t, c.writeDetachedSignature(envelope))
+
+	signature, err := os.ReadFile(filepath.Join(outputDir, detachedSignatureFileName))
+	require.NoError(t, err)
+	signedPayload, err := os.ReadFile(filepath.Join(outputDir, detachedPayloadFileName))
+	require.NoError(t, err)
+	assert.Equal(t, dsse.PAE(intoto.PayloadType, payload), signedPayload)
+
+	// The detached signature must be verifiable against the payload file alone.
+	privateKey, err := cryptox.ReadKey(keyContent)
+	require.NoError(t, err)
+	verifier, err := cryptox.NewECDSASignerVerifierFromSSLibKey(privateKey)
+	require.NoError(t, err)
+	assert.NoError(t, verifier.Verify(signedPayload, signature))
+}
+
+func TestWriteDetachedSignature_NotRequested(t *testing.T) {
+	c := &createEvidenceBase{}
+	assert.NoError(t, c.writeDetachedSignature(&dsse.Envelope{}))
+}
+
+func TestGetDetachedSignature_MultipleSignatures(t *testing.T) {
+	envelope := &dsse.Envelope{Signatures: []dsse.Signature{{Sig: "c2ln"}, {Sig: "c2ln"}}}
+	_, _, err := getDetachedSignature(envelope)
+	assert.ErrorContains(t, err, "single signature")
+}
@@ -0,0 +1,255 @@
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The subject is passed in the variables of the query, so its repository key, path and name are never part of the query text.
+const searchSubjectEvidenceGraphqlQuery = `query ($repositoryKey: String!, $path: String!, $name: String!) { evidence { searchEvidence( where: { hasSubjectWith: { repositoryKey: $repositoryKey, path: $path, name: $name}} ) { edges { node { predicateType downloadPath createdAt } } } } }`
+
+// A query searching the evidence of several subjects at once, with one aliased search field per subject. The variables
+// of each subject are suffixed with the index of the subject.
+const (
+	searchSubjectsEvidenceGraphqlQuery         = `query (%s) { evidence { %s } }`
+	searchSubjectEvidenceAliasedGraphqlField   = `%s: searchEvidence( where: { hasSubjectWith: { repositoryKey: $repositoryKey%[2]d, path: $path%[2]d, name: $name%[2]d}} ) { edges { node { predicateType downloadPath createdAt } } }`
+	searchSubjectEvidenceGraphqlVariablesTypes = `$repositoryKey%[1]d: String!, $path%[1]d: String!, $name%[1]d: String!`
+	subjectSearchAlias                         = "s%d"
+)
+
+// graphqlRequest is the body of a GraphQL request.
+type graphqlRequest struct {
+	Query     string            `json:"query"`
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+type subjectEvidence struct {
+	PredicateType string `json:"predicateType"`
+	DownloadPath  string `json:"downloadPath"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+type subjectEvidenceEdge struct {
+	Node subjectEvidence `json:"node"`
+}
+
+type subjectEvidenceSearch struct {
+	Edges []subjectEvidenceEdge `json:"edges"`
+}
+
+type subjectEvidenceResponse struct {
+	Data struct {
+		Evidence struct {
+			SearchEvidence subjectEvidenceSearch `json:"searchEvidence"`
+		} `json:"evidence"`
+	} `json:"data"`
+}
+
+// subjectsEvidenceResponse holds the searches of several subjects, by the alias of each search.
+type subjectsEvidenceResponse struct {
+	Data struct {
+		Evidence map[string]subjectEvidenceSearch `json:"evidence"`
+	} `json:"data"`
+}
+
+// The maximal number of subjects whose existing evidence is looked up by a single GraphQL query.
+const existingEvidenceLookupBatchSize = 50
+
+// needsExistingEvidence reports whether the evidence already attached to the subject affects the upload.
+func (c *createEvidenceBase) needsExistingEvidence() bool {
+	// The predicate type is unknown before uploading when the evidence is read from a sigstore bundle.
+	return c.predicateType != "" && (c.force || c.failOnExisting || c.skipIdentical)
+}
+
+// handleExistingEvidence looks for evidence with the same predicate type which is already attached to the subject.
+// With --skip-identical, an existing evidence identical to the new one is reported as already present, and the upload is skipped.
+// With --fail-on-existing such evidence fails the command, and with --force it is returned, to be deleted once the new evidence is uploaded.
+// Otherwise, the new evidence is added next to the existing evidence.
+func (c *createEvidenceBase) handleExistingEvidence(subjectRepoPath string, evidencePayload []byte) (supersededEvidence []subjectEvidence, alreadyPresent bool, err error) {
+	if !c.needsExistingEvidence() {
+		return nil, false, nil
+	}
+	existingEvidence, err := c.getExistingEvidence(subjectRepoPath)
+	if err != nil || len(existingEvidence) == 0 {
+		return nil, false, err
+	}
+	if c.skipIdentical {
+		artifactoryClient, err := c.createArtifactoryClient()
+		if err != nil {
+			return nil, false, err
+		}
+		if identicalEvidencePath := findIdenticalEvidence(artifactoryClient, existingEvidence, evidencePayload); identicalEvidencePath != "" {
+			clientlog.Info(fmt.Sprintf("Evidence with predicate type '%s' and the same predicate is already present for subject '%s': %s. Skipping the upload.", c.predicateType, subjectRepoPath, identicalEvidencePath))
+			return nil, true, nil
+		}
+	}
+	if c.force {
+		return existingEvidence, false, nil
+	}
+	if c.failOnExisting {
+		return nil, false, errorutils.CheckErrorf("evidence with predicate type '%s' already exists for subject '%s', use --force to replace it", c.predicateType, subjectRepoPath)
+	}
+	return nil, false, nil
+}
+
+// getExistingEvidence returns the evidence with the predicate type of the command attached to the subject, from the
+// evidence looked up for all the subjects of the run when available.
+func (c *createEvidenceBase) getExistingEvidence(subjectRepoPath string) ([]subjectEvidence, error) {
+	if existingEvidence, ok := c.existingEvidence[subjectRepoPath]; ok {
+		return existingEvidence, nil
+	}
+	onemodelClient, err := evdUtils.CreateOnemodelServiceManager(c.serverDetails, c.clientOptions)
+	if err != nil {
+		return nil, err
+	}
+	return findExistingEvidence(onemodelClient, subjectRepoPath, c.predicateType)
+}
+
+// prefetchExistingEvidence looks up the existing evidence of all the subjects of the run in batches, rather than a
+// query per subject, when it affects the upload.
+func (c *createEvidenceBase) prefetchExistingEvidence(subjectRepoPaths []string) error {
+	if !c.needsExistingEvidence() {
+		return nil
+	}
+	onemodelClient, err := evdUtils.CreateOnemodelServiceManager(c.serverDetails, c.clientOptions)
+	if err != nil {
+		return err
+	}
+	c.existingEvidence, err = findSubjectsExistingEvidence(onemodelClient, subjectRepoPaths, c.predicateType)
+	return err
+}
+
+// supersedeEvidence uploads the new evidence, and only then deletes the existing evidence it replaces, so a failed
+// upload leaves the existing evidence in place.
+func (c *createEvidenceBase) supersedeEvidence(supersededEvidence []subjectEvidence, upload func() error) error {
+	if err := upload(); err != nil {
+		return err
+	}
+	if len(supersededEvidence) == 0 {
+		return nil
+	}
+	artifactoryClient, err := c.createArtifactoryClient()
+	if err != nil {
+		return err
+	}
+	return deleteSupersededEvidence(artifactoryClient, supersededEvidence)
+}
+
+func deleteSupersededEvidence(artifactoryClient artifactory.ArtifactoryServicesManager, supersededEvidence []subjectEvidence) error {
+	for _, evidence := range supersededEvidence {
+		clientlog.Info("Deleting the replaced evidence:", evidence.DownloadPath)
+		if err := deleteEvidence(artifactoryClient, evidence.DownloadPath); err != nil {
+			return errorutils.CheckErrorf("the new evidence was uploaded, but deleting the evidence it replaces '%s' failed: %s", evidence.DownloadPath, err.Error())
+		}
+	}
+	return nil
+}
+
+// findExistingEvidence returns the evidence with the given predicate type which is attached to the subject.
+func findExistingEvidence(onemodelClient onemodel.Manager, subjectRepoPath, predicateType string) ([]subjectEvidence, error) {
+	repoKey, subjectPath, name, err := splitSubjectRepoPath(subjectRepoPath)
+	if err != nil {
+		return nil, err
+	}
+	query, err := json.Marshal(graphqlRequest{
+		Query:     searchSubjectEvidenceGraphqlQuery,
+		Variables: map[string]string{"repositoryKey": repoKey, "path": subjectPath, "name": name},
+	})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	clientlog.Debug("GraphQL query:", string(query))
+	rawResponse, err := onemodelClient.GraphqlQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	var response subjectEvidenceResponse
+	if err = json.Unmarshal(rawResponse, &response); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the evidence search response: %s", err.Error())
+	}
+	return filterEvidenceByPredicateType(response.Data.Evidence.SearchEvidence.Edges, predicateType), nil
+}
+
+// findSubjectsExistingEvidence returns the evidence with the given predicate type attached to each of the subjects,
+// by subject. The subjects are looked up in batches, each searched by an aliased field of a single GraphQL query.
+func findSubjectsExistingEvidence(onemodelClient onemodel.Manager, subjectRepoPaths []string, predicateType string) (map[string][]subjectEvidence, error) {
+	existingEvidence := make(map[string][]subjectEvidence, len(subjectRepoPaths))
+	for start := 0; start < len(subjectRepoPaths); start += existingEvidenceLookupBatchSize {
+		batch := subjectRepoPaths[start:min(start+existingEvidenceLookupBatchSize, len(subjectRepoPaths))]
+		var searches, variablesTypes []string
+		variables := make(map[string]string, 3*len(batch))
+		for i, subjectRepoPath := range batch {
+			repoKey, subjectPath, name, err := splitSubjectRepoPath(subjectRepoPath)
+			if err != nil {
+				return nil, err
+			}
+			searches = append(searches, fmt.Sprintf(searchSubjectEvidenceAliasedGraphqlField, fmt.Sprintf(subjectSearchAlias, i), i))
+			variablesTypes = append(variablesTypes, fmt.Sprintf(searchSubjectEvidenceGraphqlVariablesTypes, i))
+			variables[fmt.Sprintf("repositoryKey%d", i)] = repoKey
+			variables[fmt.Sprintf("path%d", i)] = subjectPath
+			variables[fmt.Sprintf("name%d", i)] = name
+		}
+		query, err := json.Marshal(graphqlRequest{
+			Query:     fmt.Sprintf(searchSubjectsEvidenceGraphqlQuery, strings.Join(variablesTypes, ", "), strings.Join(searches, " ")),
+			Variables: variables,
+		})
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		clientlog.Debug("GraphQL query:", string(query))
+		rawResponse, err := onemodelClient.GraphqlQuery(query)
+		if err != nil {
+			return nil, err
+		}
+		var response subjectsEvidenceResponse
+		if err = json.Unmarshal(rawResponse, &response); err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the evidence search response: %s", err.Error())
+		}
+		for i, subjectRepoPath := range batch {
+			existingEvidence[subjectRepoPath] = filterEvidenceByPredicateType(response.Data.Evidence[fmt.Sprintf(subjectSearchAlias, i)].Edges, predicateType)
+		}
+	}
+	return existingEvidence, nil
+}
+
+func filterEvidenceByPredicateType(edges []subjectEvidenceEdge, predicateType string) []subjectEvidence {
+	var existingEvidence []subjectEvidence
+	for _, edge := range edges {
+		if edge.Node.PredicateType == predicateType {
+			existingEvidence = append(existingEvidence, edge.Node)
+		}
+	}
+	return existingEvidence
+}
+
+func splitSubjectRepoPath(subjectRepoPath string) (repoKey, subjectPath, name string, err error) {
+	repoKey, pathAndName, found := strings.Cut(subjectRepoPath, "/")
+	if !found || repoKey == "" || pathAndName == "" {
+		return "", "", "", errorutils.CheckErrorf("invalid subject '%s', expected format '<repo>/<path>/<name>'", subjectRepoPath)
+	}
+	subjectPath = path.Dir(pathAndName)
+	if subjectPath == "." {
+		subjectPath = ""
+	}
+	return repoKey, subjectPath, path.Base(pathAndName), nil
+}
+
+// deleteEvidence deletes the evidence file, which is stored in Artifactory next to its subject.
+func deleteEvidence(artifactoryClient artifactory.ArtifactoryServicesManager, evidencePath string) error {
+	rtDetails := artifactoryClient.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	resp, body, err := artifactoryClient.Client().SendDelete(rtDetails.GetUrl()+strings.TrimPrefix(evidencePath, "/"), nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
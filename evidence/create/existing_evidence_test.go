@@ -0,0 +1,196 @@
+package create
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOnemodelManager struct {
+	response []byte
+	err      error
+	query    []byte
+}
+
+func (m *mockOnemodelManager) GraphqlQuery(query []byte) ([]byte, error) {
+	m.query = query
+	return m.response, m.err
+}
+
+func (m *mockOnemodelManager) getRequest(t *testing.T) graphqlRequest {
+	var request graphqlRequest
+	require.NoError(t, json.Unmarshal(m.query, &request))
+	return request
+}
+
+const subjectEvidenceResponseJson = `{"data":{"evidence":{"searchEvidence":{"edges":[
+	{"node":{"predicateType":"https://slsa.dev/provenance/v1","downloadPath":"repo/.evidence/path/file.txt/provenance.json","createdAt":"2025-01-01T10:00:00.000Z"}},
+	{"node":{"predicateType":"https://in-toto.io/attestation/test-result/v0.1","downloadPath":"repo/.evidence/path/file.txt/test-result.json"}}
+]}}}}`
+
+func TestFindExistingEvidence(t *testing.T) {
+	tests := []struct {
+		name             string
+		predicateType    string
+		expectedEvidence []subjectEvidence
+	}{
+		{
+			name:          "Matching predicate type",
+			predicateType: "https://slsa.dev/provenance/v1",
+			expectedEvidence: []subjectEvidence{{
+				PredicateType: "https://slsa.dev/provenance/v1",
+				DownloadPath:  "repo/.evidence/path/file.txt/provenance.json",
+				CreatedAt:     "2025-01-01T10:00:00.000Z",
+			}},
+		},
+		{
+			name:          "No matching predicate type",
+			predicateType: "https://example.com/predicate/v1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			onemodelClient := &mockOnemodelManager{response: []byte(subjectEvidenceResponseJson)}
+			existingEvidence, err := findExistingEvidence(onemodelClient, "repo/path/file.txt", tt.predicateType)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedEvidence, existingEvidence)
+			request := onemodelClient.getRequest(t)
+			assert.Equal(t, searchSubjectEvidenceGraphqlQuery, request.Query)
+			assert.Equal(t, map[string]string{"repositoryKey": "repo", "path": "path", "name": "file.txt"}, request.Variables)
+		})
+	}
+}
+
+func TestFindExistingEvidence_QuotedSubject(t *testing.T) {
+	onemodelClient := &mockOnemodelManager{response: []byte(subjectEvidenceResponseJson)}
+	_, err := findExistingEvidence(onemodelClient, `repo/dir"} ) { x } #/file\".txt`, "type")
+	assert.NoError(t, err)
+	request := onemodelClient.getRequest(t)
+	// The subject is only sent in the variables, so it can't change the query
+	assert.Equal(t, searchSubjectEvidenceGraphqlQuery, request.Query)
+	assert.Equal(t, map[string]string{"repositoryKey": "repo", "path": `dir"} ) { x } #`, "name": `file\".txt`}, request.Variables)
+}
+
+func TestFindExistingEvidence_Errors(t *testing.T) {
+	_, err := findExistingEvidence(&mockOnemodelManager{}, "file.txt", "type")
+	assert.ErrorContains(t, err, "invalid subject")
+
+	_, err = findExistingEvidence(&mockOnemodelManager{err: errors.New("query failed")}, "repo/file.txt", "type")
+	assert.EqualError(t, err, "query failed")
+
+	_, err = findExistingEvidence(&mockOnemodelManager{response: []byte("not json")}, "repo/file.txt", "type")
+	assert.ErrorContains(t, err, "failed to parse the evidence search response")
+}
+
+func TestFindSubjectsExistingEvidence(t *testing.T) {
+	onemodelClient := &mockOnemodelManager{response: []byte(`{"data":{"evidence":{
+		"s0":{"edges":[{"node":{"predicateType":"https://slsa.dev/provenance/v1","downloadPath":"repo/.evidence/a.txt/provenance.json"}}]},
+		"s1":{"edges":[{"node":{"predicateType":"https://in-toto.io/attestation/test-result/v0.1","downloadPath":"repo/.evidence/dir/b.txt/test-result.json"}}]}
+	}}}`)}
+	existingEvidence, err := findSubjectsExistingEvidence(onemodelClient, []string{"repo/a.txt", "repo/dir/b.txt"}, "https://slsa.dev/provenance/v1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]subjectEvidence{
+		"repo/a.txt":     {{PredicateType: "https://slsa.dev/provenance/v1", DownloadPath: "repo/.evidence/a.txt/provenance.json"}},
+		"repo/dir/b.txt": nil,
+	}, existingEvidence)
+	request := onemodelClient.getRequest(t)
+	assert.Contains(t, request.Query, `query ($repositoryKey0: String!, $path0: String!, $name0: String!, $repositoryKey1: String!, $path1: String!, $name1: String!)`)
+	assert.Contains(t, request.Query, `s0: searchEvidence( where: { hasSubjectWith: { repositoryKey: $repositoryKey0, path: $path0, name: $name0}} )`)
+	assert.Contains(t, request.Query, `s1: searchEvidence( where: { hasSubjectWith: { repositoryKey: $repositoryKey1, path: $path1, name: $name1}} )`)
+	assert.Equal(t, map[string]string{
+		"repositoryKey0": "repo", "path0": "", "name0": "a.txt",
+		"repositoryKey1": "repo", "path1": "dir", "name1": "b.txt",
+	}, request.Variables)
+}
+
+func TestHandleExistingEvidence(t *testing.T) {
+	existingEvidence := map[string][]subjectEvidence{"repo/file.txt": {{PredicateType: "type", DownloadPath: "repo/.evidence/file.txt/type.json"}}}
+	tests := []struct {
+		name               string
+		c                  *createEvidenceBase
+		expectedSuperseded []subjectEvidence
+		expectedError      string
+	}{
+		{
+			name: "No predicate type",
+			c:    &createEvidenceBase{force: true},
+		},
+		{
+			name: "Added next to the existing evidence by default",
+			c:    &createEvidenceBase{predicateType: "type"},
+		},
+		{
+			name:               "Force",
+			c:                  &createEvidenceBase{predicateType: "type", force: true, existingEvidence: existingEvidence},
+			expectedSuperseded: existingEvidence["repo/file.txt"],
+		},
+		{
+			name:          "Fail on existing",
+			c:             &createEvidenceBase{predicateType: "type", failOnExisting: true, existingEvidence: existingEvidence},
+			expectedError: "evidence with predicate type 'type' already exists for subject 'repo/file.txt', use --force to replace it",
+		},
+		{
+			name: "Fail on existing without existing evidence",
+			c:    &createEvidenceBase{predicateType: "type", failOnExisting: true, existingEvidence: map[string][]subjectEvidence{"repo/file.txt": nil}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supersededEvidence, alreadyPresent, err := tt.c.handleExistingEvidence("repo/file.txt", nil)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.False(t, alreadyPresent)
+			assert.Equal(t, tt.expectedSuperseded, supersededEvidence)
+		})
+	}
+}
+
+func TestSupersedeEvidence(t *testing.T) {
+	supersededEvidence := []subjectEvidence{{PredicateType: "type", DownloadPath: "repo/.evidence/file.txt/type.json"}}
+	tests := []struct {
+		name            string
+		uploadErr       error
+		expectedDeletes []string
+	}{
+		{
+			name:            "Upload succeeded",
+			expectedDeletes: []string{"/repo/.evidence/file.txt/type.json"},
+		},
+		{
+			name:      "Upload failed",
+			uploadErr: errors.New("upload failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deletes []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					deletes = append(deletes, r.URL.Path)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			c := &createEvidenceBase{serverDetails: &config.ServerDetails{ArtifactoryUrl: server.URL + "/"}}
+			var uploaded bool
+			err := c.supersedeEvidence(supersededEvidence, func() error {
+				uploaded = true
+				return tt.uploadErr
+			})
+			assert.True(t, uploaded)
+			assert.Equal(t, tt.uploadErr, err)
+			assert.Equal(t, tt.expectedDeletes, deletes)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package create
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// evidenceFingerprint identifies what an evidence attests: its subject, predicate type and predicate. The time, the
+// creator and the signatures of the evidence aren't part of it, so an evidence created again for the same subject and
+// predicate, such as by a retried pipeline, has the same fingerprint.
+type evidenceFingerprint struct {
+	subjectSha256 string
+	predicateType string
+	predicateHash string
+}
+
+// getEvidenceFingerprint returns the fingerprint of a DSSE envelope with an in-toto statement.
+func getEvidenceFingerprint(envelopeJson []byte) (*evidenceFingerprint, error) {
+	var envelope dsse.Envelope
+	if err := json.Unmarshal(envelopeJson, &envelope); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the evidence envelope: %s", err.Error())
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to decode the evidence envelope payload: %s", err.Error())
+	}
+	var statement intoto.Statement
+	if err = json.Unmarshal(payload, &statement); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the evidence statement: %s", err.Error())
+	}
+	predicateHash, err := hashPredicate(statement.Predicate)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := &evidenceFingerprint{predicateType: statement.PredicateType, predicateHash: predicateHash}
+	if len(statement.Subject) > 0 {
+		fingerprint.subjectSha256 = statement.Subject[0].Digest.Sha256
+	}
+	return fingerprint, nil
+}
+
+// hashPredicate returns the sha256 of the predicate, which is stable regardless of the order of its object keys and
+// of its whitespace, so the same predicate read from differently formatted files has the same hash.
+func hashPredicate(predicate json.RawMessage) (string, error) {
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(predicate))
+	// Numbers are kept as written, rather than converted to float64 and reformatted
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return "", errorutils.CheckErrorf("failed to parse the evidence predicate: %s", err.Error())
+	}
+	// Map keys are marshaled sorted
+	normalized, err := json.Marshal(value)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	hash := sha256.Sum256(normalized)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// findIdenticalEvidence returns the path of an existing evidence with the same fingerprint as the new evidence, or an
+// empty path if there is none. An existing evidence which can't be read is considered different, with a warning. Evidence
+// which isn't a DSSE envelope with an in-toto statement can't be compared, and is never considered identical.
+func findIdenticalEvidence(artifactoryClient artifactory.ArtifactoryServicesManager, existingEvidence []subjectEvidence, evidencePayload []byte) string {
+	fingerprint, err := getEvidenceFingerprint(evidencePayload)
+	if err != nil {
+		clientlog.Debug("Couldn't compare the new evidence with the existing evidence: " + err.Error())
+		return ""
+	}
+	for _, evidence := range existingEvidence {
+		existingFingerprint, err := readEvidenceFingerprint(artifactoryClient, evidence.DownloadPath)
+		if err != nil {
+			clientlog.Warn("Couldn't compare the new evidence with the existing evidence " + evidence.DownloadPath + ": " + err.Error())
+			continue
+		}
+		if *existingFingerprint == *fingerprint {
+			return evidence.DownloadPath
+		}
+	}
+	return ""
+}
+
+func readEvidenceFingerprint(artifactoryClient artifactory.ArtifactoryServicesManager, evidencePath string) (fingerprint *evidenceFingerprint, err error) {
+	reader, err := artifactoryClient.ReadRemoteFile(evidencePath)
+	if err != nil {
+		return nil, err
+	}
+	defer ioutils.Close(reader, &err)
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return getEvidenceFingerprint(content)
+}
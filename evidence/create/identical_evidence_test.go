@@ -0,0 +1,82 @@
+package create
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockArtifactoryManagerEvidence struct {
+	artifactory.EmptyArtifactoryServicesManager
+	files map[string][]byte
+}
+
+func (m *mockArtifactoryManagerEvidence) ReadRemoteFile(readPath string) (io.ReadCloser, error) {
+	content, ok := m.files[readPath]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func createTestEnvelope(t *testing.T, subjectSha256, predicateType, predicate, createdAt string) []byte {
+	statement := `{"_type": "https://in-toto.io/Statement/v1", "subject": [{"digest": {"sha256": "` + subjectSha256 + `"}}], ` +
+		`"predicateType": "` + predicateType + `", "predicate": ` + predicate + `, "createdAt": "` + createdAt + `"}`
+	envelope, err := json.Marshal(dsse.Envelope{Payload: base64.StdEncoding.EncodeToString([]byte(statement)), PayloadType: "application/vnd.in-toto+json"})
+	require.NoError(t, err)
+	return envelope
+}
+
+func TestGetEvidenceFingerprint(t *testing.T) {
+	fingerprint, err := getEvidenceFingerprint(createTestEnvelope(t, "abc", "https://slsa.dev/provenance/v1", `{"a": 1, "b": {"c": [1, 2]}}`, "2025-01-01T10:00:00Z"))
+	require.NoError(t, err)
+	// The order of the keys, the whitespace and the creation time don't change the fingerprint
+	sameFingerprint, err := getEvidenceFingerprint(createTestEnvelope(t, "abc", "https://slsa.dev/provenance/v1", `{"b":{"c":[1,2]},"a":1}`, "2025-01-02T10:00:00Z"))
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, sameFingerprint)
+	assert.Equal(t, "abc", fingerprint.subjectSha256)
+	assert.Equal(t, "https://slsa.dev/provenance/v1", fingerprint.predicateType)
+
+	otherFingerprint, err := getEvidenceFingerprint(createTestEnvelope(t, "abc", "https://slsa.dev/provenance/v1", `{"a": 1.0, "b": {"c": [1, 2]}}`, "2025-01-01T10:00:00Z"))
+	require.NoError(t, err)
+	assert.NotEqual(t, fingerprint.predicateHash, otherFingerprint.predicateHash)
+
+	_, err = getEvidenceFingerprint([]byte("not json"))
+	assert.ErrorContains(t, err, "failed to parse the evidence envelope")
+}
+
+func TestFindIdenticalEvidence(t *testing.T) {
+	predicateType := "https://slsa.dev/provenance/v1"
+	artifactoryClient := &mockArtifactoryManagerEvidence{files: map[string][]byte{
+		"repo/.evidence/other.json":     createTestEnvelope(t, "abc", predicateType, `{"result": "failed"}`, "2025-01-01T10:00:00Z"),
+		"repo/.evidence/identical.json": createTestEnvelope(t, "abc", predicateType, `{"result": "passed"}`, "2025-01-01T10:00:00Z"),
+	}}
+	newEvidence := createTestEnvelope(t, "abc", predicateType, `{ "result": "passed" }`, "2025-01-03T10:00:00Z")
+
+	tests := []struct {
+		name          string
+		existingPaths []string
+		expectedPath  string
+	}{
+		{name: "Identical", existingPaths: []string{"repo/.evidence/other.json", "repo/.evidence/identical.json"}, expectedPath: "repo/.evidence/identical.json"},
+		{name: "Different predicate", existingPaths: []string{"repo/.evidence/other.json"}},
+		{name: "Unreadable", existingPaths: []string{"repo/.evidence/missing.json"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var existingEvidence []subjectEvidence
+			for _, existingPath := range tt.existingPaths {
+				existingEvidence = append(existingEvidence, subjectEvidence{PredicateType: predicateType, DownloadPath: existingPath})
+			}
+			assert.Equal(t, tt.expectedPath, findIdenticalEvidence(artifactoryClient, existingEvidence, newEvidence))
+		})
+	}
+}
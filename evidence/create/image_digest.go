@@ -0,0 +1,106 @@
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The manifest file of a multi-architecture image, which lists the manifests of the image per platform.
+const listManifestFileName = "list.manifest.json"
+
+// ImageDigest is the immutable reference of a Docker image tag for which evidence was created. The digest of a
+// multi-architecture image is the digest of its index, and the digests of the images of each platform are listed as well.
+type ImageDigest struct {
+	Image     string           `json:"image"`
+	Tag       string           `json:"tag"`
+	Digest    string           `json:"digest"`
+	Reference string           `json:"reference"`
+	Platforms []PlatformDigest `json:"platforms,omitempty"`
+}
+
+type PlatformDigest struct {
+	Platform string `json:"platform"`
+	Digest   string `json:"digest"`
+}
+
+type listManifest struct {
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform struct {
+			Os           string `json:"os"`
+			Architecture string `json:"architecture"`
+			Variant      string `json:"variant"`
+		} `json:"platform"`
+	} `json:"manifests"`
+}
+
+func isImagePackageType(packageType string) bool {
+	switch strings.ToLower(packageType) {
+	case "docker", "oci":
+		return true
+	}
+	return false
+}
+
+// getImageDigest returns the digest of the image tag, which is the sha256 of its manifest, the subject of the evidence.
+// The platforms of a multi-architecture image are read from its list manifest.
+func getImageDigest(artifactoryClient artifactory.ArtifactoryServicesManager, image, tag, manifestPath, manifestSha256 string) (*ImageDigest, error) {
+	imageDigest := &ImageDigest{Image: image, Tag: tag, Digest: "sha256:" + manifestSha256}
+	imageDigest.Reference = image + "@" + imageDigest.Digest
+	if path.Base(manifestPath) != listManifestFileName {
+		return imageDigest, nil
+	}
+	platforms, err := getPlatformDigests(artifactoryClient, manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	imageDigest.Platforms = platforms
+	return imageDigest, nil
+}
+
+func getPlatformDigests(artifactoryClient artifactory.ArtifactoryServicesManager, listManifestPath string) (platforms []PlatformDigest, err error) {
+	reader, err := artifactoryClient.ReadRemoteFile(listManifestPath)
+	if err != nil {
+		return nil, err
+	}
+	defer ioutils.Close(reader, &err)
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var manifest listManifest
+	if err = json.Unmarshal(content, &manifest); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the list manifest '%s': %s", listManifestPath, err.Error())
+	}
+	for _, platformManifest := range manifest.Manifests {
+		platform := path.Join(platformManifest.Platform.Os, platformManifest.Platform.Architecture, platformManifest.Platform.Variant)
+		platforms = append(platforms, PlatformDigest{Platform: platform, Digest: platformManifest.Digest})
+	}
+	return platforms, nil
+}
+
+// reportImageDigest logs the digest the evidence was created for, so the image can be referred to by it downstream. With
+// printDigest, the digest is printed to the standard output as JSON, for pipelines to capture.
+func reportImageDigest(imageDigest *ImageDigest, printDigest bool) error {
+	clientlog.Info(fmt.Sprintf("Evidence was created for image '%s:%s', pinned to %s", imageDigest.Image, imageDigest.Tag, imageDigest.Reference))
+	for _, platform := range imageDigest.Platforms {
+		clientlog.Info(fmt.Sprintf("  %s: %s", platform.Platform, platform.Digest))
+	}
+	if !printDigest {
+		return nil
+	}
+	content, err := json.MarshalIndent(imageDigest, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	fmt.Println(string(content))
+	return nil
+}
@@ -0,0 +1,52 @@
+package create
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testListManifest = `{
+  "schemaVersion": 2,
+  "manifests": [
+    {"digest": "sha256:amd64", "platform": {"os": "linux", "architecture": "amd64"}},
+    {"digest": "sha256:arm64", "platform": {"os": "linux", "architecture": "arm64", "variant": "v8"}}
+  ]
+}`
+
+type mockArtifactoryManagerImage struct {
+	artifactory.EmptyArtifactoryServicesManager
+	readPaths []string
+}
+
+func (m *mockArtifactoryManagerImage) ReadRemoteFile(readPath string) (io.ReadCloser, error) {
+	m.readPaths = append(m.readPaths, readPath)
+	return io.NopCloser(bytes.NewReader([]byte(testListManifest))), nil
+}
+
+func TestGetImageDigest(t *testing.T) {
+	artifactoryClient := &mockArtifactoryManagerImage{}
+	imageDigest, err := getImageDigest(artifactoryClient, "app", "1.0", "docker-local/app/1.0/manifest.json", "abc")
+	require.NoError(t, err)
+	assert.Equal(t, &ImageDigest{Image: "app", Tag: "1.0", Digest: "sha256:abc", Reference: "app@sha256:abc"}, imageDigest)
+	// The manifest of a single image isn't read
+	assert.Empty(t, artifactoryClient.readPaths)
+
+	imageDigest, err = getImageDigest(artifactoryClient, "app", "2.0", "docker-local/app/2.0/list.manifest.json", "def")
+	require.NoError(t, err)
+	assert.Equal(t, &ImageDigest{Image: "app", Tag: "2.0", Digest: "sha256:def", Reference: "app@sha256:def", Platforms: []PlatformDigest{
+		{Platform: "linux/amd64", Digest: "sha256:amd64"},
+		{Platform: "linux/arm64/v8", Digest: "sha256:arm64"},
+	}}, imageDigest)
+	assert.Equal(t, []string{"docker-local/app/2.0/list.manifest.json"}, artifactoryClient.readPaths)
+}
+
+func TestIsImagePackageType(t *testing.T) {
+	assert.True(t, isImagePackageType("Docker"))
+	assert.True(t, isImagePackageType("oci"))
+	assert.False(t, isImagePackageType("npm"))
+}
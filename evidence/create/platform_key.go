@@ -0,0 +1,142 @@
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const platformKeyPairsApi = "api/security/keypair"
+
+// platformKeyPair is a key pair stored in the keystore of the JFrog Platform. The private key is only returned by
+// platforms which allow retrieving it, and to users permitted to.
+type platformKeyPair struct {
+	PairName   string `json:"pairName"`
+	PairType   string `json:"pairType"`
+	Alias      string `json:"alias"`
+	PublicKey  string `json:"publicKey"`
+	PrivateKey string `json:"privateKey"`
+}
+
+// PlatformKey is a signing key resolved from the keystore of the JFrog Platform.
+type PlatformKey struct {
+	// The PEM encoded private key, loaded by the create evidence commands like a key provided with --key.
+	Key string
+	// The alias of the key pair, which names the key in the envelope.
+	KeyAlias string
+}
+
+// ResolvePlatformKey fetches the signing key stored in the keystore of the JFrog Platform under the provided key pair name
+// or alias, so the private key doesn't have to be stored on the machine creating the evidence. It fails when the key pair
+// doesn't exist, or when the platform doesn't return its private key.
+func ResolvePlatformKey(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, name string) (*PlatformKey, error) {
+	artifactoryClient, err := evdUtils.CreateArtifactoryServiceManager(serverDetails, -1, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+	return resolvePlatformKey(artifactoryClient, name)
+}
+
+func resolvePlatformKey(artifactoryClient artifactory.ArtifactoryServicesManager, name string) (*PlatformKey, error) {
+	keyPairs, err := getPlatformKeyPairs(artifactoryClient)
+	if err != nil {
+		return nil, err
+	}
+	keyPair := findPlatformKeyPair(keyPairs, name)
+	if keyPair == nil {
+		return nil, errorutils.CheckErrorf("the signing key '%s' doesn't exist in the JFrog Platform keystore. Available key pairs: %s", name, getPlatformKeyPairsNames(keyPairs))
+	}
+	// The key pairs list doesn't include the private keys
+	keyPair, err = getPlatformKeyPair(artifactoryClient, keyPair.PairName)
+	if err != nil {
+		return nil, err
+	}
+	if keyPair.PrivateKey == "" {
+		return nil, errorutils.CheckErrorf("the JFrog Platform keystore doesn't return the private key of the key pair '%s', so evidence can't be signed with it. Provide the private key with --key instead", keyPair.PairName)
+	}
+	privateKey, err := cryptox.ReadKey([]byte(keyPair.PrivateKey))
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to load the private key of the key pair '%s': %s", keyPair.PairName, err.Error())
+	}
+	if privateKey == nil {
+		return nil, errorutils.CheckErrorf("the key pair '%s' doesn't hold a private key", keyPair.PairName)
+	}
+	keyAlias := keyPair.Alias
+	if keyAlias == "" {
+		keyAlias = keyPair.PairName
+	}
+	clientlog.Info(fmt.Sprintf("Signing with the %s key of the JFrog Platform key pair '%s'.", privateKey.KeyType, keyPair.PairName))
+	return &PlatformKey{Key: keyPair.PrivateKey, KeyAlias: keyAlias}, nil
+}
+
+// findPlatformKeyPair returns the key pair named name, or else the key pair whose alias is name.
+func findPlatformKeyPair(keyPairs []platformKeyPair, name string) *platformKeyPair {
+	for i := range keyPairs {
+		if keyPairs[i].PairName == name {
+			return &keyPairs[i]
+		}
+	}
+	for i := range keyPairs {
+		if keyPairs[i].Alias == name {
+			return &keyPairs[i]
+		}
+	}
+	return nil
+}
+
+func getPlatformKeyPairsNames(keyPairs []platformKeyPair) string {
+	if len(keyPairs) == 0 {
+		return "none"
+	}
+	names := make([]string, 0, len(keyPairs))
+	for _, keyPair := range keyPairs {
+		names = append(names, keyPair.PairName)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+func getPlatformKeyPairs(artifactoryClient artifactory.ArtifactoryServicesManager) ([]platformKeyPair, error) {
+	var keyPairs []platformKeyPair
+	if err := getPlatformKeyPairsJson(artifactoryClient, platformKeyPairsApi, &keyPairs); err != nil {
+		return nil, err
+	}
+	return keyPairs, nil
+}
+
+func getPlatformKeyPair(artifactoryClient artifactory.ArtifactoryServicesManager, pairName string) (*platformKeyPair, error) {
+	keyPair := &platformKeyPair{}
+	if err := getPlatformKeyPairsJson(artifactoryClient, platformKeyPairsApi+"/"+url.PathEscape(pairName), keyPair); err != nil {
+		return nil, err
+	}
+	return keyPair, nil
+}
+
+func getPlatformKeyPairsJson(artifactoryClient artifactory.ArtifactoryServicesManager, api string, result interface{}) error {
+	rtDetails := artifactoryClient.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	resp, body, _, err := artifactoryClient.Client().SendGet(rtDetails.GetUrl()+api, true, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return errorutils.CheckErrorf("the JFrog Platform keystore requires admin permissions: %s", strings.TrimSpace(string(body)))
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	if err = json.Unmarshal(body, result); err != nil {
+		return errorutils.CheckErrorf("failed to parse the JFrog Platform keystore response: %s", err.Error())
+	}
+	return nil
+}
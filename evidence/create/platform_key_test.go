@@ -0,0 +1,84 @@
+package create
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePlatformKey(t *testing.T) {
+	privateKey, err := os.ReadFile(filepath.Join("..", "cryptox", "testdata", "ecdsa-test-key-pem"))
+	require.NoError(t, err)
+	keyPairs := map[string]platformKeyPair{
+		"evidence-key": {PairName: "evidence-key", PairType: "EC", Alias: "ci-signer", PublicKey: "public", PrivateKey: string(privateKey)},
+		"gpg-key":      {PairName: "gpg-key", PairType: "GPG", PublicKey: "public"},
+		"no-alias":     {PairName: "no-alias", PairType: "EC", PublicKey: "public", PrivateKey: string(privateKey)},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/"+platformKeyPairsApi {
+			var list []platformKeyPair
+			for _, name := range []string{"evidence-key", "gpg-key", "no-alias"} {
+				// The list doesn't include the private keys
+				keyPair := keyPairs[name]
+				keyPair.PrivateKey = ""
+				list = append(list, keyPair)
+			}
+			content, _ := json.Marshal(list)
+			_, _ = w.Write(content)
+			return
+		}
+		keyPair, ok := keyPairs[filepath.Base(r.URL.Path)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		content, _ := json.Marshal(keyPair)
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+	artifactoryClient, err := utils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, -1, 0, false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		keyName          string
+		expectedAlias    string
+		expectedErrorMsg string
+	}{
+		{name: "By pair name", keyName: "evidence-key", expectedAlias: "ci-signer"},
+		{name: "By alias", keyName: "ci-signer", expectedAlias: "ci-signer"},
+		{name: "Pair name as alias", keyName: "no-alias", expectedAlias: "no-alias"},
+		{
+			name:             "Unknown",
+			keyName:          "missing",
+			expectedErrorMsg: "the signing key 'missing' doesn't exist in the JFrog Platform keystore. Available key pairs: evidence-key, gpg-key, no-alias",
+		},
+		{
+			name:             "Private key not returned",
+			keyName:          "gpg-key",
+			expectedErrorMsg: "the JFrog Platform keystore doesn't return the private key of the key pair 'gpg-key', so evidence can't be signed with it. Provide the private key with --key instead",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolvedKey, err := resolvePlatformKey(artifactoryClient, tt.keyName)
+			if tt.expectedErrorMsg != "" {
+				assert.EqualError(t, err, tt.expectedErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAlias, resolvedKey.KeyAlias)
+			// The resolved key signs like a key provided with --key
+			_, err = createAndSignEnvelope([]byte(`{"foo": "bar"}`), []string{resolvedKey.Key}, []string{resolvedKey.KeyAlias}, false)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package create
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	predicateUrlTimeout = 30 * time.Second
+	// The maximal size of a predicate fetched from a URL.
+	maxPredicateUrlSize = 16 * 1024 * 1024
+)
+
+// The client with which predicates are fetched from URLs.
+var predicateHttpClient = &http.Client{Timeout: predicateUrlTimeout}
+
+// readPredicate reads the predicate from its file, or fetches it when the predicate is an http(s) URL, as done for
+// predicates which pipelines generate as ephemeral artifacts.
+func (c *createEvidenceBase) readPredicate() ([]byte, error) {
+	if !isPredicateUrl(c.predicateFilePath) {
+		predicate, err := os.ReadFile(c.predicateFilePath)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed to read predicate file '%s': %s", c.predicateFilePath, err.Error())
+		}
+		return predicate, nil
+	}
+	return fetchPredicate(c.predicateFilePath, c.predicateHeader)
+}
+
+func isPredicateUrl(predicate string) bool {
+	lowerPredicate := strings.ToLower(predicate)
+	return strings.HasPrefix(lowerPredicate, "http://") || strings.HasPrefix(lowerPredicate, "https://")
+}
+
+// fetchPredicate downloads the predicate from the URL, sending the header when provided, and validates it is JSON.
+func fetchPredicate(predicateUrl, header string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, predicateUrl, nil)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid predicate URL '%s': %s", predicateUrl, err.Error())
+	}
+	if header != "" {
+		name, value, err := parsePredicateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set(name, value)
+	}
+	clientlog.Debug("Fetching the predicate from", predicateUrl)
+	resp, err := predicateHttpClient.Do(req)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to fetch the predicate from '%s': %s", predicateUrl, err.Error())
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckErrorf("failed to fetch the predicate from '%s': the server responded with status %s", predicateUrl, resp.Status)
+	}
+	// Reading one byte more than the limit detects predicates which exceed it
+	predicate, err := io.ReadAll(io.LimitReader(resp.Body, maxPredicateUrlSize+1))
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to fetch the predicate from '%s': %s", predicateUrl, err.Error())
+	}
+	if len(predicate) > maxPredicateUrlSize {
+		return nil, errorutils.CheckErrorf("the predicate fetched from '%s' exceeds the maximal size of %d bytes", predicateUrl, maxPredicateUrlSize)
+	}
+	if !json.Valid(predicate) {
+		return nil, errorutils.CheckErrorf("the predicate fetched from '%s' is not a valid JSON", predicateUrl)
+	}
+	return predicate, nil
+}
+
+// parsePredicateHeader parses a header in the "Name: value" format. The value isn't included in errors, as it is
+// usually a secret.
+func parsePredicateHeader(header string) (name, value string, err error) {
+	name, value, found := strings.Cut(header, ":")
+	name = strings.TrimSpace(name)
+	if !found || name == "" || strings.ContainsAny(name, " \t") {
+		return "", "", errorutils.CheckErrorf("invalid predicate header: expected the 'Name: value' format, such as 'Authorization: Bearer <token>'")
+	}
+	return name, strings.TrimSpace(value), nil
+}
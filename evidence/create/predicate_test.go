@@ -0,0 +1,89 @@
+package create
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPredicateFromUrl(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/predicate.json":
+			_, _ = w.Write([]byte(`{"builder": "ci"}`))
+		case "/protected.json":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(`{"protected": true}`))
+		case "/invalid.json":
+			_, _ = w.Write([]byte(`not json`))
+		case "/large.json":
+			_, _ = w.Write([]byte(`"` + strings.Repeat("a", maxPredicateUrlSize) + `"`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name              string
+		path              string
+		header            string
+		expectedPredicate string
+		expectedErrorMsg  string
+	}{
+		{name: "Public predicate", path: "/predicate.json", expectedPredicate: `{"builder": "ci"}`},
+		{name: "Protected predicate", path: "/protected.json", header: "Authorization: Bearer token", expectedPredicate: `{"protected": true}`},
+		{name: "Missing header", path: "/protected.json", expectedErrorMsg: "the server responded with status 401"},
+		{name: "Invalid header", path: "/protected.json", header: "Bearer token", expectedErrorMsg: "invalid predicate header"},
+		{name: "Not found", path: "/missing.json", expectedErrorMsg: "the server responded with status 404"},
+		{name: "Invalid JSON", path: "/invalid.json", expectedErrorMsg: "is not a valid JSON"},
+		{name: "Too large", path: "/large.json", expectedErrorMsg: "exceeds the maximal size"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &createEvidenceBase{predicateFilePath: server.URL + tt.path, predicateHeader: tt.header}
+			predicate, err := c.readPredicate()
+			if tt.expectedErrorMsg != "" {
+				assert.ErrorContains(t, err, tt.expectedErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPredicate, string(predicate))
+		})
+	}
+}
+
+func TestReadPredicateFromFile(t *testing.T) {
+	predicatePath := filepath.Join(t.TempDir(), "predicate.json")
+	require.NoError(t, os.WriteFile(predicatePath, []byte(`{"builder": "ci"}`), 0600))
+	c := &createEvidenceBase{predicateFilePath: predicatePath}
+	predicate, err := c.readPredicate()
+	require.NoError(t, err)
+	assert.Equal(t, `{"builder": "ci"}`, string(predicate))
+
+	c.predicateFilePath = filepath.Join(t.TempDir(), "missing.json")
+	_, err = c.readPredicate()
+	assert.ErrorContains(t, err, "failed to read predicate file")
+}
+
+func TestParsePredicateHeader(t *testing.T) {
+	name, value, err := parsePredicateHeader("X-Api-Key:  secret ")
+	require.NoError(t, err)
+	assert.Equal(t, "X-Api-Key", name)
+	assert.Equal(t, "secret", value)
+
+	for _, header := range []string{"secret", ": secret", "X Api Key: secret"} {
+		_, _, err = parsePredicateHeader(header)
+		assert.ErrorContains(t, err, "invalid predicate header", header)
+		assert.NotContains(t, err.Error(), "secret", header)
+	}
+}
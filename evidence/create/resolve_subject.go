@@ -0,0 +1,110 @@
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// subjectResolver computes the subject path and its sha256 the same way the create evidence commands do.
+type subjectResolver func(artifactoryClient artifactory.ArtifactoryServicesManager) (string, string, error)
+
+type resolveSubject struct {
+	createEvidenceBase
+	format  string
+	resolve subjectResolver
+}
+
+type ResolvedSubject struct {
+	Subject string `json:"subject"`
+	Sha256  string `json:"sha256"`
+}
+
+func NewResolveSubjectCustom(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, format, subjectRepoPath, subjectSha256 string) evidence.Command {
+	custom := &createEvidenceCustom{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		subjectRepoPath:    subjectRepoPath,
+		subjectSha256:      subjectSha256,
+	}
+	return newResolveSubject(serverDetails, clientOptions, format, custom.buildCustomSubjectPath)
+}
+
+func NewResolveSubjectReleaseBundle(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, format, project, releaseBundle, releaseBundleVersion string) evidence.Command {
+	rb := &createEvidenceReleaseBundle{
+		createEvidenceBase:   createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		project:              project,
+		releaseBundle:        releaseBundle,
+		releaseBundleVersion: releaseBundleVersion,
+	}
+	return newResolveSubject(serverDetails, clientOptions, format, rb.buildReleaseBundleSubjectPath)
+}
+
+func NewResolveSubjectBuild(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, format, project, buildName, buildNumber string) evidence.Command {
+	build := &createEvidenceBuild{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		project:            project,
+		buildName:          buildName,
+		buildNumber:        buildNumber,
+	}
+	return newResolveSubject(serverDetails, clientOptions, format, build.buildBuildInfoSubjectPath)
+}
+
+func NewResolveSubjectPackage(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, format, packageName, packageVersion, packageRepoName string) evidence.Command {
+	pkg := &createEvidencePackage{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		packageService:     evidence.NewPackageService(packageName, packageVersion, packageRepoName),
+	}
+	return newResolveSubject(serverDetails, clientOptions, format, pkg.buildPackageSubjectPath)
+}
+
+func newResolveSubject(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, format string, resolve subjectResolver) *resolveSubject {
+	return &resolveSubject{
+		createEvidenceBase: createEvidenceBase{serverDetails: serverDetails, clientOptions: clientOptions},
+		format:             format,
+		resolve:            resolve,
+	}
+}
+
+func (r *resolveSubject) CommandName() string {
+	return "resolve-evidence-subject"
+}
+
+func (r *resolveSubject) ServerDetails() (*config.ServerDetails, error) {
+	return r.serverDetails, nil
+}
+
+func (r *resolveSubject) Run() error {
+	artifactoryClient, err := r.createArtifactoryClient()
+	if err != nil {
+		log.Error("failed to create Artifactory client", err)
+		return err
+	}
+	subject, sha256, err := r.resolve(artifactoryClient)
+	if err != nil {
+		return err
+	}
+	return r.printResolvedSubject(ResolvedSubject{Subject: subject, Sha256: sha256})
+}
+
+func (r *resolveSubject) printResolvedSubject(resolved ResolvedSubject) error {
+	switch r.format {
+	case "json":
+		resolvedJson, err := json.MarshalIndent(resolved, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(resolvedJson))
+	case "":
+		fmt.Printf("Subject:        %s\n", resolved.Subject)
+		fmt.Printf("Subject sha256: %s\n", resolved.Sha256)
+	default:
+		return errorutils.CheckErrorf("unsupported format '%s'. Supported formats: 'json'", r.format)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package create
+
+import (
+	"testing"
+
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSubject_Custom(t *testing.T) {
+	tests := []struct {
+		name             string
+		subjectRepoPath  string
+		subjectSha256    string
+		expectedChecksum string
+		expectError      bool
+	}{
+		{
+			name:             "Valid subject",
+			subjectRepoPath:  "repo/path/file.txt",
+			expectedChecksum: "dummy_sha256",
+		},
+		{
+			name:             "Valid subject with matching sha256",
+			subjectRepoPath:  "repo/file.txt",
+			subjectSha256:    "dummy_sha256",
+			expectedChecksum: "dummy_sha256",
+		},
+		{
+			name:            "Mismatching sha256",
+			subjectRepoPath: "repo/file.txt",
+			subjectSha256:   "other_sha256",
+			expectError:     true,
+		},
+		{
+			name:            "Invalid subject",
+			subjectRepoPath: "file.txt",
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := NewResolveSubjectCustom(&config.ServerDetails{}, evdUtils.ClientOptions{}, "", tt.subjectRepoPath, tt.subjectSha256)
+			resolveCmd, ok := cmd.(*resolveSubject)
+			assert.True(t, ok)
+
+			subject, checksum, err := resolveCmd.resolve(&mockArtifactoryServicesManagerBuild{})
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.subjectRepoPath, subject)
+			assert.Equal(t, tt.expectedChecksum, checksum)
+		})
+	}
+}
+
+func TestResolveSubject_Build(t *testing.T) {
+	cmd := NewResolveSubjectBuild(&config.ServerDetails{}, evdUtils.ClientOptions{}, "json", "myProject", "buildName", "1")
+	resolveCmd, ok := cmd.(*resolveSubject)
+	assert.True(t, ok)
+	assert.Equal(t, "json", resolveCmd.format)
+
+	subject, checksum, err := resolveCmd.resolve(&mockArtifactoryServicesManagerBuild{})
+	assert.NoError(t, err)
+	assert.Equal(t, "myProject-build-info/buildName/1-1705529045000.json", subject)
+	assert.Equal(t, "dummy_sha256", checksum)
+}
+
+func TestResolveSubject_ReleaseBundle(t *testing.T) {
+	cmd := NewResolveSubjectReleaseBundle(&config.ServerDetails{}, evdUtils.ClientOptions{}, "", "myProject", "bundleName", "1.0.0")
+	resolveCmd, ok := cmd.(*resolveSubject)
+	assert.True(t, ok)
+
+	subject, checksum, err := resolveCmd.resolve(&mockArtifactoryServicesManagerBuild{})
+	assert.NoError(t, err)
+	assert.Equal(t, "myProject-release-bundles-v2/bundleName/1.0.0/release-bundle.json.evd", subject)
+	assert.Equal(t, "dummy_sha256", checksum)
+}
+
+func TestResolveSubject_PrintUnsupportedFormat(t *testing.T) {
+	cmd := &resolveSubject{format: "yaml"}
+	err := cmd.printResolvedSubject(ResolvedSubject{Subject: "repo/file.txt", Sha256: "dummy_sha256"})
+	assert.ErrorContains(t, err, "unsupported format 'yaml'")
+}
+
+func TestResolveSubject_CommandName(t *testing.T) {
+	cmd := &resolveSubject{}
+	assert.Equal(t, "resolve-evidence-subject", cmd.CommandName())
+}
@@ -0,0 +1,34 @@
+package create
+
+import (
+	"encoding/base64"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/tsa"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientlog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// timestampEnvelope obtains an RFC 3161 timestamp over the envelope signature from the configured TSA,
+// and attaches the timestamp token to the envelope metadata.
+// The signature is timestamped rather than the payload, to prove the evidence was signed no later than the timestamp time.
+// When the envelope is co-signed, the first signature is timestamped, as all the signatures are made at creation time.
+func (c *createEvidenceBase) timestampEnvelope(envelope *dsse.Envelope) error {
+	if c.tsaUrl == "" {
+		return nil
+	}
+	if len(envelope.Signatures) == 0 {
+		return errorutils.CheckErrorf("cannot timestamp an unsigned envelope")
+	}
+	signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	clientlog.Debug("Requesting a timestamp for the evidence signature from", c.tsaUrl)
+	token, err := tsa.RequestToken(c.tsaUrl, signature)
+	if err != nil {
+		return err
+	}
+	envelope.Metadata = &dsse.Metadata{TimestampToken: base64.StdEncoding.EncodeToString(token)}
+	return nil
+}
@@ -0,0 +1,129 @@
+package create
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/sign"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The payload signed and verified by the self-test of the signing key.
+const (
+	keySelfTestPayloadType = "application/vnd.jfrog.key-self-test"
+	keySelfTestPayload     = `{"test":"jfrog evidence signing key self-test"}`
+)
+
+// KeyValidationResult describes a signing key which passed validation.
+type KeyValidationResult struct {
+	KeyType     string `json:"keyType"`
+	Scheme      string `json:"scheme"`
+	KeyId       string `json:"keyId"`
+	KeyAlias    string `json:"keyAlias,omitempty"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// validateKey checks that a signing key can be used to create evidence, by loading it the same way the create evidence
+// commands do, and signing and verifying a sample payload with it. No network access is required.
+type validateKey struct {
+	key      string
+	keyAlias string
+	format   string
+}
+
+func NewValidateKey(key, keyAlias, format string) evidence.Command {
+	return &validateKey{key: key, keyAlias: keyAlias, format: format}
+}
+
+func (v *validateKey) CommandName() string {
+	return "validate-evidence-key"
+}
+
+func (v *validateKey) ServerDetails() (*config.ServerDetails, error) {
+	return nil, nil
+}
+
+func (v *validateKey) Run() error {
+	result, err := v.validate()
+	if err != nil {
+		return err
+	}
+	if v.format == "json" {
+		content, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+	log.Output(fmt.Sprintf("Key type:    %s\nScheme:      %s\nKey ID:      %s", result.KeyType, result.Scheme, result.KeyId))
+	if result.KeyAlias != "" {
+		log.Output(fmt.Sprintf("Key alias:   %s", result.KeyAlias))
+	}
+	log.Output(fmt.Sprintf("Fingerprint: %s\nThe signing key is valid.", result.Fingerprint))
+	return nil
+}
+
+func (v *validateKey) validate() (*KeyValidationResult, error) {
+	keyContent, err := readKeyContent(v.key)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to read the signing key: %s", err.Error())
+	}
+	privateKey, err := cryptox.LoadKey(keyContent)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid signing key, unsupported format: %s. Supported keys are PEM or OpenSSH encoded ECDSA, RSA and ED25519 private keys", err.Error())
+	}
+	if privateKey.KeyVal.Private == "" {
+		return nil, errorutils.CheckErrorf("invalid signing key, missing private material: the %s key is a public key, while creating evidence requires its private key", privateKey.KeyType)
+	}
+	keyId, err := cryptox.CalculateKeyID(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	privateKey.KeyID = v.keyAlias
+	signers, err := createSigners(privateKey)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid %s signing key: %s", privateKey.KeyType, err.Error())
+	}
+	verifier, ok := signers[0].(dsse.Verifier)
+	if !ok {
+		return nil, errorutils.CheckError(errors.New("the signing key can't be used to verify its signatures"))
+	}
+	if err = selfTestKey(signers[0], verifier); err != nil {
+		return nil, err
+	}
+	fingerprint, err := cryptox.GenerateFingerprint(verifier.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &KeyValidationResult{
+		KeyType:     privateKey.KeyType,
+		Scheme:      cryptox.KeyScheme(verifier.Public()),
+		KeyId:       keyId,
+		KeyAlias:    v.keyAlias,
+		Fingerprint: fingerprint,
+	}, nil
+}
+
+// selfTestKey signs a sample payload into a DSSE envelope, as done when creating evidence, and verifies the signature
+// with the public part of the key.
+func selfTestKey(signer dsse.Signer, verifier dsse.Verifier) error {
+	envelopeSigner, err := sign.NewEnvelopeSigner(signer)
+	if err != nil {
+		return err
+	}
+	envelope, err := envelopeSigner.SignPayload(keySelfTestPayloadType, []byte(keySelfTestPayload))
+	if err != nil {
+		return errorutils.CheckErrorf("invalid signing key, failed to sign a sample payload: %s", err.Error())
+	}
+	if err = envelope.Verify(verifier); err != nil {
+		return errorutils.CheckErrorf("invalid signing key, the signature of a sample payload doesn't match its public key: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package create
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateKey(t *testing.T) {
+	tests := []struct {
+		name             string
+		keyPath          string
+		keyAlias         string
+		expectedKeyType  string
+		expectedScheme   string
+		expectedErrorMsg string
+	}{
+		{
+			name:            "ECDSA key",
+			keyPath:         filepath.Join("..", "cryptox", "testdata", "ecdsa-test-key-pem"),
+			keyAlias:        "ci-key",
+			expectedKeyType: "ecdsa",
+			expectedScheme:  "ecdsa-p256",
+		},
+		{
+			name:            "ED25519 key",
+			keyPath:         filepath.Join("..", "cryptox", "testdata", "ed25519-test-key-pem"),
+			expectedKeyType: "ed25519",
+			expectedScheme:  "ed25519",
+		},
+		{
+			name:            "RSA key",
+			keyPath:         filepath.Join("..", "cryptox", "testdata", "rsa-test-key"),
+			expectedKeyType: "rsa",
+			expectedScheme:  "rsa-3072",
+		},
+		{
+			name:             "Unsupported key",
+			keyPath:          filepath.Join("..", "..", "tests", "testdata", "unsupported_key.pem"),
+			expectedErrorMsg: "invalid signing key, unsupported format",
+		},
+		{
+			name:             "Public key",
+			keyPath:          filepath.Join("..", "..", "tests", "testdata", "public_key.pem"),
+			expectedErrorMsg: "invalid signing key, missing private material",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewValidateKey(tt.keyPath, tt.keyAlias, "").(*validateKey).validate()
+			if tt.expectedErrorMsg != "" {
+				assert.ErrorContains(t, err, tt.expectedErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedKeyType, result.KeyType)
+			assert.Equal(t, tt.expectedScheme, result.Scheme)
+			assert.Len(t, result.KeyId, 64)
+			assert.Equal(t, tt.keyAlias, result.KeyAlias)
+			assert.NotEmpty(t, result.Fingerprint)
+		})
+	}
+}
+
+func TestValidateKeyContent(t *testing.T) {
+	// The key may be passed as its content rather than as a path.
+	keyContent, err := os.ReadFile(filepath.Join("..", "cryptox", "testdata", "ecdsa-test-key-pem"))
+	require.NoError(t, err)
+	result, err := NewValidateKey(string(keyContent), "", "json").(*validateKey).validate()
+	require.NoError(t, err)
+	assert.Equal(t, "ecdsa", result.KeyType)
+}
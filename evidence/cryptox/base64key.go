@@ -0,0 +1,38 @@
+package cryptox
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// DecodeBase64Key decodes a base64 encoded PEM key, including OpenSSH keys. CI systems which only allow single-line
+// secrets require keys to be provided this way. Whitespace in the encoded value is ignored.
+func DecodeBase64Key(encodedKey string) ([]byte, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encodedKey), ""))
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to decode the base64 encoded key: %s", err.Error())
+	}
+	if block, _ := pem.Decode(decodedKey); block == nil {
+		return nil, errorutils.CheckErrorf("the base64 encoded key is not a PEM encoded key")
+	}
+	return decodedKey, nil
+}
+
+// DecodeKeyIfBase64 returns the decoded key when the key is a base64 encoded PEM key, and the key as is otherwise.
+// PEM keys are never decoded, so only a value which clearly isn't a PEM key is considered.
+func DecodeKeyIfBase64(key []byte) []byte {
+	if block, _ := pem.Decode(key); block != nil {
+		return key
+	}
+	decodedKey, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(key)), ""))
+	if err != nil {
+		return key
+	}
+	if block, _ := pem.Decode(decodedKey); block == nil {
+		return key
+	}
+	return decodedKey
+}
@@ -0,0 +1,40 @@
+package cryptox
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeBase64Key(t *testing.T) {
+	for _, keyFileName := range []string{"ecdsa-test-key-pem", "rsa-test-key", "ssh-ed25519-256"} {
+		t.Run(keyFileName, func(t *testing.T) {
+			keyContent, err := os.ReadFile(filepath.Join("testdata", keyFileName))
+			require.NoError(t, err)
+			encodedKey := base64.StdEncoding.EncodeToString(keyContent)
+
+			decodedKey, err := DecodeBase64Key(encodedKey)
+			require.NoError(t, err)
+			assert.Equal(t, keyContent, decodedKey)
+			key, err := ReadKey(decodedKey)
+			assert.NoError(t, err)
+			assert.NotNil(t, key)
+
+			assert.Equal(t, keyContent, DecodeKeyIfBase64([]byte(encodedKey)))
+			assert.Equal(t, keyContent, DecodeKeyIfBase64(keyContent))
+		})
+	}
+}
+
+func TestDecodeBase64Key_Errors(t *testing.T) {
+	_, err := DecodeBase64Key("not base64!")
+	assert.ErrorContains(t, err, "failed to decode the base64 encoded key")
+	_, err = DecodeBase64Key(base64.StdEncoding.EncodeToString([]byte("not a key")))
+	assert.EqualError(t, err, "the base64 encoded key is not a PEM encoded key")
+
+	assert.Equal(t, []byte("/path/to/key"), DecodeKeyIfBase64([]byte("/path/to/key")))
+}
@@ -0,0 +1,67 @@
+package cryptox
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+)
+
+// DSSE signatures don't declare the algorithm that created them, so the signing scheme of a signature is inferred from
+// its encoding: ECDSA signatures are ASN.1 sequences of two integers, ED25519 signatures are always 64 bytes long and
+// RSA signatures are as long as the key modulus.
+const (
+	ed25519SignatureSize = 64
+	minRSASignatureSize  = 128
+)
+
+// SignatureScheme returns the signing scheme of a raw signature, such as 'ecdsa-p256', 'rsa-2048' or 'ed25519'.
+// An empty string is returned if the scheme can't be inferred.
+func SignatureScheme(sig []byte) string {
+	ecdsaSig := struct {
+		R, S *big.Int
+	}{}
+	if rest, err := asn1.Unmarshal(sig, &ecdsaSig); err == nil && len(rest) == 0 && ecdsaSig.R != nil && ecdsaSig.S != nil {
+		return ecdsaScheme(max(ecdsaSig.R.BitLen(), ecdsaSig.S.BitLen()))
+	}
+	switch {
+	case len(sig) == ed25519SignatureSize:
+		return ED25519KeyType
+	case len(sig) >= minRSASignatureSize:
+		return rsaScheme(len(sig) * 8)
+	}
+	return ""
+}
+
+// KeyScheme returns the signing scheme of a public key, in the format returned by SignatureScheme.
+// An empty string is returned for unsupported keys.
+func KeyScheme(publicKey crypto.PublicKey) string {
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsaScheme(key.Params().BitSize)
+	case *rsa.PublicKey:
+		// A signature is padded to the size in bytes of the modulus.
+		return rsaScheme(key.Size() * 8)
+	case ed25519.PublicKey, *ed25519.PublicKey:
+		return ED25519KeyType
+	}
+	return ""
+}
+
+// ecdsaScheme returns the scheme of the smallest supported curve that fits the provided size in bits.
+func ecdsaScheme(bitSize int) string {
+	switch {
+	case bitSize <= 256:
+		return ECDSAKeyType + "-p256"
+	case bitSize <= 384:
+		return ECDSAKeyType + "-p384"
+	}
+	return ECDSAKeyType + "-p521"
+}
+
+func rsaScheme(bitSize int) string {
+	return fmt.Sprintf("%s-%d", RSAKeyType, bitSize)
+}
@@ -0,0 +1,59 @@
+package cryptox
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureScheme(t *testing.T) {
+	digest := sha256.Sum256([]byte("data"))
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecdsaSig, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, digest[:])
+	require.NoError(t, err)
+
+	ecdsaP384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	ecdsaP384Sig, err := ecdsa.SignASN1(rand.Reader, ecdsaP384Key, digest[:])
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSig, err := rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: sha256.Size, Hash: crypto.SHA256})
+	require.NoError(t, err)
+
+	ed25519Public, ed25519Private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	ed25519Sig := ed25519.Sign(ed25519Private, []byte("data"))
+
+	tests := []struct {
+		name      string
+		sig       []byte
+		publicKey crypto.PublicKey
+		expected  string
+	}{
+		{name: "ECDSA P-256", sig: ecdsaSig, publicKey: &ecdsaKey.PublicKey, expected: "ecdsa-p256"},
+		{name: "ECDSA P-384", sig: ecdsaP384Sig, publicKey: &ecdsaP384Key.PublicKey, expected: "ecdsa-p384"},
+		{name: "RSA", sig: rsaSig, publicKey: &rsaKey.PublicKey, expected: "rsa-2048"},
+		{name: "ED25519", sig: ed25519Sig, publicKey: ed25519Public, expected: "ed25519"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, SignatureScheme(tt.sig))
+			assert.Equal(t, tt.expected, KeyScheme(tt.publicKey))
+		})
+	}
+
+	assert.Empty(t, SignatureScheme([]byte("short")))
+	assert.Empty(t, KeyScheme(nil))
+}
@@ -33,7 +33,8 @@ var (
 	ErrFailedPEMParsing = errors.New("failed parsing the PEM block: unsupported PEM type")
 )
 
-func calculateKeyID(k *SSLibKey) (string, error) {
+// CalculateKeyID returns the ID of the key: the sha256 of the canonical JSON of its type, scheme and public key.
+func CalculateKeyID(k *SSLibKey) (string, error) {
 	key := map[string]any{
 		"keytype":               k.KeyType,
 		"scheme":                k.Scheme,
@@ -191,6 +192,15 @@ func CreateVerifier(publicKey *SSLibKey) ([]dsse.Verifier, error) {
 	return verifiers, nil
 }
 
+// MarshalPublicKeyPEM encodes a public key as a PKIX PEM block, the format in which the verify commands accept public keys.
+func MarshalPublicKeyPEM(pub crypto.PublicKey) ([]byte, error) {
+	pubBytes, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, errorutils.CheckError(fmt.Errorf("failed to marshal public key: %w", err))
+	}
+	return generatePEMBlock(pubBytes, PublicKeyPEM), nil
+}
+
 func GenerateFingerprint(pub crypto.PublicKey) (string, error) {
 	if pub == nil {
 		return "", errorutils.CheckError(fmt.Errorf("public key not available"))
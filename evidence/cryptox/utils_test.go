@@ -16,7 +16,7 @@ func TestCalculateKeyID(t *testing.T) {
 		Scheme:              "",
 		KeyID:               "",
 	}
-	keyID, err := calculateKeyID(key)
+	keyID, err := CalculateKeyID(key)
 	assert.NoError(t, err)
 	// Check if the returned key ID matches the expected one
 	// #nosec G101 - False positive - Not a real password
@@ -12,6 +12,13 @@ type Envelope struct {
 	Payload     string      `json:"payload"`
 	PayloadType string      `json:"payloadType"`
 	Signatures  []Signature `json:"signatures"`
+	// Unsigned information attached to the envelope after it was signed.
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+type Metadata struct {
+	// Base64 encoded RFC 3161 timestamp token, issued over the first signature of the envelope.
+	TimestampToken string `json:"timestampToken,omitempty"`
 }
 
 type Signature struct {
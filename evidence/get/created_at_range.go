@@ -0,0 +1,58 @@
+package get
+
+import (
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// createdAtRange is the time window in which the returned evidence was created. The evidence search doesn't support filtering
+// by creation time, so the evidence is filtered after it is fetched.
+type createdAtRange struct {
+	// Evidence created at since or later is included. Zero means unbounded.
+	since time.Time
+	// Evidence created before until is included, so that consecutive windows don't overlap. Zero means unbounded.
+	until time.Time
+}
+
+// parseCreatedAtRange parses the RFC3339 bounds of the window. A nil range, including all the evidence, is returned when
+// neither bound is provided.
+func parseCreatedAtRange(since, until string) (*createdAtRange, error) {
+	if since == "" && until == "" {
+		return nil, nil
+	}
+	timeRange := &createdAtRange{}
+	var err error
+	if since != "" {
+		if timeRange.since, err = time.Parse(time.RFC3339, since); err != nil {
+			return nil, errorutils.CheckErrorf("invalid since time '%s': expected an RFC3339 timestamp, e.g. '2025-01-31T00:00:00Z'", since)
+		}
+	}
+	if until != "" {
+		if timeRange.until, err = time.Parse(time.RFC3339, until); err != nil {
+			return nil, errorutils.CheckErrorf("invalid until time '%s': expected an RFC3339 timestamp, e.g. '2025-02-28T00:00:00Z'", until)
+		}
+	}
+	if since != "" && until != "" && !timeRange.since.Before(timeRange.until) {
+		return nil, errorutils.CheckErrorf("the since time '%s' must be before the until time '%s'", since, until)
+	}
+	return timeRange, nil
+}
+
+// contains reports whether evidence created at createdAt is in the window. Evidence whose creation time can't be parsed is
+// excluded from a bounded window.
+func (r *createdAtRange) contains(createdAt string) bool {
+	if r == nil {
+		return true
+	}
+	createdAtTime, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		log.Debug("Excluding evidence with an unparsable creation time:", createdAt)
+		return false
+	}
+	if !r.since.IsZero() && createdAtTime.Before(r.since) {
+		return false
+	}
+	return r.until.IsZero() || createdAtTime.Before(r.until)
+}
@@ -0,0 +1,73 @@
+package get
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCreatedAtRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		since         string
+		until         string
+		contained     []string
+		notContained  []string
+		expectedError string
+	}{
+		{name: "unbounded", contained: []string{"2024-01-01T00:00:00Z", "not a time"}},
+		{
+			name:         "since",
+			since:        "2024-06-01T00:00:00Z",
+			contained:    []string{"2024-06-01T00:00:00Z", "2024-06-01T02:00:00.123+02:00", "2025-01-01T00:00:00Z"},
+			notContained: []string{"2024-05-31T23:59:59Z", "2024-06-01T01:00:00+02:00", "not a time"},
+		},
+		{
+			name:         "window",
+			since:        "2024-06-01T00:00:00Z",
+			until:        "2024-07-01T00:00:00Z",
+			contained:    []string{"2024-06-01T00:00:00Z", "2024-06-30T23:59:59.999Z"},
+			notContained: []string{"2024-07-01T00:00:00Z", "2024-05-01T00:00:00Z"},
+		},
+		{name: "invalid since", since: "2024-06-01", expectedError: "invalid since time '2024-06-01'"},
+		{name: "invalid until", until: "yesterday", expectedError: "invalid until time 'yesterday'"},
+		{name: "empty window", since: "2024-07-01T00:00:00Z", until: "2024-06-01T00:00:00Z", expectedError: "must be before the until time"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeRange, err := parseCreatedAtRange(tt.since, tt.until)
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			for _, createdAt := range tt.contained {
+				assert.True(t, timeRange.contains(createdAt), createdAt)
+			}
+			for _, createdAt := range tt.notContained {
+				assert.False(t, timeRange.contains(createdAt), createdAt)
+			}
+		})
+	}
+}
+
+func TestTransformGraphQLOutputCreatedAtRange(t *testing.T) {
+	rawEvidence := []byte(`{"data":{"evidence":{"searchEvidence":{"edges":[` +
+		`{"node":{"predicateSlug":"old","createdAt":"2024-01-01T00:00:00Z"}},` +
+		`{"node":{"predicateSlug":"new","createdAt":"2024-06-15T00:00:00Z"}}]}}}}`)
+	g := &getEvidenceCustom{subjectRepoPath: "test-repo/path/file.json"}
+	var err error
+	g.createdAtRange, err = parseCreatedAtRange("2024-06-01T00:00:00Z", "")
+	require.NoError(t, err)
+
+	result, err := g.transformGraphQLOutput(rawEvidence)
+	require.NoError(t, err)
+
+	var output CustomEvidenceOutput
+	require.NoError(t, json.Unmarshal(result, &output))
+	require.Len(t, output.Result.Evidence, 1)
+	assert.Equal(t, "new", output.Result.Evidence[0].PredicateSlug)
+}
@@ -0,0 +1,274 @@
+package get
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Name of the manifest describing the exported evidence, at the root of the bundle.
+const exportIndexFileName = "index.json"
+
+// Directory of the bundle to which the evidence envelopes are written.
+const exportEvidenceDir = "evidence"
+
+// ExportIndex is the manifest of an evidence bundle, describing the subject and every evidence in the bundle.
+type ExportIndex struct {
+	SchemaVersion string             `json:"schemaVersion"`
+	Type          SubjectType        `json:"type"`
+	Subject       string             `json:"subjectRepoPath"`
+	ExportedAt    string             `json:"exportedAt"`
+	Evidence      []ExportIndexEntry `json:"evidence"`
+}
+
+type ExportIndexEntry struct {
+	File          string `json:"file"`
+	Sha256        string `json:"sha256"`
+	PredicateSlug string `json:"predicateSlug"`
+	PredicateType string `json:"predicateType,omitempty"`
+	DownloadPath  string `json:"downloadPath"`
+	KeyAlias      string `json:"keyAlias,omitempty"`
+	Verified      bool   `json:"verified"`
+	CreatedBy     string `json:"createdBy"`
+	CreatedAt     string `json:"createdAt"`
+}
+
+type exportedEvidence struct {
+	entry   ExportIndexEntry
+	content []byte
+}
+
+// exportEvidenceCustom writes every evidence of a subject into a single zip or tar.gz bundle, with an index manifest.
+// The evidence is enumerated the same way get-evidence does, and each envelope is checked against its checksum in Artifactory.
+type exportEvidenceCustom struct {
+	getEvidenceCustom
+	artifactoryClient artifactory.ArtifactoryServicesManager
+}
+
+func NewExportEvidenceCustom(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, subjectRepoPath, outputFileName string) evidence.Command {
+	return &exportEvidenceCustom{
+		getEvidenceCustom: getEvidenceCustom{
+			getEvidenceBase: getEvidenceBase{
+				serverDetails:  serverDetails,
+				clientOptions:  clientOptions,
+				outputFileName: outputFileName,
+			},
+			subjectRepoPath: subjectRepoPath,
+		},
+	}
+}
+
+func (e *exportEvidenceCustom) CommandName() string {
+	return "export-custom-evidence"
+}
+
+func (e *exportEvidenceCustom) Run() error {
+	writeArchive, err := getArchiveWriter(e.outputFileName)
+	if err != nil {
+		return err
+	}
+	onemodelClient, err := evdUtils.CreateOnemodelServiceManager(e.serverDetails, e.clientOptions)
+	if err != nil {
+		return fmt.Errorf("onemodel client init failed: %w", err)
+	}
+	evidenceList, err := e.getEvidence(onemodelClient)
+	if err != nil {
+		return fmt.Errorf("evidence retrieval failed: %w", err)
+	}
+	var output CustomEvidenceOutput
+	if err = json.Unmarshal(evidenceList, &output); err != nil {
+		return fmt.Errorf("failed to unmarshal evidence: %w", err)
+	}
+	if len(output.Result.Evidence) == 0 {
+		return errorutils.CheckErrorf("no evidence found for subject '%s'", e.subjectRepoPath)
+	}
+	if e.artifactoryClient == nil {
+		if e.artifactoryClient, err = evdUtils.CreateArtifactoryServiceManager(e.serverDetails, 0, e.clientOptions); err != nil {
+			return err
+		}
+	}
+	exported, err := e.downloadEvidence(output.Result.Evidence)
+	if err != nil {
+		return err
+	}
+	index, err := json.MarshalIndent(e.createIndex(exported, time.Now()), "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = writeBundleFile(e.outputFileName, writeArchive, index, exported); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("%d evidence of subject '%s' exported to: %s", len(exported), e.subjectRepoPath, e.outputFileName))
+	return nil
+}
+
+// downloadEvidence downloads the envelope of each evidence, and fails if its sha256 doesn't match the checksum of the
+// evidence file in Artifactory.
+func (e *exportEvidenceCustom) downloadEvidence(entries []EvidenceEntry) ([]exportedEvidence, error) {
+	exported := make([]exportedEvidence, 0, len(entries))
+	for i, entry := range entries {
+		log.Debug("Downloading evidence:", entry.DownloadPath)
+		fileInfo, err := e.artifactoryClient.FileInfo(entry.DownloadPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the checksum of evidence '%s': %w", entry.DownloadPath, err)
+		}
+		content, err := e.readEvidence(entry.DownloadPath)
+		if err != nil {
+			return nil, err
+		}
+		checksum := sha256.Sum256(content)
+		actualSha256 := hex.EncodeToString(checksum[:])
+		if actualSha256 != fileInfo.Checksums.Sha256 {
+			return nil, errorutils.CheckErrorf("checksum mismatch for evidence '%s': expected sha256 %s, downloaded %s", entry.DownloadPath, fileInfo.Checksums.Sha256, actualSha256)
+		}
+		keyAlias, _ := entry.SigningKey["alias"].(string)
+		exported = append(exported, exportedEvidence{
+			entry: ExportIndexEntry{
+				File:          fmt.Sprintf("%s/%03d-%s.json", exportEvidenceDir, i+1, getEvidenceFileBaseName(entry)),
+				Sha256:        actualSha256,
+				PredicateSlug: entry.PredicateSlug,
+				PredicateType: entry.PredicateType,
+				DownloadPath:  entry.DownloadPath,
+				KeyAlias:      keyAlias,
+				Verified:      entry.Verified,
+				CreatedBy:     entry.CreatedBy,
+				CreatedAt:     entry.CreatedAt,
+			},
+			content: content,
+		})
+	}
+	return exported, nil
+}
+
+func (e *exportEvidenceCustom) readEvidence(downloadPath string) ([]byte, error) {
+	reader, err := e.artifactoryClient.ReadRemoteFile(downloadPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download evidence '%s': %w", downloadPath, err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download evidence '%s': %w", downloadPath, err)
+	}
+	return content, nil
+}
+
+func (e *exportEvidenceCustom) createIndex(exported []exportedEvidence, exportTime time.Time) ExportIndex {
+	index := ExportIndex{
+		SchemaVersion: SchemaVersion,
+		Type:          ArtifactType,
+		Subject:       e.subjectRepoPath,
+		ExportedAt:    exportTime.UTC().Format(time.RFC3339),
+		Evidence:      make([]ExportIndexEntry, 0, len(exported)),
+	}
+	for _, item := range exported {
+		index.Evidence = append(index.Evidence, item.entry)
+	}
+	return index
+}
+
+// getEvidenceFileBaseName names the file of an evidence in the bundle after its predicate slug, keeping only characters
+// which are safe in file names.
+func getEvidenceFileBaseName(entry EvidenceEntry) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_' || r == '.' {
+			return r
+		}
+		return '-'
+	}, entry.PredicateSlug)
+	if strings.Trim(name, "-.") == "" {
+		return "evidence"
+	}
+	return name
+}
+
+func writeBundleFile(outputFileName string, writeArchive archiveWriter, index []byte, exported []exportedEvidence) (err error) {
+	file, err := os.Create(outputFileName)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = errorutils.CheckError(closeErr)
+		}
+	}()
+	return writeArchive(file, index, exported)
+}
+
+type archiveWriter func(out io.Writer, index []byte, exported []exportedEvidence) error
+
+// getArchiveWriter returns the writer of the archive format matching the extension of the output file.
+func getArchiveWriter(outputFileName string) (archiveWriter, error) {
+	switch {
+	case strings.HasSuffix(outputFileName, ".zip"):
+		return writeZipArchive, nil
+	case strings.HasSuffix(outputFileName, ".tar.gz"), strings.HasSuffix(outputFileName, ".tgz"):
+		return writeTarGzArchive, nil
+	default:
+		return nil, errorutils.CheckErrorf("unsupported output file '%s': the evidence bundle must be a '.zip', '.tar.gz' or '.tgz' file", outputFileName)
+	}
+}
+
+func writeZipArchive(out io.Writer, index []byte, exported []exportedEvidence) error {
+	zipWriter := zip.NewWriter(out)
+	writeFile := func(name string, content []byte) error {
+		fileWriter, err := zipWriter.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = fileWriter.Write(content)
+		return err
+	}
+	if err := writeFile(exportIndexFileName, index); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, item := range exported {
+		if err := writeFile(item.entry.File, item.content); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return errorutils.CheckError(zipWriter.Close())
+}
+
+func writeTarGzArchive(out io.Writer, index []byte, exported []exportedEvidence) error {
+	gzipWriter := gzip.NewWriter(out)
+	tarWriter := tar.NewWriter(gzipWriter)
+	modTime := time.Now()
+	writeFile := func(name string, content []byte) error {
+		header := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), ModTime: modTime}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		_, err := tarWriter.Write(content)
+		return err
+	}
+	if err := writeFile(exportIndexFileName, index); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, item := range exported {
+		if err := writeFile(item.entry.File, item.content); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(gzipWriter.Close())
+}
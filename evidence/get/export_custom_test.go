@@ -0,0 +1,123 @@
+package get
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exportedEnvelope = `{"payload":"e30=","payloadType":"application/vnd.in-toto+json","signatures":[]}`
+
+type mockArtifactoryManagerExport struct {
+	artifactory.EmptyArtifactoryServicesManager
+	sha256 string
+}
+
+func (m *mockArtifactoryManagerExport) FileInfo(_ string) (*utils.FileInfo, error) {
+	fileInfo := &utils.FileInfo{}
+	fileInfo.Checksums.Sha256 = m.sha256
+	return fileInfo, nil
+}
+
+func (m *mockArtifactoryManagerExport) ReadRemoteFile(_ string) (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader([]byte(exportedEnvelope))), nil
+}
+
+func getExportedEnvelopeSha256() string {
+	checksum := sha256.Sum256([]byte(exportedEnvelope))
+	return hex.EncodeToString(checksum[:])
+}
+
+func newTestExportEvidence(checksum string) *exportEvidenceCustom {
+	export := NewExportEvidenceCustom(nil, evdUtils.ClientOptions{}, "test-repo/path/file.txt", "").(*exportEvidenceCustom)
+	export.artifactoryClient = &mockArtifactoryManagerExport{sha256: checksum}
+	return export
+}
+
+var exportedEntries = []EvidenceEntry{
+	{PredicateSlug: "cyclonedx-sbom", PredicateType: "https://cyclonedx.org/bom", DownloadPath: "test-repo/.evidence/sbom.json", SigningKey: map[string]any{"alias": "ci-key"}, CreatedAt: "2024-01-01T00:00:00Z"},
+	{PredicateSlug: "", DownloadPath: "test-repo/.evidence/other.json"},
+}
+
+func TestExportEvidenceDownload(t *testing.T) {
+	export := newTestExportEvidence(getExportedEnvelopeSha256())
+	exported, err := export.downloadEvidence(exportedEntries)
+	require.NoError(t, err)
+	require.Len(t, exported, 2)
+	assert.Equal(t, "evidence/001-cyclonedx-sbom.json", exported[0].entry.File)
+	assert.Equal(t, "ci-key", exported[0].entry.KeyAlias)
+	assert.Equal(t, getExportedEnvelopeSha256(), exported[0].entry.Sha256)
+	assert.Equal(t, "evidence/002-evidence.json", exported[1].entry.File)
+	assert.Equal(t, exportedEnvelope, string(exported[1].content))
+
+	index := export.createIndex(exported, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, "test-repo/path/file.txt", index.Subject)
+	assert.Equal(t, "2024-02-01T00:00:00Z", index.ExportedAt)
+	assert.Len(t, index.Evidence, 2)
+}
+
+func TestExportEvidenceChecksumMismatch(t *testing.T) {
+	export := newTestExportEvidence("0000")
+	_, err := export.downloadEvidence(exportedEntries)
+	assert.ErrorContains(t, err, "checksum mismatch for evidence 'test-repo/.evidence/sbom.json'")
+}
+
+func TestGetArchiveWriter(t *testing.T) {
+	for _, name := range []string{"bundle.zip", "bundle.tar.gz", "bundle.tgz"} {
+		_, err := getArchiveWriter(name)
+		assert.NoError(t, err, name)
+	}
+	_, err := getArchiveWriter("bundle.json")
+	assert.ErrorContains(t, err, "unsupported output file")
+}
+
+func TestWriteBundleFile(t *testing.T) {
+	exported := []exportedEvidence{{entry: ExportIndexEntry{File: "evidence/001-sbom.json"}, content: []byte(exportedEnvelope)}}
+	index, err := json.Marshal(ExportIndex{Subject: "test-repo/file.txt"})
+	require.NoError(t, err)
+
+	t.Run("Zip", func(t *testing.T) {
+		outputFileName := filepath.Join(t.TempDir(), "bundle.zip")
+		require.NoError(t, writeBundleFile(outputFileName, writeZipArchive, index, exported))
+		reader, err := zip.OpenReader(outputFileName)
+		require.NoError(t, err)
+		defer func() {
+			assert.NoError(t, reader.Close())
+		}()
+		require.Len(t, reader.File, 2)
+		assert.Equal(t, exportIndexFileName, reader.File[0].Name)
+		assert.Equal(t, "evidence/001-sbom.json", reader.File[1].Name)
+	})
+
+	t.Run("Tar gz", func(t *testing.T) {
+		var buffer bytes.Buffer
+		require.NoError(t, writeTarGzArchive(&buffer, index, exported))
+		gzipReader, err := gzip.NewReader(&buffer)
+		require.NoError(t, err)
+		tarReader := tar.NewReader(gzipReader)
+		var names []string
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			names = append(names, header.Name)
+		}
+		assert.Equal(t, []string{exportIndexFileName, "evidence/001-sbom.json"}, names)
+	})
+}
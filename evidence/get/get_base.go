@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
@@ -20,10 +21,32 @@ const (
 )
 
 type getEvidenceBase struct {
-	serverDetails    *config.ServerDetails
+	serverDetails *config.ServerDetails
+	// The settings of the clients the requests of the command are sent with.
+	clientOptions    evdUtils.ClientOptions
 	outputFileName   string
 	format           string
 	includePredicate bool
+	// RFC3339 bounds of the creation time of the returned evidence. Either bound may be empty.
+	since          string
+	until          string
+	createdAtRange *createdAtRange
+	// When set, the evidence is checked against the policy in this file instead of being exported.
+	policyPath string
+	policy     *EvidencePolicy
+}
+
+func (g *getEvidenceBase) parseCreatedAtRange() (err error) {
+	g.createdAtRange, err = parseCreatedAtRange(g.since, g.until)
+	return
+}
+
+func (g *getEvidenceBase) loadPolicy() (err error) {
+	if g.policyPath == "" {
+		return nil
+	}
+	g.policy, err = loadEvidencePolicy(g.policyPath)
+	return
 }
 
 type JsonlLine struct {
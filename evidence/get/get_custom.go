@@ -8,7 +8,7 @@ import (
 
 	"github.com/jfrog/gofrog/log"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/onemodel"
 )
@@ -28,13 +28,17 @@ type CustomEvidenceOutput struct {
 	Result        CustomEvidenceResult `json:"result"`
 }
 
-func NewGetEvidenceCustom(serverDetails *config.ServerDetails, subjectRepoPath, format, outputFileName string, includePredicate bool) evidence.Command {
+func NewGetEvidenceCustom(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, subjectRepoPath, format, outputFileName, since, until, policyPath string, includePredicate bool) evidence.Command {
 	return &getEvidenceCustom{
 		getEvidenceBase: getEvidenceBase{
 			serverDetails:    serverDetails,
+			clientOptions:    clientOptions,
 			format:           format,
 			outputFileName:   outputFileName,
 			includePredicate: includePredicate,
+			since:            since,
+			until:            until,
+			policyPath:       policyPath,
 		},
 		subjectRepoPath: subjectRepoPath,
 	}
@@ -49,7 +53,14 @@ func (g *getEvidenceCustom) ServerDetails() (*config.ServerDetails, error) {
 }
 
 func (g *getEvidenceCustom) Run() error {
-	onemodelClient, err := utils.CreateOnemodelServiceManager(g.serverDetails, false)
+	if err := g.parseCreatedAtRange(); err != nil {
+		return err
+	}
+	if err := g.loadPolicy(); err != nil {
+		return err
+	}
+
+	onemodelClient, err := evdUtils.CreateOnemodelServiceManager(g.serverDetails, g.clientOptions)
 	if err != nil {
 		log.Error("failed to create onemodel client", err)
 		return fmt.Errorf("onemodel client init failed: %w", err)
@@ -62,9 +73,20 @@ func (g *getEvidenceCustom) Run() error {
 		return fmt.Errorf("evidence retrieval failed: %w", err)
 	}
 
+	if g.policy != nil {
+		return g.checkPolicy(evidence)
+	}
 	return g.exportEvidenceToFile(evidence, g.outputFileName, g.format)
 }
 
+func (g *getEvidenceCustom) checkPolicy(evidence []byte) error {
+	var output CustomEvidenceOutput
+	if err := json.Unmarshal(evidence, &output); err != nil {
+		return fmt.Errorf("failed to unmarshal evidence: %w", err)
+	}
+	return g.policy.checkPolicy(fmt.Sprintf("subject '%s'", g.subjectRepoPath), output.Result.Evidence)
+}
+
 func (g *getEvidenceCustom) getEvidence(onemodelClient onemodel.Manager) ([]byte, error) {
 	query, err := g.buildGraphqlQuery(g.subjectRepoPath)
 	if err != nil {
@@ -114,6 +136,9 @@ func (g *getEvidenceCustom) transformGraphQLOutput(rawEvidence []byte) ([]byte,
 		if edgeMap, ok := edge.(map[string]any); ok {
 			if node, ok := edgeMap["node"].(map[string]any); ok {
 				evidenceEntry := createOrderedEvidenceEntry(node, g.includePredicate)
+				if !g.createdAtRange.contains(evidenceEntry.CreatedAt) {
+					continue
+				}
 				evidenceArray = append(evidenceArray, evidenceEntry)
 			}
 		}
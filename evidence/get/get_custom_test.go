@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"testing"
 
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/onemodel"
 	"github.com/stretchr/testify/assert"
@@ -58,7 +59,7 @@ func validateEmptyEvidence(t *testing.T, result []byte) {
 // TestNewGetEvidenceCustom
 func TestNewGetEvidenceCustom(t *testing.T) {
 	serverDetails := &config.ServerDetails{}
-	cmd := NewGetEvidenceCustom(serverDetails, "repo/path", "json", "output.json", true)
+	cmd := NewGetEvidenceCustom(serverDetails, evdUtils.ClientOptions{}, "repo/path", "json", "output.json", "", "", "", true)
 
 	// Verify it's of the expected type
 	evidenceCustom, ok := cmd.(*getEvidenceCustom)
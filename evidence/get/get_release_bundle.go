@@ -5,7 +5,7 @@ import (
 	"fmt"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/onemodel"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -32,14 +32,18 @@ type ReleaseBundleOutput struct {
 	Result        ReleaseBundleResult `json:"result"`
 }
 
-func NewGetEvidenceReleaseBundle(serverDetails *config.ServerDetails,
-	releaseBundle, releaseBundleVersion, project, format, outputFileName, artifactsLimit string, includePredicate bool) evidence.Command {
+func NewGetEvidenceReleaseBundle(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions,
+	releaseBundle, releaseBundleVersion, project, format, outputFileName, artifactsLimit, since, until, policyPath string, includePredicate bool) evidence.Command {
 	return &getEvidenceReleaseBundle{
 		getEvidenceBase: getEvidenceBase{
 			serverDetails:    serverDetails,
+			clientOptions:    clientOptions,
 			outputFileName:   outputFileName,
 			format:           format,
 			includePredicate: includePredicate,
+			since:            since,
+			until:            until,
+			policyPath:       policyPath,
 		},
 		project:              project,
 		releaseBundle:        releaseBundle,
@@ -57,7 +61,14 @@ func (g *getEvidenceReleaseBundle) ServerDetails() (*config.ServerDetails, error
 }
 
 func (g *getEvidenceReleaseBundle) Run() error {
-	onemodelClient, err := utils.CreateOnemodelServiceManager(g.serverDetails, false)
+	if err := g.parseCreatedAtRange(); err != nil {
+		return err
+	}
+	if err := g.loadPolicy(); err != nil {
+		return err
+	}
+
+	onemodelClient, err := evdUtils.CreateOnemodelServiceManager(g.serverDetails, g.clientOptions)
 	if err != nil {
 		log.Error("failed to create onemodel client", err)
 		return err
@@ -68,6 +79,10 @@ func (g *getEvidenceReleaseBundle) Run() error {
 		return err
 	}
 
+	if g.policy != nil {
+		return g.checkPolicy(evidenceRecords)
+	}
+
 	err = g.exportEvidenceToFile(evidenceRecords, g.outputFileName, g.format)
 	if err != nil {
 		return err
@@ -76,6 +91,16 @@ func (g *getEvidenceReleaseBundle) Run() error {
 	return nil
 }
 
+// checkPolicy checks the evidence of the release bundle version itself. The evidence of its artifacts and builds isn't
+// considered, as it isn't evidence about the release bundle.
+func (g *getEvidenceReleaseBundle) checkPolicy(evidenceRecords []byte) error {
+	var output ReleaseBundleOutput
+	if err := json.Unmarshal(evidenceRecords, &output); err != nil {
+		return fmt.Errorf("failed to unmarshal evidence: %w", err)
+	}
+	return g.policy.checkPolicy(fmt.Sprintf("release bundle '%s:%s'", g.releaseBundle, g.releaseBundleVersion), output.Result.Evidence)
+}
+
 func (g *getEvidenceReleaseBundle) getEvidence(onemodelClient onemodel.Manager) ([]byte, error) {
 	query := g.buildGraphqlQuery(g.releaseBundle, g.releaseBundleVersion)
 	evidence, err := onemodelClient.GraphqlQuery(query)
@@ -200,6 +225,9 @@ func (g *getEvidenceReleaseBundle) extractEvidenceFromConnection(data map[string
 		}
 
 		evidenceEntry := createOrderedEvidenceEntry(node, g.includePredicate)
+		if !g.createdAtRange.contains(evidenceEntry.CreatedAt) {
+			continue
+		}
 		evidence = append(evidence, evidenceEntry)
 	}
 
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"testing"
 
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/onemodel"
 	"github.com/stretchr/testify/assert"
@@ -28,7 +29,7 @@ func (m *mockOnemodelManagerError) GraphqlQuery(_ []byte) ([]byte, error) {
 
 func TestNewGetEvidenceReleaseBundle(t *testing.T) {
 	serverDetails := &config.ServerDetails{}
-	cmd := NewGetEvidenceReleaseBundle(serverDetails, "myBundle", SchemaVersion, "myProject", "json", "output.json", "1000", true)
+	cmd := NewGetEvidenceReleaseBundle(serverDetails, evdUtils.ClientOptions{}, "myBundle", SchemaVersion, "myProject", "json", "output.json", "1000", "", "", "", true)
 
 	bundle, ok := cmd.(*getEvidenceReleaseBundle)
 
@@ -0,0 +1,55 @@
+package get
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// EvidencePolicy lists the evidence a subject must have, e.g. both an SBOM and a provenance attestation.
+// A policy file looks like: {"requiredPredicateTypes": ["https://spdx.dev/Document", "https://slsa.dev/provenance/v1"]}
+type EvidencePolicy struct {
+	RequiredPredicateTypes []string `json:"requiredPredicateTypes"`
+}
+
+func loadEvidencePolicy(policyPath string) (*EvidencePolicy, error) {
+	content, err := os.ReadFile(policyPath)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to read the evidence policy file '%s': %s", policyPath, err.Error())
+	}
+	policy := &EvidencePolicy{}
+	if err = json.Unmarshal(content, policy); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the evidence policy file '%s': %s", policyPath, err.Error())
+	}
+	if len(policy.RequiredPredicateTypes) == 0 {
+		return nil, errorutils.CheckErrorf("the evidence policy file '%s' has no required predicate types", policyPath)
+	}
+	return policy, nil
+}
+
+// getMissingPredicateTypes returns the required predicate types which none of the evidence has, in the order of the policy.
+func (p *EvidencePolicy) getMissingPredicateTypes(evidence []EvidenceEntry) []string {
+	var missing []string
+	for _, requiredType := range p.RequiredPredicateTypes {
+		if !slices.ContainsFunc(evidence, func(entry EvidenceEntry) bool { return entry.PredicateType == requiredType }) &&
+			!slices.Contains(missing, requiredType) {
+			missing = append(missing, requiredType)
+		}
+	}
+	return missing
+}
+
+// checkPolicy fails when the evidence of the subject lacks any of the predicate types required by the policy.
+func (p *EvidencePolicy) checkPolicy(subject string, evidence []EvidenceEntry) error {
+	missing := p.getMissingPredicateTypes(evidence)
+	if len(missing) > 0 {
+		return errorutils.CheckErrorf("evidence policy check failed for %s. Missing required predicate types: %s", subject, strings.Join(missing, ", "))
+	}
+	log.Info(fmt.Sprintf("Evidence policy check passed for %s. All %d required predicate types are present.", subject, len(p.RequiredPredicateTypes)))
+	return nil
+}
@@ -0,0 +1,40 @@
+package get
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadEvidencePolicy(t *testing.T) {
+	policyPath := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(policyPath, []byte(`{"requiredPredicateTypes": ["https://spdx.dev/Document", "https://slsa.dev/provenance/v1"]}`), 0600))
+	policy, err := loadEvidencePolicy(policyPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://spdx.dev/Document", "https://slsa.dev/provenance/v1"}, policy.RequiredPredicateTypes)
+
+	require.NoError(t, os.WriteFile(policyPath, []byte(`{"requiredPredicateTypes": []}`), 0600))
+	_, err = loadEvidencePolicy(policyPath)
+	assert.ErrorContains(t, err, "has no required predicate types")
+
+	_, err = loadEvidencePolicy(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorContains(t, err, "failed to read the evidence policy file")
+}
+
+func TestEvidencePolicyCheck(t *testing.T) {
+	policy := &EvidencePolicy{RequiredPredicateTypes: []string{"https://spdx.dev/Document", "https://slsa.dev/provenance/v1", "https://in-toto.io/attestation/test-result/v0.1"}}
+	evidence := []EvidenceEntry{
+		{PredicateSlug: "sbom", PredicateType: "https://spdx.dev/Document"},
+		{PredicateSlug: "custom", PredicateType: "https://example.com/custom"},
+	}
+	assert.Equal(t, []string{"https://slsa.dev/provenance/v1", "https://in-toto.io/attestation/test-result/v0.1"}, policy.getMissingPredicateTypes(evidence))
+	assert.EqualError(t, policy.checkPolicy("subject 'repo/file'", evidence),
+		"evidence policy check failed for subject 'repo/file'. Missing required predicate types: https://slsa.dev/provenance/v1, https://in-toto.io/attestation/test-result/v0.1")
+
+	evidence = append(evidence, EvidenceEntry{PredicateType: "https://slsa.dev/provenance/v1"}, EvidenceEntry{PredicateType: "https://in-toto.io/attestation/test-result/v0.1"})
+	assert.Empty(t, policy.getMissingPredicateTypes(evidence))
+	assert.NoError(t, policy.checkPolicy("subject 'repo/file'", evidence))
+}
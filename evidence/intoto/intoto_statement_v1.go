@@ -24,6 +24,15 @@ type Statement struct {
 	CreatedBy     string               `json:"createdBy"`
 	Markdown      string               `json:"markdown,omitempty"`
 	Stage         string               `json:"stage,omitempty"`
+	Promotion     *Promotion           `json:"promotion,omitempty"`
+	Annotations   map[string]string    `json:"annotations,omitempty"`
+}
+
+// Promotion identifies the release bundle promotion the evidence is bound to.
+type Promotion struct {
+	Environment   string `json:"environment"`
+	CreatedMillis string `json:"createdMillis"`
+	CreatedBy     string `json:"createdBy,omitempty"`
 }
 
 type ResourceDescriptor struct {
@@ -65,6 +74,14 @@ func (s *Statement) SetStage(stage string) {
 	s.Stage = stage
 }
 
+func (s *Statement) SetPromotion(promotion *Promotion) {
+	s.Promotion = promotion
+}
+
+func (s *Statement) SetAnnotations(annotations map[string]string) {
+	s.Annotations = annotations
+}
+
 func (s *Statement) Marshal() ([]byte, error) {
 	intotoJson, err := json.Marshal(s)
 	if err != nil {
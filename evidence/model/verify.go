@@ -2,7 +2,7 @@ package model
 
 import "github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
 
-const SchemaVersion = "1.0"
+const SchemaVersion = "1.1"
 
 type VerificationResponse struct {
 	// Update the schemaVersion value when this structure is updated.
@@ -24,6 +24,7 @@ type EvidenceVerification struct {
 	PredicateType      string                     `json:"predicateType"`
 	CreatedBy          string                     `json:"createdBy"`
 	CreatedAt          string                     `json:"createdAt"`
+	Annotations        map[string]string          `json:"annotations,omitempty"`
 	VerificationResult EvidenceVerificationResult `json:"verificationResult"`
 }
 
@@ -32,6 +33,11 @@ type EvidenceVerificationResult struct {
 	SignaturesVerificationStatus VerificationStatus `json:"signaturesVerificationStatus"`
 	KeySource                    string             `json:"keySource,omitempty"`
 	KeyFingerprint               string             `json:"keyFingerprint,omitempty"`
+	// Set only when the evidence envelope carries an RFC 3161 timestamp.
+	TimestampVerificationStatus VerificationStatus `json:"timestampVerificationStatus,omitempty"`
+	TimestampTime               string             `json:"timestampTime,omitempty"`
+	// Set when the signatures verification fails for a known reason, such as a signing scheme mismatch.
+	FailureReason string `json:"failureReason,omitempty"`
 }
 
 type VerificationStatus string
@@ -2,6 +2,7 @@ package evidence
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -23,7 +24,7 @@ type PackageService interface {
 	GetPackageType(artifactoryClient artifactory.ArtifactoryServicesManager) (string, error)
 
 	// GetPackageVersionLeadArtifact retrieves the lead artifact path for a package version
-	// with fallback logic from Artifactory to Metadata service
+	// with fallback logic from Artifactory to Metadata service, and then to searching the artifacts of the version
 	GetPackageVersionLeadArtifact(packageType string, metadataClient metadata.Manager, artifactoryClient artifactory.ArtifactoryServicesManager) (string, error)
 
 	// GetPackageName returns the package name
@@ -88,11 +89,20 @@ func (b *basePackage) GetPackageVersionLeadArtifact(packageType string, metadata
 	leadArtifact, err := artifactoryClient.GetPackageLeadFile(leadFileRequest)
 	if err != nil {
 		// Fallback to metadata service
-		leadArtifactPath, err := b.getPackageVersionLeadArtifactFromMetaData(packageType, metadataClient)
-		if err != nil {
-			return "", fmt.Errorf("failed to get lead artifact from both Artifactory and Metadata services: %w", err)
+		leadArtifactPath, metadataErr := b.getPackageVersionLeadArtifactFromMetaData(packageType, metadataClient)
+		if metadataErr == nil {
+			return b.buildLeadArtifactPath(leadArtifactPath), nil
 		}
-		return b.buildLeadArtifactPath(leadArtifactPath), nil
+		// Fallback to searching the artifacts of the version, for the package types whose artifacts can be located
+		leadArtifactPath, aqlErr := b.getPackageVersionLeadArtifactFromAql(packageType, artifactoryClient)
+		if aqlErr != nil {
+			return "", aqlErr
+		}
+		if leadArtifactPath == "" {
+			return "", errorutils.CheckErrorf("version '%s' of %s package '%s' was not found in repository '%s': %s",
+				b.PackageVersion, packageType, b.PackageName, b.PackageRepoName, errors.Join(err, metadataErr).Error())
+		}
+		return leadArtifactPath, nil
 	}
 
 	leadArtifactPath := strings.Replace(string(leadArtifact), ":", "/", 1)
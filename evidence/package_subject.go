@@ -0,0 +1,57 @@
+package evidence
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const packageVersionAqlQueryTemplate = `items.find({"repo": "%s",%s}).include("repo", "path", "name", "sha256").sort({"%s": ["name"]}).limit(1)`
+
+// packageVersionAqlCriteria returns the AQL criteria matching the lead artifact of a package version, and the sort order
+// which puts the preferred artifact first. It's used to resolve the lead artifact when neither Artifactory nor the Metadata
+// service could provide it. Only the package types whose artifacts can be located by their properties or layout are supported.
+func packageVersionAqlCriteria(packageType, name, version string) (criteria, sortOrder string, err error) {
+	switch strings.ToLower(packageType) {
+	case "npm":
+		return fmt.Sprintf(`"@npm.name": "%s", "@npm.version": "%s", "name": {"$match": "*.tgz"}`, name, version), "$asc", nil
+	case "pypi":
+		// A version may consist of several distributions (wheels and a source distribution), which are equally valid subjects
+		return fmt.Sprintf(`"@pypi.name": "%s", "@pypi.version": "%s"`, name, version), "$asc", nil
+	case "docker", "oci":
+		// The list manifest of a multi-architecture image is preferred over the manifest of a single image
+		return fmt.Sprintf(`"path": "%s/%s", "name": {"$match": "*manifest.json"}`, name, version), "$asc", nil
+	case "maven", "gradle":
+		groupId, artifactId, found := strings.Cut(name, ":")
+		if !found || groupId == "" || artifactId == "" {
+			return "", "", errorutils.CheckErrorf("the name of a %s package must be in the form <groupId>:<artifactId>, got '%s'", packageType, name)
+		}
+		versionPath := path.Join(strings.ReplaceAll(groupId, ".", "/"), artifactId, version)
+		// Unique snapshot versions are sorted descending, so the latest snapshot is the subject
+		return fmt.Sprintf(`"path": "%s", "name": {"$match": "%s-*.pom"}`, versionPath, artifactId), "$desc", nil
+	default:
+		return "", "", nil
+	}
+}
+
+// getPackageVersionLeadArtifactFromAql returns the path of the lead artifact of the package version, or an empty path if
+// the package type is not supported or no artifact was found.
+func (b *basePackage) getPackageVersionLeadArtifactFromAql(packageType string, artifactoryClient artifactory.ArtifactoryServicesManager) (string, error) {
+	criteria, sortOrder, err := packageVersionAqlCriteria(packageType, b.PackageName, b.PackageVersion)
+	if err != nil || criteria == "" {
+		return "", err
+	}
+	result, err := utils.ExecuteAqlQuery(fmt.Sprintf(packageVersionAqlQueryTemplate, b.PackageRepoName, criteria, sortOrder), &artifactoryClient)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Results) == 0 {
+		return "", nil
+	}
+	leadArtifact := result.Results[0]
+	return path.Join(leadArtifact.Repo, leadArtifact.Path, leadArtifact.Name), nil
+}
@@ -0,0 +1,108 @@
+package evidence
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockArtifactoryServicesManagerAql fails to provide the lead file, and returns the given AQL response
+type mockArtifactoryServicesManagerAql struct {
+	mockArtifactoryServicesManagerBadResponse
+	aqlResponse string
+	aqlQuery    string
+}
+
+func (m *mockArtifactoryServicesManagerAql) Aql(query string) (io.ReadCloser, error) {
+	m.aqlQuery = query
+	return io.NopCloser(strings.NewReader(m.aqlResponse)), nil
+}
+
+func TestPackageVersionAqlCriteria(t *testing.T) {
+	tests := []struct {
+		name              string
+		packageType       string
+		packageName       string
+		expectedCriteria  string
+		expectedSortOrder string
+		expectedError     string
+	}{
+		{
+			name:              "npm",
+			packageType:       "npm",
+			packageName:       "@acme/lib",
+			expectedCriteria:  `"@npm.name": "@acme/lib", "@npm.version": "1.0.0", "name": {"$match": "*.tgz"}`,
+			expectedSortOrder: "$asc",
+		},
+		{
+			name:              "pypi",
+			packageType:       "pypi",
+			packageName:       "requests",
+			expectedCriteria:  `"@pypi.name": "requests", "@pypi.version": "1.0.0"`,
+			expectedSortOrder: "$asc",
+		},
+		{
+			name:              "docker",
+			packageType:       "docker",
+			packageName:       "acme/app",
+			expectedCriteria:  `"path": "acme/app/1.0.0", "name": {"$match": "*manifest.json"}`,
+			expectedSortOrder: "$asc",
+		},
+		{
+			name:              "maven",
+			packageType:       "maven",
+			packageName:       "org.acme:lib",
+			expectedCriteria:  `"path": "org/acme/lib/1.0.0", "name": {"$match": "lib-*.pom"}`,
+			expectedSortOrder: "$desc",
+		},
+		{
+			name:          "maven without group",
+			packageType:   "maven",
+			packageName:   "lib",
+			expectedError: "the name of a maven package must be in the form <groupId>:<artifactId>",
+		},
+		{
+			name:        "unsupported",
+			packageType: "nuget",
+			packageName: "MyLibrary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			criteria, sortOrder, err := packageVersionAqlCriteria(tt.packageType, tt.packageName, "1.0.0")
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCriteria, criteria)
+			assert.Equal(t, tt.expectedSortOrder, sortOrder)
+		})
+	}
+}
+
+func TestGetLeadArtifactFromAql(t *testing.T) {
+	artifactoryClientMock := &mockArtifactoryServicesManagerAql{
+		aqlResponse: `{"results": [{"repo": "npm-local", "path": "@acme/lib/-/@acme", "name": "lib-1.0.0.tgz", "sha256": "abc"}]}`,
+	}
+	packageService := NewPackageService("@acme/lib", "1.0.0", "npm-local")
+
+	leadArtifactPath, err := packageService.GetPackageVersionLeadArtifact("npm", &mockMetadataServiceManagerBadResponse{}, artifactoryClientMock)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "npm-local/@acme/lib/-/@acme/lib-1.0.0.tgz", leadArtifactPath)
+	assert.Contains(t, artifactoryClientMock.aqlQuery, `items.find({"repo": "npm-local","@npm.name": "@acme/lib"`)
+}
+
+func TestGetLeadArtifactVersionNotFound(t *testing.T) {
+	artifactoryClientMock := &mockArtifactoryServicesManagerAql{aqlResponse: `{"results": []}`}
+	packageService := NewPackageService("@acme/lib", "2.0.0", "npm-local")
+
+	leadArtifactPath, err := packageService.GetPackageVersionLeadArtifact("npm", &mockMetadataServiceManagerBadResponse{}, artifactoryClientMock)
+
+	assert.ErrorContains(t, err, "version '2.0.0' of npm package '@acme/lib' was not found in repository 'npm-local'")
+	assert.Empty(t, leadArtifactPath)
+}
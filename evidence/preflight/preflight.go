@@ -0,0 +1,165 @@
+package preflight
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gookit/color"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	evdUtils "github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientLog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	statusOk                   = "OK"
+	statusUnreachable          = "Unreachable"
+	statusAuthenticationFailed = "Authentication failed"
+	statusUnexpectedResponse   = "Unexpected response"
+)
+
+type EndpointStatus struct {
+	Service    string `json:"service"`
+	Url        string `json:"url"`
+	Status     string `json:"status"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// endpoint describes a service used by the evidence commands, and the API which is used to check it.
+type endpoint struct {
+	service          string
+	createAuthConfig func() (auth.ServiceDetails, error)
+	healthCheckApi   string
+	// Whether a 401/403 response means that the configured credentials are rejected by the service.
+	checkCredentials bool
+}
+
+type evidencePreflight struct {
+	serverDetails *config.ServerDetails
+	format        string
+	// Sends an authenticated GET request and returns the response status code.
+	sendGet func(url string, serviceDetails auth.ServiceDetails) (int, error)
+}
+
+func NewEvidencePreflight(serverDetails *config.ServerDetails, clientOptions evdUtils.ClientOptions, format string) evidence.Command {
+	return &evidencePreflight{
+		serverDetails: serverDetails,
+		format:        format,
+		sendGet: func(url string, serviceDetails auth.ServiceDetails) (int, error) {
+			return sendAuthenticatedGet(url, serviceDetails, clientOptions)
+		},
+	}
+}
+
+func (p *evidencePreflight) CommandName() string {
+	return "evidence-preflight"
+}
+
+func (p *evidencePreflight) ServerDetails() (*config.ServerDetails, error) {
+	return p.serverDetails, nil
+}
+
+func (p *evidencePreflight) Run() error {
+	statuses := p.checkEndpoints()
+	if err := p.printStatuses(statuses); err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		if status.Status != statusOk {
+			return errorutils.CheckErrorf("evidence preflight checks failed, the %s service is not usable: %s", status.Service, status.Status)
+		}
+	}
+	return nil
+}
+
+func (p *evidencePreflight) getEndpoints() []endpoint {
+	return []endpoint{
+		{service: "artifactory", createAuthConfig: p.serverDetails.CreateArtAuthConfig, healthCheckApi: "api/system/version", checkCredentials: true},
+		{service: "evidence", createAuthConfig: p.serverDetails.CreateEvidenceAuthConfig, healthCheckApi: "api/v1/system/ping", checkCredentials: true},
+		{service: "metadata", createAuthConfig: p.serverDetails.CreateMetadataAuthConfig, healthCheckApi: "api/v1/system/ping"},
+	}
+}
+
+func (p *evidencePreflight) checkEndpoints() []EndpointStatus {
+	var statuses []EndpointStatus
+	for _, e := range p.getEndpoints() {
+		statuses = append(statuses, p.checkEndpoint(e))
+	}
+	return statuses
+}
+
+func (p *evidencePreflight) checkEndpoint(e endpoint) EndpointStatus {
+	status := EndpointStatus{Service: e.service}
+	serviceDetails, err := e.createAuthConfig()
+	if err != nil {
+		status.Status = statusUnreachable
+		status.Error = err.Error()
+		return status
+	}
+	status.Url = serviceDetails.GetUrl() + e.healthCheckApi
+	clientLog.Debug("Checking the", e.service, "service at:", status.Url)
+	status.StatusCode, err = p.sendGet(status.Url, serviceDetails)
+	switch {
+	case err != nil:
+		status.Status = statusUnreachable
+		status.Error = err.Error()
+	case status.StatusCode == http.StatusOK:
+		status.Status = statusOk
+	case e.checkCredentials && (status.StatusCode == http.StatusUnauthorized || status.StatusCode == http.StatusForbidden):
+		status.Status = statusAuthenticationFailed
+	default:
+		status.Status = statusUnexpectedResponse
+	}
+	return status
+}
+
+func (p *evidencePreflight) printStatuses(statuses []EndpointStatus) error {
+	if p.format == "json" {
+		statusesJson, err := json.MarshalIndent(statuses, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(statusesJson))
+		return nil
+	}
+	for _, status := range statuses {
+		fmt.Printf("%-12s %s\n", status.Service+":", status.Url)
+		fmt.Printf("    - Status: %s\n", getColoredStatus(status))
+		if status.Error != "" {
+			fmt.Printf("    - Error:  %s\n", status.Error)
+		}
+	}
+	return nil
+}
+
+func getColoredStatus(status EndpointStatus) string {
+	text := status.Status
+	if status.StatusCode != 0 {
+		text = fmt.Sprintf("%s (%d)", status.Status, status.StatusCode)
+	}
+	if status.Status == statusOk {
+		return color.Green.Render(text)
+	}
+	return color.Red.Render(text)
+}
+
+func sendAuthenticatedGet(url string, serviceDetails auth.ServiceDetails, clientOptions evdUtils.ClientOptions) (int, error) {
+	client, err := httpclient.ClientBuilder().Build()
+	if err != nil {
+		return 0, err
+	}
+	if err = clientOptions.ApplyProxy(client.GetClient()); err != nil {
+		return 0, err
+	}
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, _, _, err := client.SendGet(url, true, httpClientDetails, "")
+	if err != nil {
+		return 0, err
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,113 @@
+package preflight
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServerDetails() *config.ServerDetails {
+	return &config.ServerDetails{
+		Url:            "https://test.jfrog.io/",
+		ArtifactoryUrl: "https://test.jfrog.io/artifactory/",
+		EvidenceUrl:    "https://test.jfrog.io/evidence/",
+		MetadataUrl:    "https://test.jfrog.io/metadata/",
+		AccessToken:    "token",
+	}
+}
+
+func TestCheckEndpoints(t *testing.T) {
+	tests := []struct {
+		name             string
+		responses        map[string]int
+		sendErr          error
+		expectedStatuses map[string]string
+		expectError      bool
+	}{
+		{
+			name:      "All services reachable",
+			responses: map[string]int{"artifactory": http.StatusOK, "evidence": http.StatusOK, "metadata": http.StatusOK},
+			expectedStatuses: map[string]string{
+				"artifactory": statusOk, "evidence": statusOk, "metadata": statusOk,
+			},
+		},
+		{
+			name:      "Evidence rejects credentials",
+			responses: map[string]int{"artifactory": http.StatusOK, "evidence": http.StatusUnauthorized, "metadata": http.StatusOK},
+			expectedStatuses: map[string]string{
+				"artifactory": statusOk, "evidence": statusAuthenticationFailed, "metadata": statusOk,
+			},
+			expectError: true,
+		},
+		{
+			name:      "Metadata unexpected response",
+			responses: map[string]int{"artifactory": http.StatusOK, "evidence": http.StatusOK, "metadata": http.StatusNotFound},
+			expectedStatuses: map[string]string{
+				"artifactory": statusOk, "evidence": statusOk, "metadata": statusUnexpectedResponse,
+			},
+			expectError: true,
+		},
+		{
+			name:    "Services unreachable",
+			sendErr: errors.New("connection refused"),
+			expectedStatuses: map[string]string{
+				"artifactory": statusUnreachable, "evidence": statusUnreachable, "metadata": statusUnreachable,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &evidencePreflight{
+				serverDetails: newTestServerDetails(),
+				sendGet: func(url string, _ auth.ServiceDetails) (int, error) {
+					if tt.sendErr != nil {
+						return 0, tt.sendErr
+					}
+					for service, statusCode := range tt.responses {
+						if strings.Contains(url, "/"+service+"/") {
+							return statusCode, nil
+						}
+					}
+					return 0, errors.New("unexpected url: " + url)
+				},
+			}
+
+			statuses := p.checkEndpoints()
+			assert.Len(t, statuses, len(tt.expectedStatuses))
+			for _, status := range statuses {
+				assert.Equal(t, tt.expectedStatuses[status.Service], status.Status, status.Service)
+			}
+
+			err := p.Run()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckEndpoints_Urls(t *testing.T) {
+	var urls []string
+	p := &evidencePreflight{
+		serverDetails: newTestServerDetails(),
+		sendGet: func(url string, _ auth.ServiceDetails) (int, error) {
+			urls = append(urls, url)
+			return http.StatusOK, nil
+		},
+	}
+	p.checkEndpoints()
+	assert.Equal(t, []string{
+		"https://test.jfrog.io/artifactory/api/system/version",
+		"https://test.jfrog.io/evidence/api/v1/system/ping",
+		"https://test.jfrog.io/metadata/api/v1/system/ping",
+	}, urls)
+}
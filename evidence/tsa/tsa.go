@@ -0,0 +1,64 @@
+package tsa
+
+import (
+	"bytes"
+	"crypto"
+	"net/http"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+)
+
+const timestampQueryContentType = "application/timestamp-query"
+
+// RequestToken obtains an RFC 3161 timestamp token over the SHA-256 digest of data from the TSA at tsaUrl.
+// The returned token is the DER encoded signed data, and includes the TSA certificate.
+func RequestToken(tsaUrl string, data []byte) ([]byte, error) {
+	request, err := timestamp.CreateRequest(bytes.NewReader(data), &timestamp.RequestOptions{
+		Hash:         crypto.SHA256,
+		Certificates: true,
+	})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	client, err := httpclient.ClientBuilder().Build()
+	if err != nil {
+		return nil, err
+	}
+	httpClientDetails := httputils.HttpClientDetails{Headers: map[string]string{"Content-Type": timestampQueryContentType}}
+	resp, body, err := client.SendPost(tsaUrl, request, httpClientDetails, "")
+	// Server errors are retried, so once the retries are exhausted the error only reports the timeout. The status
+	// code of the last response is more helpful.
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckErrorf("failed to obtain a timestamp from '%s', status code: %d", tsaUrl, resp.StatusCode)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ts, err := timestamp.ParseResponse(body)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the timestamp response from '%s': %s", tsaUrl, err.Error())
+	}
+	return ts.RawToken, nil
+}
+
+// VerifyToken verifies the signature of an RFC 3161 timestamp token and that it was issued over data.
+// Returns the time asserted by the TSA.
+func VerifyToken(token, data []byte) (time.Time, error) {
+	ts, err := timestamp.Parse(token)
+	if err != nil {
+		return time.Time{}, errorutils.CheckErrorf("invalid timestamp token: %s", err.Error())
+	}
+	if !ts.HashAlgorithm.Available() {
+		return time.Time{}, errorutils.CheckErrorf("unsupported timestamp hash algorithm: %s", ts.HashAlgorithm.String())
+	}
+	hash := ts.HashAlgorithm.New()
+	hash.Write(data)
+	if !bytes.Equal(hash.Sum(nil), ts.HashedMessage) {
+		return time.Time{}, errorutils.CheckErrorf("the timestamp token was not issued over the evidence signature")
+	}
+	return ts.Time, nil
+}
@@ -0,0 +1,90 @@
+package tsa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/digitorus/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTsa(t *testing.T, issuedAt time.Time) *httptest.Server {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test TSA"},
+		NotBefore:             issuedAt.Add(-time.Hour),
+		NotAfter:              issuedAt.Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+		BasicConstraintsValid: true,
+	}
+	certDer, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certDer)
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, timestampQueryContentType, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		request, err := timestamp.ParseRequest(body)
+		require.NoError(t, err)
+		ts := timestamp.Timestamp{
+			HashAlgorithm:     request.HashAlgorithm,
+			HashedMessage:     request.HashedMessage,
+			Time:              issuedAt,
+			Nonce:             request.Nonce,
+			Policy:            asn1.ObjectIdentifier{1, 2, 3, 4, 1},
+			AddTSACertificate: request.Certificates,
+		}
+		response, err := ts.CreateResponse(cert, privateKey)
+		require.NoError(t, err)
+		_, err = w.Write(response)
+		assert.NoError(t, err)
+	}))
+}
+
+func TestRequestAndVerifyToken(t *testing.T) {
+	// The TSA certificate must be valid at the current time, when the response is signed.
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	server := newTestTsa(t, issuedAt)
+	defer server.Close()
+
+	signature := []byte("evidence-signature")
+	token, err := RequestToken(server.URL, signature)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	verifiedTime, err := VerifyToken(token, signature)
+	require.NoError(t, err)
+	assert.True(t, issuedAt.Equal(verifiedTime))
+
+	_, err = VerifyToken(token, []byte("other-signature"))
+	assert.ErrorContains(t, err, "was not issued over the evidence signature")
+
+	_, err = VerifyToken([]byte("not-a-token"), signature)
+	assert.ErrorContains(t, err, "invalid timestamp token")
+}
+
+func TestRequestToken_TsaError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := RequestToken(server.URL, []byte("evidence-signature"))
+	assert.ErrorContains(t, err, "status code: 503")
+}
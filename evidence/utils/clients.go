@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/auth"
+	clientConfig "github.com/jfrog/jfrog-client-go/config"
+	"github.com/jfrog/jfrog-client-go/evidence"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/lifecycle"
+	"github.com/jfrog/jfrog-client-go/metadata"
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// ClientOptions holds the settings of the clients an evidence command sends its JFrog Platform requests with, which
+// aren't part of the server details.
+type ClientOptions struct {
+	// The URL of the proxy the requests are sent through. When empty, the HTTPS_PROXY and HTTP_PROXY environment
+	// variables are honored.
+	ProxyUrl string
+	// The custom headers sent with the requests, in the 'Name=value' format.
+	Headers []string
+}
+
+// jfrogClientProvider is implemented by the service managers of all the services, though not all their interfaces declare it.
+type jfrogClientProvider interface {
+	Client() *jfroghttpclient.JfrogHttpClient
+}
+
+// ApplyProxy routes the requests of the HTTP client through the proxy of the options, by setting it on the client transport.
+func (options ClientOptions) ApplyProxy(httpClient *http.Client) error {
+	if options.ProxyUrl == "" {
+		return nil
+	}
+	proxyUrl, err := url.Parse(options.ProxyUrl)
+	if err != nil {
+		return errorutils.CheckErrorf("invalid proxy URL '%s': %s", options.ProxyUrl, err.Error())
+	}
+	transport, ok := httpClient.Transport.(*http.Transport)
+	if !ok {
+		return errorutils.CheckErrorf("can't set the proxy on a client with a custom transport")
+	}
+	transport.Proxy = http.ProxyURL(proxyUrl)
+	return nil
+}
+
+func (options ClientOptions) applyToServiceManager(serviceManager interface{}) error {
+	clientProvider, ok := serviceManager.(jfrogClientProvider)
+	if !ok {
+		return errorutils.CheckErrorf("the service manager doesn't expose its HTTP client")
+	}
+	return options.ApplyProxy(clientProvider.Client().GetHttpClient().GetClient())
+}
+
+// createServiceConfig returns the config of a client of the service, which sends the custom headers of the options. If
+// the value sent for httpRetries is negative, the default will be used.
+func (options ClientOptions) createServiceConfig(serverDetails *config.ServerDetails, serviceDetails auth.ServiceDetails, httpRetries int) (clientConfig.Config, error) {
+	certsPath, err := coreutils.GetJfrogCertsDir()
+	if err != nil {
+		return nil, err
+	}
+	if err = options.applyHeaders(serviceDetails); err != nil {
+		return nil, err
+	}
+	configBuilder := clientConfig.NewConfigBuilder().
+		SetServiceDetails(serviceDetails).
+		SetCertificatesPath(certsPath).
+		SetInsecureTls(serverDetails.InsecureTls)
+	if httpRetries >= 0 {
+		configBuilder.SetHttpRetries(httpRetries)
+	}
+	return configBuilder.Build()
+}
+
+// CreateArtifactoryServiceManager creates an Artifactory client with the client options. If the value sent for
+// httpRetries is negative, the default will be used.
+func CreateArtifactoryServiceManager(serverDetails *config.ServerDetails, httpRetries int, options ClientOptions) (artifactory.ArtifactoryServicesManager, error) {
+	artAuth, err := serverDetails.CreateArtAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	serviceConfig, err := options.createServiceConfig(serverDetails, artAuth, httpRetries)
+	if err != nil {
+		return nil, err
+	}
+	serviceManager, err := artifactory.New(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	return serviceManager, options.applyToServiceManager(serviceManager)
+}
+
+// CreateEvidenceServiceManager creates an evidence client with the client options.
+func CreateEvidenceServiceManager(serverDetails *config.ServerDetails, options ClientOptions) (*evidence.EvidenceServicesManager, error) {
+	evdAuth, err := serverDetails.CreateEvidenceAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	serviceConfig, err := options.createServiceConfig(serverDetails, evdAuth, -1)
+	if err != nil {
+		return nil, err
+	}
+	serviceManager, err := evidence.New(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	return serviceManager, options.applyToServiceManager(serviceManager)
+}
+
+// CreateOnemodelServiceManager creates a OneModel GraphQL client with the client options.
+func CreateOnemodelServiceManager(serverDetails *config.ServerDetails, options ClientOptions) (onemodel.Manager, error) {
+	onemodelAuth, err := serverDetails.CreateOnemodelAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	serviceConfig, err := options.createServiceConfig(serverDetails, onemodelAuth, -1)
+	if err != nil {
+		return nil, err
+	}
+	serviceManager, err := onemodel.NewManager(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	return serviceManager, options.applyToServiceManager(serviceManager)
+}
+
+// CreateMetadataServiceManager creates a metadata client with the client options.
+func CreateMetadataServiceManager(serverDetails *config.ServerDetails, options ClientOptions) (metadata.Manager, error) {
+	metadataAuth, err := serverDetails.CreateMetadataAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	serviceConfig, err := options.createServiceConfig(serverDetails, metadataAuth, -1)
+	if err != nil {
+		return nil, err
+	}
+	serviceManager, err := metadata.NewManager(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	return serviceManager, options.applyToServiceManager(serviceManager)
+}
+
+// CreateLifecycleServiceManager creates a lifecycle client with the client options.
+func CreateLifecycleServiceManager(serverDetails *config.ServerDetails, options ClientOptions) (*lifecycle.LifecycleServicesManager, error) {
+	lifecycleAuth, err := serverDetails.CreateLifecycleAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	serviceConfig, err := options.createServiceConfig(serverDetails, lifecycleAuth, -1)
+	if err != nil {
+		return nil, err
+	}
+	serviceManager, err := lifecycle.New(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	return serviceManager, options.applyToServiceManager(serviceManager)
+}
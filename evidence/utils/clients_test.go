@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/evidence/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateServiceManagersWithProxy(t *testing.T) {
+	t.Setenv("JFROG_CLI_HOME_DIR", t.TempDir())
+	// The platform host doesn't resolve, so a request only succeeds when it's sent through the proxy.
+	serverDetails := &config.ServerDetails{
+		ArtifactoryUrl: "http://platform.invalid/artifactory/",
+		EvidenceUrl:    "http://platform.invalid/evidence/",
+		MetadataUrl:    "http://platform.invalid/metadata/",
+		OnemodelUrl:    "http://platform.invalid/onemodel/",
+		AccessToken:    "token",
+	}
+
+	tests := []struct {
+		name            string
+		sendRequest     func(options ClientOptions) error
+		expectedUrlPath string
+	}{
+		{
+			name: "Artifactory",
+			sendRequest: func(options ClientOptions) error {
+				serviceManager, err := CreateArtifactoryServiceManager(serverDetails, 0, options)
+				if err != nil {
+					return err
+				}
+				_, err = serviceManager.GetVersion()
+				return err
+			},
+			expectedUrlPath: "/artifactory/api/system/version",
+		},
+		{
+			name: "Evidence",
+			sendRequest: func(options ClientOptions) error {
+				serviceManager, err := CreateEvidenceServiceManager(serverDetails, options)
+				if err != nil {
+					return err
+				}
+				_, err = serviceManager.UploadEvidence(services.EvidenceDetails{SubjectUri: "repo/file", DSSEFileRaw: []byte("{}")})
+				return err
+			},
+			expectedUrlPath: "/evidence/api/v1/subject/repo/file",
+		},
+		{
+			name: "Onemodel",
+			sendRequest: func(options ClientOptions) error {
+				serviceManager, err := CreateOnemodelServiceManager(serverDetails, options)
+				if err != nil {
+					return err
+				}
+				_, err = serviceManager.GraphqlQuery([]byte(`{"query":"{}"}`))
+				return err
+			},
+			expectedUrlPath: "/onemodel/api/v1/graphql",
+		},
+		{
+			name: "Metadata",
+			sendRequest: func(options ClientOptions) error {
+				serviceManager, err := CreateMetadataServiceManager(serverDetails, options)
+				if err != nil {
+					return err
+				}
+				_, err = serviceManager.GraphqlQuery([]byte(`{"query":"{}"}`))
+				return err
+			},
+			expectedUrlPath: "/metadata/api/v1/query",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var proxiedUrls []string
+			proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// A request sent through a proxy names the absolute URL of the target.
+				proxiedUrls = append(proxiedUrls, r.URL.String())
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"version":"7.100.0"}`))
+			}))
+			defer proxy.Close()
+
+			require.NoError(t, tt.sendRequest(ClientOptions{ProxyUrl: proxy.URL}))
+			assert.Contains(t, proxiedUrls, "http://platform.invalid"+tt.expectedUrlPath)
+		})
+	}
+}
+
+func TestCreateArtifactoryServiceManagerWithHeaders(t *testing.T) {
+	t.Setenv("JFROG_CLI_HOME_DIR", t.TempDir())
+	var receivedHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedHeader = r.Header.Get("X-Team")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"version":"7.100.0"}`))
+	}))
+	defer server.Close()
+
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: server.URL + "/", AccessToken: "token"}
+	serviceManager, err := CreateArtifactoryServiceManager(serverDetails, 0, ClientOptions{Headers: []string{"X-Team=platform"}})
+	require.NoError(t, err)
+	_, err = serviceManager.GetVersion()
+	require.NoError(t, err)
+	assert.Equal(t, "platform", receivedHeader)
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+)
+
+const redactedHeaderValue = "***"
+
+// The headers which are set by the clients themselves, for authentication or to describe the request body, and therefore can't be overridden.
+var reservedHeaders = []string{"Authorization", "Proxy-Authorization", "X-Jfrog-Art-Api", "Host", "Content-Length", "Content-Type", "Transfer-Encoding"}
+
+// Header names containing any of these words are redacted when logged.
+var sensitiveHeaderWords = []string{"auth", "token", "secret", "password", "key", "cookie", "session"}
+
+// A header name is an RFC 7230 token.
+var headerNameRegexp = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// ParseCustomHeaders parses headers of the form 'Name=value' into a map keyed by the canonical header names.
+func ParseCustomHeaders(headers []string) (map[string]string, error) {
+	parsedHeaders := make(map[string]string)
+	for _, header := range headers {
+		if strings.TrimSpace(header) == "" {
+			continue
+		}
+		name, value, found := strings.Cut(header, "=")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, errorutils.CheckErrorf("invalid header '%s': expected the form 'Name=value'", header)
+		}
+		if !headerNameRegexp.MatchString(name) {
+			return nil, errorutils.CheckErrorf("invalid header name '%s'", name)
+		}
+		value = strings.TrimSpace(value)
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, errorutils.CheckErrorf("invalid value for header '%s': line breaks are not allowed", name)
+		}
+		name = http.CanonicalHeaderKey(name)
+		for _, reservedHeader := range reservedHeaders {
+			if strings.EqualFold(name, reservedHeader) {
+				return nil, errorutils.CheckErrorf("the header '%s' is set by the client and can't be overridden", name)
+			}
+		}
+		if _, exists := parsedHeaders[name]; exists {
+			return nil, errorutils.CheckErrorf("the header '%s' is provided more than once", name)
+		}
+		parsedHeaders[name] = value
+	}
+	return parsedHeaders, nil
+}
+
+// FormatHeadersForLog returns the headers sorted by name, with the values of sensitive headers redacted.
+func FormatHeadersForLog(headers map[string]string) string {
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	formatted := make([]string, 0, len(names))
+	for _, name := range names {
+		value := headers[name]
+		if isSensitiveHeader(name) {
+			value = redactedHeaderValue
+		}
+		formatted = append(formatted, fmt.Sprintf("%s: %s", name, value))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func isSensitiveHeader(name string) bool {
+	lowerName := strings.ToLower(name)
+	for _, word := range sensitiveHeaderWords {
+		if strings.Contains(lowerName, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyHeaders adds the custom headers of the options to the requests of the clients created with these service details.
+func (options ClientOptions) applyHeaders(serviceDetails auth.ServiceDetails) error {
+	headers, err := ParseCustomHeaders(options.Headers)
+	if err != nil {
+		return err
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+	serviceDetails.AppendPreRequestFunction(func(_ *auth.CommonConfigFields, httpClientDetails *httputils.HttpClientDetails) error {
+		if httpClientDetails.Headers == nil {
+			httpClientDetails.Headers = make(map[string]string)
+		}
+		for name, value := range headers {
+			httpClientDetails.Headers[name] = value
+		}
+		return nil
+	})
+	return nil
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"testing"
+
+	evidenceAuth "github.com/jfrog/jfrog-client-go/evidence/auth"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCustomHeaders(t *testing.T) {
+	tests := []struct {
+		name            string
+		headers         []string
+		expectedHeaders map[string]string
+		expectedError   string
+	}{
+		{
+			name:            "Canonical names",
+			headers:         []string{"x-request-source=ci", " X-Team = platform "},
+			expectedHeaders: map[string]string{"X-Request-Source": "ci", "X-Team": "platform"},
+		},
+		{
+			name:            "Value with equal sign",
+			headers:         []string{"X-Query=a=b"},
+			expectedHeaders: map[string]string{"X-Query": "a=b"},
+		},
+		{
+			name:            "Empty entries",
+			headers:         []string{"", "X-Team=platform"},
+			expectedHeaders: map[string]string{"X-Team": "platform"},
+		},
+		{
+			name:          "Missing separator",
+			headers:       []string{"X-Team: platform"},
+			expectedError: "invalid header 'X-Team: platform': expected the form 'Name=value'",
+		},
+		{
+			name:          "Missing name",
+			headers:       []string{"=platform"},
+			expectedError: "invalid header '=platform': expected the form 'Name=value'",
+		},
+		{
+			name:          "Invalid name",
+			headers:       []string{"X Team=platform"},
+			expectedError: "invalid header name 'X Team'",
+		},
+		{
+			name:          "Line break in value",
+			headers:       []string{"X-Team=plat\nform"},
+			expectedError: "invalid value for header 'X-Team': line breaks are not allowed",
+		},
+		{
+			name:          "Reserved header",
+			headers:       []string{"authorization=Bearer token"},
+			expectedError: "the header 'Authorization' is set by the client and can't be overridden",
+		},
+		{
+			name:          "Duplicate header",
+			headers:       []string{"X-Team=platform", "x-team=security"},
+			expectedError: "the header 'X-Team' is provided more than once",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers, err := ParseCustomHeaders(tt.headers)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedHeaders, headers)
+		})
+	}
+}
+
+func TestFormatHeadersForLog(t *testing.T) {
+	headers := map[string]string{"X-Team": "platform", "X-Api-Key": "secret-value", "X-Session-Id": "1234"}
+	assert.Equal(t, "X-Api-Key: ***, X-Session-Id: ***, X-Team: platform", FormatHeadersForLog(headers))
+}
+
+func TestApplyHeaders(t *testing.T) {
+	serviceDetails := evidenceAuth.NewEvidenceDetails()
+	require.NoError(t, ClientOptions{Headers: []string{"X-Team=platform"}}.applyHeaders(serviceDetails))
+	httpClientDetails := &httputils.HttpClientDetails{}
+	require.NoError(t, serviceDetails.RunPreRequestFunctions(httpClientDetails))
+	assert.Equal(t, map[string]string{"X-Team": "platform"}, httpClientDetails.Headers)
+
+	serviceDetails = evidenceAuth.NewEvidenceDetails()
+	require.NoError(t, ClientOptions{}.applyHeaders(serviceDetails))
+	assert.Empty(t, serviceDetails.GetPreRequestFunctions())
+
+	assert.ErrorContains(t, ClientOptions{Headers: []string{"Authorization=Bearer token"}}.applyHeaders(serviceDetails), "can't be overridden")
+}
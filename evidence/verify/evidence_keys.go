@@ -0,0 +1,158 @@
+package verify
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientLog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// evidenceKey is a public key registered on the platform, with which evidence of the subject was signed.
+type evidenceKey struct {
+	alias       string
+	fingerprint string
+	pem         []byte
+	// The predicate types of the evidence signed with the key.
+	predicateTypes []string
+}
+
+// getEvidenceKeysReleaseBundle retrieves the public keys with which the evidence of a release bundle can be verified.
+type getEvidenceKeysReleaseBundle struct {
+	verifyEvidenceBase
+	project              string
+	releaseBundle        string
+	releaseBundleVersion string
+	keyAlias             string
+	outputFileName       string
+}
+
+// NewGetEvidenceKeysReleaseBundle creates a command which outputs the public keys registered for verifying the evidence of a
+// release bundle version, in PEM. Writing to a file requires a single key, so it can be passed as is to verify-evidence.
+func NewGetEvidenceKeysReleaseBundle(serverDetails *config.ServerDetails, clientOptions utils.ClientOptions, project, releaseBundle, releaseBundleVersion, keyAlias, outputFileName string) evidence.Command {
+	return &getEvidenceKeysReleaseBundle{
+		verifyEvidenceBase: verifyEvidenceBase{
+			serverDetails:      serverDetails,
+			clientOptions:      clientOptions,
+			useArtifactoryKeys: true,
+		},
+		project:              project,
+		releaseBundle:        releaseBundle,
+		releaseBundleVersion: releaseBundleVersion,
+		keyAlias:             keyAlias,
+		outputFileName:       outputFileName,
+	}
+}
+
+func (c *getEvidenceKeysReleaseBundle) CommandName() string {
+	return "get-release-bundle-evidence-keys"
+}
+
+func (c *getEvidenceKeysReleaseBundle) ServerDetails() (*config.ServerDetails, error) {
+	return c.serverDetails, nil
+}
+
+func (c *getEvidenceKeysReleaseBundle) Run() error {
+	artifactoryClient, err := c.createArtifactoryClient()
+	if err != nil {
+		return fmt.Errorf("failed to create Artifactory client: %w", err)
+	}
+	repoKey := utils.BuildReleaseBundleRepoKey(c.project)
+	path := fmt.Sprintf("%s/%s", c.releaseBundle, c.releaseBundleVersion)
+	if _, err = getReleaseBundleManifestSha256(artifactoryClient, repoKey, path); err != nil {
+		return err
+	}
+	metadata, err := c.queryEvidenceMetadata(repoKey, path, releaseBundleManifestName)
+	if err != nil {
+		return err
+	}
+	keys, err := collectEvidenceKeys(*metadata, c.keyAlias)
+	if err != nil {
+		return err
+	}
+	return writeEvidenceKeys(keys, c.outputFileName)
+}
+
+// collectEvidenceKeys returns the distinct public keys with which the evidence was signed, in the order of the evidence.
+// When an alias is provided, only the keys registered under it are returned.
+func collectEvidenceKeys(edges []model.SearchEvidenceEdge, keyAlias string) ([]*evidenceKey, error) {
+	var keys []*evidenceKey
+	keysByFingerprint := make(map[string]*evidenceKey)
+	for _, edge := range edges {
+		signingKey := edge.Node.SigningKey
+		if signingKey.PublicKey == "" || (keyAlias != "" && signingKey.Alias != keyAlias) {
+			continue
+		}
+		key, err := toEvidenceKey(signingKey)
+		if err != nil {
+			return nil, err
+		}
+		if existingKey, ok := keysByFingerprint[key.fingerprint]; ok {
+			key = existingKey
+		} else {
+			keysByFingerprint[key.fingerprint] = key
+			keys = append(keys, key)
+		}
+		key.predicateTypes = append(key.predicateTypes, edge.Node.PredicateType)
+	}
+	if len(keys) == 0 {
+		if keyAlias != "" {
+			return nil, errorutils.CheckErrorf("no public key with the alias '%s' is registered for the evidence of the subject", keyAlias)
+		}
+		return nil, errorutils.CheckErrorf("no public key is registered for the evidence of the subject")
+	}
+	return keys, nil
+}
+
+// toEvidenceKey parses the registered key, so its PEM encoding is validated and normalized.
+func toEvidenceKey(signingKey model.SingingKey) (*evidenceKey, error) {
+	loadedKey, err := cryptox.LoadKey([]byte(signingKey.PublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the public key '%s': %w", signingKey.Alias, err)
+	}
+	verifiers, err := cryptox.CreateVerifier(loadedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the public key '%s': %w", signingKey.Alias, err)
+	}
+	publicKey := verifiers[0].Public()
+	pem, err := cryptox.MarshalPublicKeyPEM(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint, err := cryptox.GenerateFingerprint(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &evidenceKey{alias: signingKey.Alias, fingerprint: fingerprint, pem: pem}, nil
+}
+
+// writeEvidenceKeys prints the keys, each preceded by a comment line describing it, or writes the single key to the output file.
+func writeEvidenceKeys(keys []*evidenceKey, outputFileName string) error {
+	for _, key := range keys {
+		clientLog.Info(fmt.Sprintf("Found the key '%s' (fingerprint %s), which signed evidence of types: %s", key.alias, key.fingerprint, strings.Join(key.predicateTypes, ", ")))
+	}
+	if outputFileName == "" {
+		for _, key := range keys {
+			fmt.Printf("# alias: %s, fingerprint: %s\n%s", key.alias, key.fingerprint, key.pem)
+		}
+		return nil
+	}
+	if len(keys) > 1 {
+		var aliases []string
+		for _, key := range keys {
+			aliases = append(aliases, fmt.Sprintf("'%s'", key.alias))
+		}
+		return errorutils.CheckErrorf("%d public keys are registered for the evidence of the subject: %s. Select one with --key-alias to write it to a file", len(keys), strings.Join(aliases, ", "))
+	}
+	if err := os.WriteFile(outputFileName, keys[0].pem, 0644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	clientLog.Info("Public key successfully exported to file name:", outputFileName)
+	return nil
+}
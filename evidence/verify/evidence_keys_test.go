@@ -0,0 +1,104 @@
+package verify
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestPublicKey(t *testing.T, name string) string {
+	content, err := os.ReadFile(filepath.Join("..", "cryptox", "testdata", name))
+	require.NoError(t, err)
+	// The exported keys end with a newline, unlike the test keys.
+	return strings.TrimSpace(string(content)) + "\n"
+}
+
+func newEvidenceKeyEdge(predicateType, alias, publicKey string) model.SearchEvidenceEdge {
+	edge := model.SearchEvidenceEdge{}
+	edge.Node.PredicateType = predicateType
+	edge.Node.SigningKey = model.SingingKey{Alias: alias, PublicKey: publicKey}
+	return edge
+}
+
+func TestCollectEvidenceKeys(t *testing.T) {
+	ecdsaKey := readTestPublicKey(t, "ecdsa-test-key-pem.pub")
+	rsaKey := readTestPublicKey(t, "rsa-test-key.pub")
+	edges := []model.SearchEvidenceEdge{
+		newEvidenceKeyEdge("https://slsa.dev/provenance/v1", "release-key", ecdsaKey),
+		newEvidenceKeyEdge("https://cyclonedx.org/bom", "qa-key", rsaKey),
+		newEvidenceKeyEdge("https://in-toto.io/attestation/test-result/v0.1", "release-key", ecdsaKey),
+		newEvidenceKeyEdge("https://jfrog.com/evidence/unsigned", "", ""),
+	}
+
+	keys, err := collectEvidenceKeys(edges, "")
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "release-key", keys[0].alias)
+	assert.Equal(t, []string{"https://slsa.dev/provenance/v1", "https://in-toto.io/attestation/test-result/v0.1"}, keys[0].predicateTypes)
+	assert.Equal(t, ecdsaKey, string(keys[0].pem))
+	assert.NotEmpty(t, keys[0].fingerprint)
+	assert.Equal(t, "qa-key", keys[1].alias)
+
+	keys, err = collectEvidenceKeys(edges, "qa-key")
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, []string{"https://cyclonedx.org/bom"}, keys[0].predicateTypes)
+
+	_, err = collectEvidenceKeys(edges, "other-key")
+	assert.EqualError(t, err, "no public key with the alias 'other-key' is registered for the evidence of the subject")
+
+	_, err = collectEvidenceKeys(edges[3:], "")
+	assert.EqualError(t, err, "no public key is registered for the evidence of the subject")
+
+	_, err = collectEvidenceKeys([]model.SearchEvidenceEdge{newEvidenceKeyEdge("https://slsa.dev/provenance/v1", "bad-key", "not-a-key")}, "")
+	assert.ErrorContains(t, err, "failed to load the public key 'bad-key'")
+}
+
+func TestGetEvidenceKeysReleaseBundle_Run(t *testing.T) {
+	ecdsaKey := readTestPublicKey(t, "ecdsa-test-key-pem.pub")
+	rsaKey := readTestPublicKey(t, "rsa-test-key.pub")
+	edges := []model.SearchEvidenceEdge{
+		newEvidenceKeyEdge("https://slsa.dev/provenance/v1", "release-key", ecdsaKey),
+		newEvidenceKeyEdge("https://cyclonedx.org/bom", "qa-key", rsaKey),
+	}
+	response := model.ResponseSearchEvidence{}
+	response.Data.Evidence.SearchEvidence.Edges = edges
+	graphqlResponse, err := json.Marshal(response)
+	require.NoError(t, err)
+
+	newCommand := func(keyAlias, outputFileName string) *getEvidenceKeysReleaseBundle {
+		c := NewGetEvidenceKeysReleaseBundle(&config.ServerDetails{}, utils.ClientOptions{}, "", "app", "1.0.0", keyAlias, outputFileName).(*getEvidenceKeysReleaseBundle)
+		artifactoryClient := artifactory.ArtifactoryServicesManager(&MockArtifactoryServicesManagerReleaseBundle{
+			AqlResponse: `{"results":[{"sha256":"test-sha256","name":"release-bundle.json.evd"}]}`,
+		})
+		c.artifactoryClient = &artifactoryClient
+		c.oneModelClient = &MockOneModelManagerReleaseBundle{GraphqlResponse: graphqlResponse}
+		return c
+	}
+
+	output := captureOutput(func() {
+		assert.NoError(t, newCommand("", "").Run())
+	})
+	assert.Contains(t, output, "# alias: release-key, fingerprint: ")
+	assert.Contains(t, output, ecdsaKey)
+	assert.Contains(t, output, rsaKey)
+
+	outputFileName := filepath.Join(t.TempDir(), "key.pem")
+	assert.EqualError(t, newCommand("", outputFileName).Run(),
+		"2 public keys are registered for the evidence of the subject: 'release-key', 'qa-key'. Select one with --key-alias to write it to a file")
+	assert.NoFileExists(t, outputFileName)
+
+	require.NoError(t, newCommand("qa-key", outputFileName).Run())
+	content, err := os.ReadFile(outputFileName)
+	require.NoError(t, err)
+	assert.Equal(t, rsaKey, string(content))
+}
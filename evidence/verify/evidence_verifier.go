@@ -1,14 +1,19 @@
 package verify
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
 	"io"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/tsa"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	clientLog "github.com/jfrog/jfrog-client-go/utils/log"
 )
@@ -57,7 +62,8 @@ func (v *evidenceVerifier) Verify(subjectSha256 string, evidenceMetadata *[]mode
 			return nil, err
 		}
 		results = append(results, *verification)
-		if verification.VerificationResult.SignaturesVerificationStatus == model.Failed || verification.VerificationResult.Sha256VerificationStatus == model.Failed {
+		if verification.VerificationResult.SignaturesVerificationStatus == model.Failed || verification.VerificationResult.Sha256VerificationStatus == model.Failed ||
+			verification.VerificationResult.TimestampVerificationStatus == model.Failed {
 			result.OverallVerificationStatus = model.Failed
 		}
 	}
@@ -88,11 +94,13 @@ func (v *evidenceVerifier) verifyEvidence(evidence *model.SearchEvidenceEdge, su
 		PredicateType:   evidence.Node.PredicateType,
 		CreatedBy:       evidence.Node.CreatedBy,
 		CreatedAt:       evidence.Node.CreatedAt,
+		Annotations:     getStatementAnnotations(&envelope),
 		VerificationResult: model.EvidenceVerificationResult{
 			Sha256VerificationStatus:     checksumStatus,
 			SignaturesVerificationStatus: model.Failed,
 		},
 	}
+	verifyTimestamp(&envelope, result)
 	localVerifiers, err := v.getLocalVerifiers()
 	if err != nil && v.keys != nil && len(v.keys) > 0 {
 		return nil, err
@@ -132,13 +140,93 @@ func verifyEnvelope(verifiers []dsse.Verifier, envelope *dsse.Envelope, result *
 			} else {
 				result.VerificationResult.KeyFingerprint = fingerprint
 			}
+			result.VerificationResult.FailureReason = ""
 			return true
 		}
 	}
 	result.VerificationResult.SignaturesVerificationStatus = model.Failed
+	result.VerificationResult.FailureReason = getSchemeMismatch(verifiers, envelope)
 	return false
 }
 
+// getSchemeMismatch returns a description of the mismatch between the signing schemes of the envelope signatures and of
+// the verification keys, or an empty string if any key matches the scheme of any signature, or a scheme is unknown.
+func getSchemeMismatch(verifiers []dsse.Verifier, envelope *dsse.Envelope) string {
+	var signatureSchemes, keySchemes []string
+	for _, signature := range envelope.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(signature.Sig)
+		if err != nil {
+			continue
+		}
+		if scheme := cryptox.SignatureScheme(sig); scheme != "" && !slices.Contains(signatureSchemes, scheme) {
+			signatureSchemes = append(signatureSchemes, scheme)
+		}
+	}
+	for _, verifier := range verifiers {
+		if scheme := cryptox.KeyScheme(verifier.Public()); scheme != "" && !slices.Contains(keySchemes, scheme) {
+			keySchemes = append(keySchemes, scheme)
+		}
+	}
+	if len(signatureSchemes) == 0 || len(keySchemes) == 0 {
+		return ""
+	}
+	for _, keyScheme := range keySchemes {
+		if slices.Contains(signatureSchemes, keyScheme) {
+			return ""
+		}
+	}
+	if len(keySchemes) == 1 {
+		return fmt.Sprintf("envelope signed with %s but provided key is %s", strings.Join(signatureSchemes, ", "), keySchemes[0])
+	}
+	return fmt.Sprintf("envelope signed with %s but provided keys are %s", strings.Join(signatureSchemes, ", "), strings.Join(keySchemes, ", "))
+}
+
+// getStatementAnnotations returns the annotations of the in-toto statement carried by the envelope, if any.
+func getStatementAnnotations(envelope *dsse.Envelope) map[string]string {
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		clientLog.Debug("Failed to decode the evidence payload:", err.Error())
+		return nil
+	}
+	statement := struct {
+		Annotations map[string]string `json:"annotations"`
+	}{}
+	if err = json.Unmarshal(payload, &statement); err != nil {
+		clientLog.Debug("Failed to parse the evidence statement:", err.Error())
+		return nil
+	}
+	return statement.Annotations
+}
+
+// verifyTimestamp validates the RFC 3161 timestamp attached to the envelope, if any, against the first envelope signature.
+func verifyTimestamp(envelope *dsse.Envelope, result *model.EvidenceVerification) {
+	if envelope.Metadata == nil || envelope.Metadata.TimestampToken == "" {
+		return
+	}
+	result.VerificationResult.TimestampVerificationStatus = model.Failed
+	if len(envelope.Signatures) == 0 {
+		clientLog.Warn("Evidence envelope has a timestamp but no signature")
+		return
+	}
+	token, err := base64.StdEncoding.DecodeString(envelope.Metadata.TimestampToken)
+	if err != nil {
+		clientLog.Warn("Failed to decode the evidence timestamp token:", err.Error())
+		return
+	}
+	signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+	if err != nil {
+		clientLog.Warn("Failed to decode the evidence signature:", err.Error())
+		return
+	}
+	timestampTime, err := tsa.VerifyToken(token, signature)
+	if err != nil {
+		clientLog.Warn("Failed to verify the evidence timestamp:", err.Error())
+		return
+	}
+	result.VerificationResult.TimestampVerificationStatus = model.Success
+	result.VerificationResult.TimestampTime = timestampTime.UTC().Format(time.RFC3339)
+}
+
 func (v *evidenceVerifier) getLocalVerifiers() ([]dsse.Verifier, error) {
 	if v.localKeys != nil {
 		return v.localKeys, nil
@@ -3,6 +3,11 @@ package verify
 import (
 	"bytes"
 	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -339,6 +344,42 @@ func TestVerifyEnvelop_MismatchedKeyIDs(t *testing.T) {
 	mockVerifier.AssertExpectations(t)
 }
 
+// Test verifyEnvelope reports a signing scheme mismatch between the envelope and the key
+func TestVerifyEnvelop_SchemeMismatch(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ed25519Public, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	// An ECDSA P-256 signature.
+	envelope := createMockEnvelope()
+	envelope.Signatures[0].Sig = "MEQCICnduG51TlGtJPP7ziZaVcTRV97TkLrKQRUFl+s4IaQfAiBKVltC5NzHTRC2iqwg7KTaiC693CJjiIOkJMFOgq4jfQ=="
+
+	tests := []struct {
+		name           string
+		publicKeys     []crypto.PublicKey
+		expectedReason string
+	}{
+		{name: "Different key type", publicKeys: []crypto.PublicKey{ed25519Public}, expectedReason: "envelope signed with ecdsa-p256 but provided key is ed25519"},
+		{name: "Matching key type", publicKeys: []crypto.PublicKey{ed25519Public, &ecdsaKey.PublicKey}},
+		{name: "Unknown key type", publicKeys: []crypto.PublicKey{nil}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var verifiers []dsse.Verifier
+			for _, publicKey := range tt.publicKeys {
+				mockVerifier := &MockDSSEVerifier{KeyIDValue: "test-key-id", PublicKey: publicKey}
+				mockVerifier.On("Verify", mock.Anything, mock.Anything).Return(errors.New("verification failed"))
+				verifiers = append(verifiers, mockVerifier)
+			}
+			result := &model.EvidenceVerification{}
+
+			assert.False(t, verifyEnvelope(verifiers, &envelope, result))
+			assert.Equal(t, model.VerificationStatus(model.Failed), result.VerificationResult.SignaturesVerificationStatus)
+			assert.Equal(t, tt.expectedReason, result.VerificationResult.FailureReason)
+		})
+	}
+}
+
 // Test Verify with successful verification using local keys
 func TestVerifier_Verify_Success(t *testing.T) {
 	// Create mock DSSE verifier that will succeed
@@ -524,3 +565,58 @@ func TestVerifier_Verify_UseArtifactoryKeysFalse_NoArtifactoryClientCalls(t *tes
 	// Verify that the DSSE verifier was called
 	mockDSSEVerifier.AssertExpectations(t)
 }
+
+func TestVerifyTimestamp(t *testing.T) {
+	signedEnvelope := func(metadata *dsse.Metadata) *dsse.Envelope {
+		return &dsse.Envelope{
+			Signatures: []dsse.Signature{{KeyId: "key", Sig: "c2lnbmF0dXJl"}},
+			Metadata:   metadata,
+		}
+	}
+
+	t.Run("No timestamp", func(t *testing.T) {
+		result := &model.EvidenceVerification{}
+		verifyTimestamp(signedEnvelope(nil), result)
+		assert.Empty(t, result.VerificationResult.TimestampVerificationStatus)
+	})
+
+	t.Run("Invalid timestamp token", func(t *testing.T) {
+		result := &model.EvidenceVerification{}
+		verifyTimestamp(signedEnvelope(&dsse.Metadata{TimestampToken: "bm90LWEtdG9rZW4="}), result)
+		assert.Equal(t, model.VerificationStatus(model.Failed), result.VerificationResult.TimestampVerificationStatus)
+		assert.Empty(t, result.VerificationResult.TimestampTime)
+	})
+
+	t.Run("Undecodable timestamp token", func(t *testing.T) {
+		result := &model.EvidenceVerification{}
+		verifyTimestamp(signedEnvelope(&dsse.Metadata{TimestampToken: "%%%"}), result)
+		assert.Equal(t, model.VerificationStatus(model.Failed), result.VerificationResult.TimestampVerificationStatus)
+	})
+}
+
+func TestGetStatementAnnotations(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  string
+		expected map[string]string
+	}{
+		{
+			name:     "With annotations",
+			payload:  base64.StdEncoding.EncodeToString([]byte(`{"predicateType":"https://slsa.dev/provenance/v1","annotations":{"ci.build.id":"1234","git.commit":"3f9a2c1"}}`)),
+			expected: map[string]string{"ci.build.id": "1234", "git.commit": "3f9a2c1"},
+		},
+		{
+			name:    "Without annotations",
+			payload: base64.StdEncoding.EncodeToString([]byte(`{"predicateType":"https://slsa.dev/provenance/v1"}`)),
+		},
+		{
+			name:    "Invalid payload",
+			payload: "not base64!",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getStatementAnnotations(&dsse.Envelope{Payload: tt.payload}))
+		})
+	}
+}
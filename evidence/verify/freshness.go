@@ -0,0 +1,73 @@
+package verify
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientLog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const day = 24 * time.Hour
+
+// ParseMaxAge parses the maximum age of evidence, either in days, e.g. '30d', or as a Go duration, e.g. '12h'.
+func ParseMaxAge(maxAge string) (time.Duration, error) {
+	var duration time.Duration
+	if days, ok := strings.CutSuffix(maxAge, "d"); ok {
+		count, err := strconv.Atoi(days)
+		if err == nil {
+			duration = time.Duration(count) * day
+		}
+	} else {
+		duration, _ = time.ParseDuration(maxAge)
+	}
+	if duration <= 0 {
+		return 0, errorutils.CheckErrorf("invalid max age '%s': expected a positive number of days, e.g. '30d', or a duration, e.g. '12h'", maxAge)
+	}
+	return duration, nil
+}
+
+// checkEvidenceFreshness fails if the latest evidence of the predicate type was created more than maxAge before now.
+// The evidence found for the subject by the verification is reused, as it includes the creation time of each evidence.
+func checkEvidenceFreshness(edges []model.SearchEvidenceEdge, predicateType string, maxAge time.Duration, now time.Time) error {
+	var latest time.Time
+	found := false
+	for _, edge := range edges {
+		if edge.Node.PredicateType != predicateType {
+			continue
+		}
+		found = true
+		createdAt, err := time.Parse(time.RFC3339, edge.Node.CreatedAt)
+		if err != nil {
+			clientLog.Debug("Ignoring evidence with an unparsable creation time:", edge.Node.CreatedAt)
+			continue
+		}
+		if createdAt.After(latest) {
+			latest = createdAt
+		}
+	}
+	if !found {
+		return errorutils.CheckErrorf("no evidence with the predicate type '%s' was found for the subject", predicateType)
+	}
+	if latest.IsZero() {
+		return errorutils.CheckErrorf("the creation time of the evidence with the predicate type '%s' is unknown", predicateType)
+	}
+	age := now.Sub(latest)
+	if age > maxAge {
+		return errorutils.CheckErrorf("the latest evidence with the predicate type '%s' was created at %s, %s ago, which is older than the maximum age of %s",
+			predicateType, latest.Format(time.RFC3339), formatAge(age), formatAge(maxAge))
+	}
+	clientLog.Info(fmt.Sprintf("The latest evidence with the predicate type '%s' was created %s ago, within the maximum age of %s", predicateType, formatAge(age), formatAge(maxAge)))
+	return nil
+}
+
+// formatAge formats an age in days and hours, or in hours and minutes when shorter than a day.
+func formatAge(age time.Duration) string {
+	if age >= day {
+		return fmt.Sprintf("%dd%dh", age/day, age%day/time.Hour)
+	}
+	return fmt.Sprintf("%dh%dm", age/time.Hour, age%time.Hour/time.Minute)
+}
@@ -0,0 +1,71 @@
+package verify
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMaxAge(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxAge   string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{name: "Days", maxAge: "30d", expected: 30 * day},
+		{name: "Duration", maxAge: "12h", expected: 12 * time.Hour},
+		{name: "Zero days", maxAge: "0d", wantErr: true},
+		{name: "Negative duration", maxAge: "-1h", wantErr: true},
+		{name: "Invalid days", maxAge: "xd", wantErr: true},
+		{name: "Invalid", maxAge: "thirty", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ParseMaxAge(tt.maxAge)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestCheckEvidenceFreshness(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	edges := []model.SearchEvidenceEdge{
+		{Node: model.EvidenceMetadata{PredicateType: "https://cyclonedx.org/bom", CreatedAt: "2024-01-01T12:00:00Z"}},
+		{Node: model.EvidenceMetadata{PredicateType: "https://cyclonedx.org/bom", CreatedAt: "2024-02-20T12:00:00Z"}},
+		{Node: model.EvidenceMetadata{PredicateType: "https://slsa.dev/provenance/v1", CreatedAt: "2024-02-29T12:00:00Z"}},
+		{Node: model.EvidenceMetadata{PredicateType: "https://in-toto.io/attestation/vulns", CreatedAt: "now"}},
+	}
+
+	t.Run("Latest evidence within max age", func(t *testing.T) {
+		assert.NoError(t, checkEvidenceFreshness(edges, "https://cyclonedx.org/bom", 30*day, now))
+	})
+
+	t.Run("Latest evidence older than max age", func(t *testing.T) {
+		err := checkEvidenceFreshness(edges, "https://cyclonedx.org/bom", 7*day, now)
+		assert.ErrorContains(t, err, "10d0h ago")
+		assert.ErrorContains(t, err, "maximum age of 7d0h")
+	})
+
+	t.Run("No evidence of predicate type", func(t *testing.T) {
+		err := checkEvidenceFreshness(edges, "https://spdx.dev/Document", 30*day, now)
+		assert.ErrorContains(t, err, "no evidence with the predicate type")
+	})
+
+	t.Run("Unknown creation time", func(t *testing.T) {
+		err := checkEvidenceFreshness(edges, "https://in-toto.io/attestation/vulns", 30*day, now)
+		assert.ErrorContains(t, err, "creation time")
+	})
+}
+
+func TestFormatAge(t *testing.T) {
+	assert.Equal(t, "2d3h", formatAge(2*day+3*time.Hour+10*time.Minute))
+	assert.Equal(t, "5h30m", formatAge(5*time.Hour+30*time.Minute))
+}
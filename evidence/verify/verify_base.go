@@ -3,11 +3,14 @@ package verify
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/gookit/color"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -23,13 +26,18 @@ const searchEvidenceQueryWithoutPublicKey = `{"query":"{ evidence { searchEviden
 
 // verifyEvidenceBase provides shared logic for evidence verification commands.
 type verifyEvidenceBase struct {
-	serverDetails      *config.ServerDetails
+	serverDetails *config.ServerDetails
+	// The settings of the clients the requests of the command are sent with.
+	clientOptions      utils.ClientOptions
 	format             string
 	keys               []string
 	useArtifactoryKeys bool
-	artifactoryClient  *artifactory.ArtifactoryServicesManager
-	oneModelClient     onemodel.Manager
-	verifier           EvidenceVerifierInterface
+	// When maxAge is set, the verification fails if the latest evidence of predicateType is older.
+	predicateType     string
+	maxAge            time.Duration
+	artifactoryClient *artifactory.ArtifactoryServicesManager
+	oneModelClient    onemodel.Manager
+	verifier          EvidenceVerifierInterface
 }
 
 // printVerifyResult prints the verification result in the requested format.
@@ -49,7 +57,10 @@ func (v *verifyEvidenceBase) verifyEvidence(client *artifactory.ArtifactoryServi
 	if err != nil {
 		return err
 	}
-	return v.printVerifyResult(verify)
+	if err = v.printVerifyResult(verify); err != nil || v.maxAge == 0 {
+		return err
+	}
+	return checkEvidenceFreshness(*evidenceMetadata, v.predicateType, v.maxAge, time.Now())
 }
 
 // createArtifactoryClient creates an Artifactory client for evidence operations.
@@ -57,7 +68,7 @@ func (v *verifyEvidenceBase) createArtifactoryClient() (*artifactory.Artifactory
 	if v.artifactoryClient != nil {
 		return v.artifactoryClient, nil
 	}
-	artifactoryClient, err := utils.CreateUploadServiceManager(v.serverDetails, 1, 0, 0, false, nil)
+	artifactoryClient, err := utils.CreateArtifactoryServiceManager(v.serverDetails, 0, v.clientOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +114,7 @@ func createOneModelService(v *verifyEvidenceBase) error {
 	if v.oneModelClient != nil {
 		return nil
 	}
-	manager, err := utils.CreateOnemodelServiceManager(v.serverDetails, false)
+	manager, err := utils.CreateOnemodelServiceManager(v.serverDetails, v.clientOptions)
 	if err != nil {
 		return err
 	}
@@ -123,7 +134,8 @@ func printText(result *model.VerificationResponse) error {
 	fmt.Printf("Loaded %d evidence\n", evidenceNumber)
 	successfulVerifications := 0
 	for _, v := range *result.EvidenceVerifications {
-		if v.VerificationResult.Sha256VerificationStatus == model.Success && v.VerificationResult.SignaturesVerificationStatus == model.Success {
+		if v.VerificationResult.Sha256VerificationStatus == model.Success && v.VerificationResult.SignaturesVerificationStatus == model.Success &&
+			v.VerificationResult.TimestampVerificationStatus != model.Failed {
 			successfulVerifications++
 		}
 	}
@@ -157,6 +169,21 @@ func printVerificationResult(verification *model.EvidenceVerification, index int
 	}
 	fmt.Printf("    - Sha256 verification status:     %s\n", getColoredStatus(verification.VerificationResult.Sha256VerificationStatus))
 	fmt.Printf("    - Signatures verification status: %s\n", getColoredStatus(verification.VerificationResult.SignaturesVerificationStatus))
+	if verification.VerificationResult.FailureReason != "" {
+		fmt.Printf("    - Failure reason:                 %s\n", verification.VerificationResult.FailureReason)
+	}
+	if verification.VerificationResult.TimestampVerificationStatus != "" {
+		if verification.VerificationResult.TimestampTime != "" {
+			fmt.Printf("    - Timestamp:                      %s\n", verification.VerificationResult.TimestampTime)
+		}
+		fmt.Printf("    - Timestamp verification status:  %s\n", getColoredStatus(verification.VerificationResult.TimestampVerificationStatus))
+	}
+	if len(verification.Annotations) > 0 {
+		fmt.Println("    - Annotations:")
+		for _, key := range slices.Sorted(maps.Keys(verification.Annotations)) {
+			fmt.Printf("        - %s: %s\n", key, verification.Annotations[key])
+		}
+	}
 }
 
 func validateResponse(result *model.VerificationResponse) error {
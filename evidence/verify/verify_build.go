@@ -2,6 +2,7 @@ package verify
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
@@ -20,13 +21,16 @@ type verifyEvidenceBuild struct {
 }
 
 // NewVerifyEvidenceBuild creates a new command for verifying evidence for a build.
-func NewVerifyEvidenceBuild(serverDetails *config.ServerDetails, project, buildName, buildNumber, format string, keys []string, useArtifactoryKeys bool) evidence.Command {
+func NewVerifyEvidenceBuild(serverDetails *config.ServerDetails, clientOptions utils.ClientOptions, project, buildName, buildNumber, format string, keys []string, useArtifactoryKeys bool, predicateType string, maxAge time.Duration) evidence.Command {
 	return &verifyEvidenceBuild{
 		verifyEvidenceBase: verifyEvidenceBase{
 			serverDetails:      serverDetails,
+			clientOptions:      clientOptions,
 			format:             format,
 			keys:               keys,
 			useArtifactoryKeys: useArtifactoryKeys,
+			predicateType:      predicateType,
+			maxAge:             maxAge,
 		},
 		project:     project,
 		buildName:   buildName,
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -92,7 +93,7 @@ func TestNewVerifyEvidenceBuild(t *testing.T) {
 	format := "json"
 	keys := []string{"key1", "key2"}
 
-	cmd := NewVerifyEvidenceBuild(serverDetails, project, buildName, buildNumber, format, keys, true)
+	cmd := NewVerifyEvidenceBuild(serverDetails, utils.ClientOptions{}, project, buildName, buildNumber, format, keys, true, "", 0)
 	verifyCmd, ok := cmd.(*verifyEvidenceBuild)
 	assert.True(t, ok)
 
@@ -5,6 +5,7 @@ import (
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
@@ -19,13 +20,16 @@ type verifyEvidenceCustom struct {
 }
 
 // NewVerifyEvidenceCustom creates a new command for verifying evidence for a custom subject path.
-func NewVerifyEvidenceCustom(serverDetails *config.ServerDetails, subjectRepoPath, format string, keys []string, useArtifactoryKeys bool) evidence.Command {
+func NewVerifyEvidenceCustom(serverDetails *config.ServerDetails, clientOptions utils.ClientOptions, subjectRepoPath, format string, keys []string, useArtifactoryKeys bool, predicateType string, maxAge time.Duration) evidence.Command {
 	return &verifyEvidenceCustom{
 		verifyEvidenceBase: verifyEvidenceBase{
 			serverDetails:      serverDetails,
+			clientOptions:      clientOptions,
 			format:             format,
 			keys:               keys,
 			useArtifactoryKeys: useArtifactoryKeys,
+			predicateType:      predicateType,
+			maxAge:             maxAge,
 		},
 		subjectRepoPath: subjectRepoPath,
 	}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/stretchr/testify/assert"
@@ -74,7 +75,7 @@ func TestNewVerifyEvidenceCustom(t *testing.T) {
 	format := "json"
 	keys := []string{"key1", "key2"}
 
-	cmd := NewVerifyEvidenceCustom(serverDetails, subjectRepoPath, format, keys, true)
+	cmd := NewVerifyEvidenceCustom(serverDetails, utils.ClientOptions{}, subjectRepoPath, format, keys, true, "", 0)
 	verifyCmd, ok := cmd.(*verifyEvidenceCustom)
 	assert.True(t, ok)
 
@@ -0,0 +1,176 @@
+package verify
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/gookit/color"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/dsse"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	clientLog "github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// EnvelopeVerificationResponse is the result of verifying a local DSSE envelope file.
+type EnvelopeVerificationResponse struct {
+	EnvelopePath string `json:"envelopePath"`
+	PayloadType  string `json:"payloadType"`
+	// The statement fields are set only for in-toto statement payloads.
+	PredicateType                string                   `json:"predicateType,omitempty"`
+	SubjectSha256                []string                 `json:"subjectSha256,omitempty"`
+	CreatedBy                    string                   `json:"createdBy,omitempty"`
+	CreatedAt                    string                   `json:"createdAt,omitempty"`
+	SignaturesVerificationStatus model.VerificationStatus `json:"signaturesVerificationStatus"`
+	KeyFingerprint               string                   `json:"keyFingerprint,omitempty"`
+	TimestampVerificationStatus  model.VerificationStatus `json:"timestampVerificationStatus,omitempty"`
+	TimestampTime                string                   `json:"timestampTime,omitempty"`
+	FailureReason                string                   `json:"failureReason,omitempty"`
+	OverallVerificationStatus    model.VerificationStatus `json:"overallVerificationStatus"`
+}
+
+// verifyEnvelopeFile verifies the signature of a local DSSE envelope file against local public keys, without any
+// network access.
+type verifyEnvelopeFile struct {
+	envelopePath string
+	format       string
+	keys         []string
+}
+
+// NewVerifyEnvelope creates a new command for verifying a local DSSE envelope file.
+func NewVerifyEnvelope(envelopePath, format string, keys []string) evidence.Command {
+	return &verifyEnvelopeFile{
+		envelopePath: envelopePath,
+		format:       format,
+		keys:         keys,
+	}
+}
+
+func (v *verifyEnvelopeFile) CommandName() string {
+	return "verify-evidence-envelope"
+}
+
+// ServerDetails returns no server, as the verification is done offline.
+func (v *verifyEnvelopeFile) ServerDetails() (*config.ServerDetails, error) {
+	return nil, nil
+}
+
+func (v *verifyEnvelopeFile) Run() error {
+	result, err := v.verify()
+	if err != nil {
+		return err
+	}
+	if v.format == "json" {
+		resultJson, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(resultJson))
+	} else {
+		printEnvelopeVerificationText(result)
+	}
+	if result.OverallVerificationStatus == model.Failed {
+		return coreutils.CliError{ExitCode: coreutils.ExitCodeError}
+	}
+	return nil
+}
+
+func (v *verifyEnvelopeFile) verify() (*EnvelopeVerificationResponse, error) {
+	content, err := os.ReadFile(v.envelopePath)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to read the envelope file %s: %s", v.envelopePath, err.Error())
+	}
+	envelope := dsse.Envelope{}
+	if err = json.Unmarshal(content, &envelope); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the envelope file %s: %s", v.envelopePath, err.Error())
+	}
+	if len(envelope.Signatures) == 0 {
+		return nil, errorutils.CheckErrorf("the envelope file %s has no signatures", v.envelopePath)
+	}
+	verifiers, err := (&evidenceVerifier{keys: v.keys}).getLocalVerifiers()
+	if err != nil {
+		return nil, err
+	}
+	if len(verifiers) == 0 {
+		return nil, errorutils.CheckErrorf("at least one public key must be provided to verify the envelope")
+	}
+
+	result := &EnvelopeVerificationResponse{
+		EnvelopePath:              v.envelopePath,
+		PayloadType:               envelope.PayloadType,
+		OverallVerificationStatus: model.Success,
+	}
+	if envelope.PayloadType == intoto.PayloadType {
+		setStatementFields(&envelope, result)
+	}
+	// The verification of the signatures and the timestamp is shared with the evidence verification.
+	verification := &model.EvidenceVerification{}
+	if !verifyEnvelope(verifiers, &envelope, verification) {
+		result.OverallVerificationStatus = model.Failed
+	}
+	verifyTimestamp(&envelope, verification)
+	if verification.VerificationResult.TimestampVerificationStatus == model.Failed {
+		result.OverallVerificationStatus = model.Failed
+	}
+	result.SignaturesVerificationStatus = verification.VerificationResult.SignaturesVerificationStatus
+	result.KeyFingerprint = verification.VerificationResult.KeyFingerprint
+	result.TimestampVerificationStatus = verification.VerificationResult.TimestampVerificationStatus
+	result.TimestampTime = verification.VerificationResult.TimestampTime
+	result.FailureReason = verification.VerificationResult.FailureReason
+	return result, nil
+}
+
+// setStatementFields sets the result fields taken from the in-toto statement carried by the envelope.
+func setStatementFields(envelope *dsse.Envelope, result *EnvelopeVerificationResponse) {
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		clientLog.Debug("Failed to decode the envelope payload:", err.Error())
+		return
+	}
+	statement := intoto.Statement{}
+	if err = json.Unmarshal(payload, &statement); err != nil {
+		clientLog.Debug("Failed to parse the envelope statement:", err.Error())
+		return
+	}
+	result.PredicateType = statement.PredicateType
+	result.CreatedBy = statement.CreatedBy
+	result.CreatedAt = statement.CreatedAt
+	for _, subject := range statement.Subject {
+		result.SubjectSha256 = append(result.SubjectSha256, subject.Digest.Sha256)
+	}
+}
+
+func printEnvelopeVerificationText(result *EnvelopeVerificationResponse) {
+	fmt.Printf("Envelope:                         %s\n", result.EnvelopePath)
+	fmt.Printf("Payload type:                     %s\n", result.PayloadType)
+	if result.PredicateType != "" {
+		fmt.Printf("Predicate type:                   %s\n", result.PredicateType)
+	}
+	for _, subjectSha256 := range result.SubjectSha256 {
+		fmt.Printf("Subject sha256:                   %s\n", subjectSha256)
+	}
+	if result.KeyFingerprint != "" {
+		fmt.Printf("Key fingerprint:                  %s\n", result.KeyFingerprint)
+	}
+	fmt.Printf("Signatures verification status:   %s\n", getColoredStatus(result.SignaturesVerificationStatus))
+	if result.FailureReason != "" {
+		fmt.Printf("Failure reason:                   %s\n", result.FailureReason)
+	}
+	if result.TimestampVerificationStatus != "" {
+		if result.TimestampTime != "" {
+			fmt.Printf("Timestamp:                        %s\n", result.TimestampTime)
+		}
+		fmt.Printf("Timestamp verification status:    %s\n", getColoredStatus(result.TimestampVerificationStatus))
+	}
+	fmt.Println()
+	if result.OverallVerificationStatus == model.Success {
+		fmt.Println(color.Green.Render("Envelope verification passed"))
+	} else {
+		fmt.Println(color.Red.Render("Envelope verification failed"))
+	}
+}
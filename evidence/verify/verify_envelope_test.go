@@ -0,0 +1,91 @@
+package verify
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/cryptox"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/intoto"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/sign"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSignedEnvelope signs a statement with the ed25519 test key, and writes the envelope to a temporary file.
+func writeSignedEnvelope(t *testing.T) string {
+	privateKeyContent, err := os.ReadFile(filepath.Join("..", "cryptox", "testdata", "ed25519-test-key-pem"))
+	require.NoError(t, err)
+	privateKey, err := cryptox.ReadKey(privateKeyContent)
+	require.NoError(t, err)
+	signer, err := cryptox.NewED25519SignerVerifierFromSSLibKey(privateKey)
+	require.NoError(t, err)
+	envelopeSigner, err := sign.NewEnvelopeSigner(signer)
+	require.NoError(t, err)
+
+	statement := intoto.Statement{
+		Type:          intoto.StatementType,
+		Subject:       []intoto.ResourceDescriptor{{Digest: intoto.Digest{Sha256: "e06f59f5a976c7f4a5406907790bb8cad6148406282f07cd143fd1de64ca169d"}}},
+		PredicateType: "https://slsa.dev/provenance/v1",
+		Predicate:     json.RawMessage(`{"builder":"ci"}`),
+		CreatedAt:     "2025-01-31T00:00:00.000Z",
+		CreatedBy:     "ci-user",
+	}
+	payload, err := json.Marshal(statement)
+	require.NoError(t, err)
+	envelope, err := envelopeSigner.SignPayload(intoto.PayloadType, payload)
+	require.NoError(t, err)
+	content, err := json.Marshal(envelope)
+	require.NoError(t, err)
+	envelopePath := filepath.Join(t.TempDir(), "evidence.json")
+	require.NoError(t, os.WriteFile(envelopePath, content, 0600))
+	return envelopePath
+}
+
+func TestVerifyEnvelope(t *testing.T) {
+	envelopePath := writeSignedEnvelope(t)
+
+	cmd := &verifyEnvelopeFile{envelopePath: envelopePath, keys: []string{filepath.Join("..", "cryptox", "testdata", "ed25519-test-key-pem.pub")}}
+	result, err := cmd.verify()
+
+	require.NoError(t, err)
+	assert.Equal(t, model.VerificationStatus(model.Success), result.OverallVerificationStatus)
+	assert.Equal(t, model.VerificationStatus(model.Success), result.SignaturesVerificationStatus)
+	assert.NotEmpty(t, result.KeyFingerprint)
+	assert.Equal(t, intoto.PayloadType, result.PayloadType)
+	assert.Equal(t, "https://slsa.dev/provenance/v1", result.PredicateType)
+	assert.Equal(t, []string{"e06f59f5a976c7f4a5406907790bb8cad6148406282f07cd143fd1de64ca169d"}, result.SubjectSha256)
+	assert.Equal(t, "ci-user", result.CreatedBy)
+	assert.Empty(t, result.TimestampVerificationStatus)
+	assert.NoError(t, cmd.Run())
+}
+
+func TestVerifyEnvelope_WrongKey(t *testing.T) {
+	envelopePath := writeSignedEnvelope(t)
+
+	cmd := &verifyEnvelopeFile{envelopePath: envelopePath, format: "json", keys: []string{filepath.Join("..", "cryptox", "testdata", "ecdsa-test-key-pem.pub")}}
+	result, err := cmd.verify()
+
+	require.NoError(t, err)
+	assert.Equal(t, model.VerificationStatus(model.Failed), result.OverallVerificationStatus)
+	assert.Equal(t, model.VerificationStatus(model.Failed), result.SignaturesVerificationStatus)
+	assert.Equal(t, coreutils.CliError{ExitCode: coreutils.ExitCodeError}, cmd.Run())
+}
+
+func TestVerifyEnvelope_InvalidInput(t *testing.T) {
+	envelopePath := writeSignedEnvelope(t)
+	unsignedEnvelopePath := filepath.Join(t.TempDir(), "unsigned.json")
+	require.NoError(t, os.WriteFile(unsignedEnvelopePath, []byte(`{"payload":"e30=","payloadType":"application/vnd.in-toto+json","signatures":[]}`), 0600))
+
+	_, err := (&verifyEnvelopeFile{envelopePath: filepath.Join(t.TempDir(), "missing.json")}).verify()
+	assert.ErrorContains(t, err, "failed to read the envelope file")
+
+	_, err = (&verifyEnvelopeFile{envelopePath: unsignedEnvelopePath}).verify()
+	assert.ErrorContains(t, err, "has no signatures")
+
+	_, err = (&verifyEnvelopeFile{envelopePath: envelopePath}).verify()
+	assert.ErrorContains(t, err, "at least one public key must be provided")
+}
@@ -4,11 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 
-	cliUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
 
@@ -21,13 +21,16 @@ type verifyEvidencePackage struct {
 }
 
 // NewVerifyEvidencePackage creates a new command for verifying evidence for a package.
-func NewVerifyEvidencePackage(serverDetails *config.ServerDetails, format, packageName, packageVersion, packageRepoName string, keys []string, useArtifactoryKeys bool) evidence.Command {
+func NewVerifyEvidencePackage(serverDetails *config.ServerDetails, clientOptions utils.ClientOptions, format, packageName, packageVersion, packageRepoName string, keys []string, useArtifactoryKeys bool, predicateType string, maxAge time.Duration) evidence.Command {
 	return &verifyEvidencePackage{
 		verifyEvidenceBase: verifyEvidenceBase{
 			serverDetails:      serverDetails,
+			clientOptions:      clientOptions,
 			format:             format,
 			keys:               keys,
 			useArtifactoryKeys: useArtifactoryKeys,
+			predicateType:      predicateType,
+			maxAge:             maxAge,
 		},
 		packageService: evidence.NewPackageService(packageName, packageVersion, packageRepoName),
 	}
@@ -55,7 +58,7 @@ func (c *verifyEvidencePackage) Run() error {
 		return fmt.Errorf("failed to get package type: %w", err)
 	}
 
-	metadataClient, err := cliUtils.CreateMetadataServiceManager(c.serverDetails, false)
+	metadataClient, err := utils.CreateMetadataServiceManager(c.serverDetails, c.clientOptions)
 	if err != nil {
 		return fmt.Errorf("failed to create metadata service manager: %w", err)
 	}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -101,7 +102,7 @@ func TestNewVerifyEvidencePackage(t *testing.T) {
 	packageRepoName := "test-repo"
 	keys := []string{"key1", "key2"}
 
-	cmd := NewVerifyEvidencePackage(serverDetails, format, packageName, packageVersion, packageRepoName, keys, true)
+	cmd := NewVerifyEvidencePackage(serverDetails, utils.ClientOptions{}, format, packageName, packageVersion, packageRepoName, keys, true, "", 0)
 	verifyCmd, ok := cmd.(*verifyEvidencePackage)
 	assert.True(t, ok)
 	assert.Equal(t, serverDetails, verifyCmd.serverDetails)
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence"
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 )
 
-const aqlReleaseBundleQueryTemplate = "items.find({\"repo\": \"%s\",\"path\": \"%s\",\"name\": \"%s\"}).include(\"sha256\")"
+const (
+	aqlReleaseBundleQueryTemplate = "items.find({\"repo\": \"%s\",\"path\": \"%s\",\"name\": \"%s\"}).include(\"sha256\")"
+	releaseBundleManifestName     = "release-bundle.json.evd"
+)
 
 // verifyEvidenceReleaseBundle verifies evidence for a release bundle.
 type verifyEvidenceReleaseBundle struct {
@@ -19,13 +24,16 @@ type verifyEvidenceReleaseBundle struct {
 }
 
 // NewVerifyEvidenceReleaseBundle creates a new command for verifying evidence for a release bundle.
-func NewVerifyEvidenceReleaseBundle(serverDetails *config.ServerDetails, format, project, releaseBundle, releaseBundleVersion string, keys []string, useArtifactoryKeys bool) evidence.Command {
+func NewVerifyEvidenceReleaseBundle(serverDetails *config.ServerDetails, clientOptions utils.ClientOptions, format, project, releaseBundle, releaseBundleVersion string, keys []string, useArtifactoryKeys bool, predicateType string, maxAge time.Duration) evidence.Command {
 	return &verifyEvidenceReleaseBundle{
 		verifyEvidenceBase: verifyEvidenceBase{
 			serverDetails:      serverDetails,
+			clientOptions:      clientOptions,
 			format:             format,
 			keys:               keys,
 			useArtifactoryKeys: useArtifactoryKeys,
+			predicateType:      predicateType,
+			maxAge:             maxAge,
 		},
 		project:              project,
 		releaseBundle:        releaseBundle,
@@ -51,21 +59,28 @@ func (c *verifyEvidenceReleaseBundle) Run() error {
 	}
 
 	repoKey := utils.BuildReleaseBundleRepoKey(c.project)
-
 	path := fmt.Sprintf("%s/%s", c.releaseBundle, c.releaseBundleVersion)
-	result, err := utils.ExecuteAqlQuery(fmt.Sprintf(aqlReleaseBundleQueryTemplate, repoKey, path, "release-bundle.json.evd"), artifactoryClient)
+	releaseBundleSha256, err := getReleaseBundleManifestSha256(artifactoryClient, repoKey, path)
 	if err != nil {
-		return fmt.Errorf("failed to execute AQL query: %w", err)
-	}
-	if len(result.Results) == 0 {
-		return fmt.Errorf("no release bundle manifest found for the given release bundle and version")
+		return err
 	}
 
-	metadata, err := c.queryEvidenceMetadata(repoKey, path, "release-bundle.json.evd")
+	metadata, err := c.queryEvidenceMetadata(repoKey, path, releaseBundleManifestName)
 	if err != nil {
 		return err
 	}
-	subjectPath := fmt.Sprintf("%s/%s/%s", repoKey, path, "release-bundle.json.evd")
-	releaseBundleSha256 := result.Results[0].Sha256
+	subjectPath := fmt.Sprintf("%s/%s/%s", repoKey, path, releaseBundleManifestName)
 	return c.verifyEvidence(artifactoryClient, metadata, releaseBundleSha256, subjectPath)
 }
+
+// getReleaseBundleManifestSha256 returns the checksum of the manifest of a release bundle version, which is the subject of its evidence.
+func getReleaseBundleManifestSha256(artifactoryClient *artifactory.ArtifactoryServicesManager, repoKey, path string) (string, error) {
+	result, err := utils.ExecuteAqlQuery(fmt.Sprintf(aqlReleaseBundleQueryTemplate, repoKey, path, releaseBundleManifestName), artifactoryClient)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute AQL query: %w", err)
+	}
+	if len(result.Results) == 0 {
+		return "", fmt.Errorf("no release bundle manifest found for the given release bundle and version")
+	}
+	return result.Results[0].Sha256, nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/jfrog/jfrog-cli-artifactory/evidence/model"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -77,7 +78,7 @@ func TestNewVerifyEvidenceReleaseBundle(t *testing.T) {
 	releaseBundleVersion := "1.0.0"
 	keys := []string{"key1", "key2"}
 
-	cmd := NewVerifyEvidenceReleaseBundle(serverDetails, format, project, releaseBundle, releaseBundleVersion, keys, true)
+	cmd := NewVerifyEvidenceReleaseBundle(serverDetails, utils.ClientOptions{}, format, project, releaseBundle, releaseBundleVersion, keys, true, "", 0)
 	verifyCmd, ok := cmd.(*verifyEvidenceReleaseBundle)
 	assert.True(t, ok)
 
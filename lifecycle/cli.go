@@ -10,14 +10,17 @@ import (
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/cmddefs"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/distribution"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/flagkit"
+	"github.com/jfrog/jfrog-cli-artifactory/commonutils"
 	lifecycle "github.com/jfrog/jfrog-cli-artifactory/lifecycle/commands"
 	rbAnnotate "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/annotate"
 	rbCreate "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/create"
 	rbDeleteLocal "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/deletelocal"
 	rbDeleteRemote "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/deleteremote"
+	rbDiff "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/diff"
 	rbDistribute "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/distribute"
 	rbExport "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/export"
 	rbImport "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/importbundle"
+	rbPaths "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/paths"
 	rbPromote "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/promote"
 	artifactoryUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
@@ -36,6 +39,8 @@ import (
 const (
 	lcCategory                                 = "Lifecycle"
 	minArtifactoryVersionForMultiSourceSupport = "7.114.0"
+	// Sets whether release bundle operations run synchronously when the --sync flag isn't provided.
+	releaseBundleSyncEnv = "JFROG_CLI_RB_SYNC"
 )
 
 func GetCommands() []components.Command {
@@ -47,7 +52,7 @@ func GetCommands() []components.Command {
 			Description: rbCreate.GetDescription(),
 			Arguments:   rbCreate.GetArguments(),
 			Category:    lcCategory,
-			Action:      create,
+			Action:      commonutils.WithErrorFormat(create, flagkit.Project),
 		},
 		{
 			Name:        "release-bundle-promote",
@@ -56,7 +61,7 @@ func GetCommands() []components.Command {
 			Description: rbPromote.GetDescription(),
 			Arguments:   rbPromote.GetArguments(),
 			Category:    lcCategory,
-			Action:      promote,
+			Action:      commonutils.WithErrorFormat(promote, flagkit.Project),
 		},
 		{
 			Name:        "release-bundle-distribute",
@@ -65,7 +70,7 @@ func GetCommands() []components.Command {
 			Description: rbDistribute.GetDescription(),
 			Arguments:   rbDistribute.GetArguments(),
 			Category:    lcCategory,
-			Action:      distribute,
+			Action:      commonutils.WithErrorFormat(distribute, flagkit.Project),
 		},
 		{
 			Name:        "release-bundle-export",
@@ -74,7 +79,7 @@ func GetCommands() []components.Command {
 			Description: rbExport.GetDescription(),
 			Arguments:   rbExport.GetArguments(),
 			Category:    lcCategory,
-			Action:      export,
+			Action:      commonutils.WithErrorFormat(export, flagkit.Project),
 		},
 		{
 			Name:        "release-bundle-delete-local",
@@ -83,7 +88,7 @@ func GetCommands() []components.Command {
 			Description: rbDeleteLocal.GetDescription(),
 			Arguments:   rbDeleteLocal.GetArguments(),
 			Category:    lcCategory,
-			Action:      deleteLocal,
+			Action:      commonutils.WithErrorFormat(deleteLocal, flagkit.Project),
 		},
 		{
 			Name:        "release-bundle-delete-remote",
@@ -92,7 +97,7 @@ func GetCommands() []components.Command {
 			Description: rbDeleteRemote.GetDescription(),
 			Arguments:   rbDeleteLocal.GetArguments(),
 			Category:    lcCategory,
-			Action:      deleteRemote,
+			Action:      commonutils.WithErrorFormat(deleteRemote, flagkit.Project),
 		},
 		{
 			Name:        "release-bundle-import",
@@ -101,7 +106,7 @@ func GetCommands() []components.Command {
 			Description: rbImport.GetDescription(),
 			Arguments:   rbImport.GetArguments(),
 			Category:    lcCategory,
-			Action:      releaseBundleImport,
+			Action:      commonutils.WithErrorFormat(releaseBundleImport, flagkit.Project),
 		},
 		{
 			Name:        "release-bundle-annotate",
@@ -110,7 +115,25 @@ func GetCommands() []components.Command {
 			Description: rbAnnotate.GetDescription(),
 			Arguments:   rbAnnotate.GetArguments(),
 			Category:    lcCategory,
-			Action:      annotate,
+			Action:      commonutils.WithErrorFormat(annotate, flagkit.Project),
+		},
+		{
+			Name:        cmddefs.ReleaseBundlePaths,
+			Aliases:     []string{"rbpaths"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.ReleaseBundlePaths),
+			Description: rbPaths.GetDescription(),
+			Arguments:   rbPaths.GetArguments(),
+			Category:    lcCategory,
+			Action:      commonutils.WithErrorFormat(paths, flagkit.Project),
+		},
+		{
+			Name:        cmddefs.ReleaseBundleDiff,
+			Aliases:     []string{"rbdiff"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.ReleaseBundleDiff),
+			Description: rbDiff.GetDescription(),
+			Arguments:   rbDiff.GetArguments(),
+			Category:    lcCategory,
+			Action:      commonutils.WithErrorFormat(diff, flagkit.Project),
 		},
 	}
 }
@@ -217,9 +240,13 @@ func create(c *components.Context) (err error) {
 	if err != nil {
 		return
 	}
+	sync, err := getSyncValue(c)
+	if err != nil {
+		return
+	}
 	createCmd := lifecycle.NewReleaseBundleCreateCommand().SetServerDetails(lcDetails).SetReleaseBundleName(c.GetArgumentAt(0)).
 		SetReleaseBundleVersion(c.GetArgumentAt(1)).SetSigningKeyName(c.GetStringFlagValue(flagkit.SigningKey)).
-		SetSync(c.GetBoolFlagValue(flagkit.Sync)).
+		SetSync(sync).
 		SetReleaseBundleProject(pluginsCommon.GetProject(c)).SetSpec(creationSpec).
 		SetBuildsSpecPath(c.GetStringFlagValue(flagkit.Builds)).SetReleaseBundlesSpecPath(c.GetStringFlagValue(flagkit.ReleaseBundles))
 
@@ -271,6 +298,23 @@ func getReleaseBundleCreationSpec(c *components.Context) (*speccore.SpecFiles, e
 		"JFROG_CLI_BUILD_NAME and JFROG_CLI_BUILD_NUMBER) must be set")
 }
 
+// getSyncValue returns whether a release bundle operation runs synchronously. The --sync flag takes precedence over the
+// JFROG_CLI_RB_SYNC environment variable, and operations are synchronous when neither is set.
+func getSyncValue(c *components.Context) (bool, error) {
+	if c.IsFlagSet(flagkit.Sync) {
+		return c.GetBoolFlagValue(flagkit.Sync), nil
+	}
+	envValue := os.Getenv(releaseBundleSyncEnv)
+	if envValue == "" {
+		return true, nil
+	}
+	sync, err := strconv.ParseBool(envValue)
+	if err != nil {
+		return false, errorutils.CheckErrorf("the value of the %s environment variable must be true or false, got '%s'", releaseBundleSyncEnv, envValue)
+	}
+	return sync, nil
+}
+
 func getStringFlagOrEnv(c *components.Context, flag string, envVar string) string {
 	if c.IsFlagSet(flag) {
 		return c.GetStringFlagValue(flag)
@@ -291,11 +335,16 @@ func promote(c *components.Context) error {
 	if err != nil {
 		return err
 	}
+	sync, err := getSyncValue(c)
+	if err != nil {
+		return err
+	}
 
 	promoteCmd := lifecycle.NewReleaseBundlePromoteCommand().SetServerDetails(lcDetails).SetReleaseBundleName(c.GetArgumentAt(0)).
 		SetReleaseBundleVersion(c.GetArgumentAt(1)).SetEnvironment(c.GetArgumentAt(2)).SetSigningKeyName(c.GetStringFlagValue(flagkit.SigningKey)).
-		SetSync(c.GetBoolFlagValue(flagkit.Sync)).SetReleaseBundleProject(pluginsCommon.GetProject(c)).
+		SetSync(sync).SetReleaseBundleProject(pluginsCommon.GetProject(c)).
 		SetIncludeReposPatterns(splitRepos(c, flagkit.IncludeRepos)).SetExcludeReposPatterns(splitRepos(c, flagkit.ExcludeRepos)).
+		SetSpec(c.GetStringFlagValue("spec"), c.GetStringFlagValue("spec-vars")).
 		SetPromotionType(c.GetStringFlagValue(flagkit.PromotionType))
 	return commands.Exec(promoteCmd)
 }
@@ -309,6 +358,10 @@ func distribute(c *components.Context) error {
 	if err != nil {
 		return err
 	}
+	sync, err := getSyncValue(c)
+	if err != nil {
+		return err
+	}
 	distributionRules, maxWaitMinutes, _, err := distribution.InitReleaseBundleDistributeCmd(c)
 	if err != nil {
 		return err
@@ -324,8 +377,9 @@ func distribute(c *components.Context) error {
 		SetAutoCreateRepo(c.GetBoolFlagValue(flagkit.CreateRepo)).
 		SetPathMappingPattern(c.GetStringFlagValue(flagkit.PathMappingPattern)).
 		SetPathMappingTarget(c.GetStringFlagValue(flagkit.PathMappingTarget)).
-		SetSync(c.GetBoolFlagValue(flagkit.Sync)).
-		SetMaxWaitMinutes(maxWaitMinutes)
+		SetSync(sync).
+		SetMaxWaitMinutes(maxWaitMinutes).
+		SetEdgeStatus(c.GetBoolFlagValue(flagkit.EdgeStatus))
 	return commands.Exec(distributeCmd)
 }
 
@@ -342,6 +396,10 @@ func deleteLocal(c *components.Context) error {
 	if err != nil {
 		return err
 	}
+	sync, err := getSyncValue(c)
+	if err != nil {
+		return err
+	}
 
 	environment := ""
 	if len(c.Arguments) == 3 {
@@ -355,7 +413,7 @@ func deleteLocal(c *components.Context) error {
 		SetEnvironment(environment).
 		SetQuiet(pluginsCommon.GetQuietValue(c)).
 		SetReleaseBundleProject(pluginsCommon.GetProject(c)).
-		SetSync(c.GetBoolFlagValue(flagkit.Sync))
+		SetSync(sync)
 	return commands.Exec(deleteCmd)
 }
 
@@ -372,6 +430,10 @@ func deleteRemote(c *components.Context) error {
 	if err != nil {
 		return err
 	}
+	sync, err := getSyncValue(c)
+	if err != nil {
+		return err
+	}
 
 	distributionRules, maxWaitMinutes, _, err := distribution.InitReleaseBundleDistributeCmd(c)
 	if err != nil {
@@ -387,7 +449,7 @@ func deleteRemote(c *components.Context) error {
 		SetMaxWaitMinutes(maxWaitMinutes).
 		SetQuiet(pluginsCommon.GetQuietValue(c)).
 		SetReleaseBundleProject(pluginsCommon.GetProject(c)).
-		SetSync(c.GetBoolFlagValue(flagkit.Sync))
+		SetSync(sync)
 	return commands.Exec(deleteCmd)
 }
 
@@ -481,7 +543,11 @@ func annotate(c *components.Context) error {
 }
 
 func validateDistributeCommand(c *components.Context) error {
-	if err := distribution.ValidateReleaseBundleDistributeCmd(c); err != nil {
+	sync, err := getSyncValue(c)
+	if err != nil {
+		return err
+	}
+	if err = distribution.ValidateReleaseBundleDistributeCmd(c, sync); err != nil {
 		return err
 	}
 
@@ -491,6 +557,10 @@ func validateDistributeCommand(c *components.Context) error {
 		(!mappingPatternProvided && mappingTargetProvided) {
 		return errorutils.CheckErrorf("the options --%s and --%s must be provided together", flagkit.PathMappingPattern, flagkit.PathMappingTarget)
 	}
+	// Reporting the status of the edges waits for the distribution, so it contradicts an asynchronous run
+	if c.GetBoolFlagValue(flagkit.EdgeStatus) && !sync {
+		return errorutils.CheckErrorf("the --%s option can't be used with an asynchronous distribution, set by --%s=false or the %s environment variable", flagkit.EdgeStatus, flagkit.Sync, releaseBundleSyncEnv)
+	}
 	return nil
 }
 
@@ -590,3 +660,44 @@ func PlatformToLifecycleUrls(lcDetails *config.ServerDetails) {
 	lcDetails.LifecycleUrl = utils.AddTrailingSlashIfNeeded(lcDetails.Url) + "lifecycle/"
 	lcDetails.Url = ""
 }
+
+func paths(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	pathsCmd := lifecycle.NewReleaseBundlePathsCommand().
+		SetReleaseBundleName(c.GetArgumentAt(0)).
+		SetReleaseBundleVersion(c.GetArgumentAt(1)).
+		SetReleaseBundleProject(pluginsCommon.GetProject(c)).
+		SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(pathsCmd)
+}
+
+func diff(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 3 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	diffCmd := lifecycle.NewReleaseBundleDiffCommand().
+		SetServerDetails(lcDetails).
+		SetReleaseBundleName(c.GetArgumentAt(0)).
+		SetReleaseBundleVersion(c.GetArgumentAt(1)).
+		SetOtherReleaseBundleVersion(c.GetArgumentAt(2)).
+		SetOtherReleaseBundleName(c.GetStringFlagValue(flagkit.OtherName)).
+		SetReleaseBundleProject(pluginsCommon.GetProject(c)).
+		SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(diffCmd)
+}
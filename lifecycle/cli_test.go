@@ -151,3 +151,95 @@ func CreateContext(t *testing.T, testStringFlags, testArgs []string, testBoolFla
 	}
 	return ctx, &bytes.Buffer{}
 }
+
+func TestGetSyncValue(t *testing.T) {
+	testRuns := []struct {
+		name         string
+		boolFlags    map[string]bool
+		envValue     string
+		expectedSync bool
+		expectError  bool
+	}{
+		{"default", nil, "", true, false},
+		{"env false", nil, "false", false, false},
+		{"env true", nil, "true", true, false},
+		{"flag overrides env", map[string]bool{flagkit.Sync: true}, "false", true, false},
+		{"flag false overrides env", map[string]bool{flagkit.Sync: false}, "true", false, false},
+		{"invalid env", nil, "sometimes", false, true},
+	}
+
+	for _, test := range testRuns {
+		t.Run(test.name, func(t *testing.T) {
+			setEnvCallBack := clientTestUtils.SetEnvWithCallbackAndAssert(t, releaseBundleSyncEnv, test.envValue)
+			defer setEnvCallBack()
+			context, _ := CreateContext(t, []string{}, []string{}, test.boolFlags)
+			sync, err := getSyncValue(context)
+			if test.expectError {
+				assert.ErrorContains(t, err, releaseBundleSyncEnv)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedSync, sync)
+		})
+	}
+}
+
+func TestValidateDistributeCommandEdgeStatus(t *testing.T) {
+	testRuns := []struct {
+		name        string
+		boolFlags   map[string]bool
+		envValue    string
+		expectError bool
+	}{
+		{"edge status", map[string]bool{flagkit.EdgeStatus: true}, "", false},
+		{"edge status with sync", map[string]bool{flagkit.EdgeStatus: true, flagkit.Sync: true}, "", false},
+		{"edge status with async", map[string]bool{flagkit.EdgeStatus: true, flagkit.Sync: false}, "", true},
+		{"async without edge status", map[string]bool{flagkit.Sync: false}, "", false},
+		{"edge status with async env", map[string]bool{flagkit.EdgeStatus: true}, "false", true},
+		{"edge status with sync flag overriding async env", map[string]bool{flagkit.EdgeStatus: true, flagkit.Sync: true}, "false", false},
+	}
+
+	for _, test := range testRuns {
+		t.Run(test.name, func(t *testing.T) {
+			setEnvCallBack := clientTestUtils.SetEnvWithCallbackAndAssert(t, releaseBundleSyncEnv, test.envValue)
+			defer setEnvCallBack()
+			context, buffer := CreateContext(t, []string{}, []string{"name", "version"}, test.boolFlags)
+			err := validateDistributeCommand(context)
+			if test.expectError {
+				assert.ErrorContains(t, err, flagkit.EdgeStatus, buffer)
+			} else {
+				assert.NoError(t, err, buffer)
+			}
+		})
+	}
+}
+
+func TestValidateDistributeCommandMaxWaitMinutes(t *testing.T) {
+	testRuns := []struct {
+		name        string
+		boolFlags   map[string]bool
+		envValue    string
+		expectError bool
+	}{
+		{"sync by default", nil, "", false},
+		{"sync flag", map[string]bool{flagkit.Sync: true}, "", false},
+		{"async flag", map[string]bool{flagkit.Sync: false}, "", true},
+		{"async env", nil, "false", true},
+		{"sync flag overriding async env", map[string]bool{flagkit.Sync: true}, "false", false},
+		{"edge status with sync env", map[string]bool{flagkit.EdgeStatus: true}, "true", false},
+	}
+
+	for _, test := range testRuns {
+		t.Run(test.name, func(t *testing.T) {
+			setEnvCallBack := clientTestUtils.SetEnvWithCallbackAndAssert(t, releaseBundleSyncEnv, test.envValue)
+			defer setEnvCallBack()
+			context, buffer := CreateContext(t, []string{"max-wait-minutes=10"}, []string{"name", "version"}, test.boolFlags)
+			err := validateDistributeCommand(context)
+			if test.expectError {
+				assert.ErrorContains(t, err, "--max-wait-minutes", buffer)
+			} else {
+				assert.NoError(t, err, buffer)
+			}
+		})
+	}
+}
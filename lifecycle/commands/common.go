@@ -33,6 +33,9 @@ func (rbc *releaseBundleCmd) getPrerequisites() (servicesManager *lifecycle.Life
 
 func (rbp *ReleaseBundlePromoteCommand) getPromotionPrerequisites() (servicesManager *lifecycle.LifecycleServicesManager,
 	rbDetails services.ReleaseBundleDetails, queryParams services.CommonOptionalQueryParams, err error) {
+	if err = validatePromotionType(rbp.promotionType); err != nil {
+		return
+	}
 	servicesManager, rbDetails, queryParams, err = rbp.initPrerequisites()
 	queryParams.PromotionType = rbp.promotionType
 	return servicesManager, rbDetails, queryParams, err
@@ -68,6 +68,22 @@ func TestGetPromotionPrerequisites_Success(t *testing.T) {
 	assert.Equal(t, expectedQueryParams, queryParams, "QueryParams do not match expected values")
 }
 
+func TestGetPromotionPrerequisites_InvalidPromotionType(t *testing.T) {
+	rbp := &ReleaseBundlePromoteCommand{
+		promotionType: "mvoe",
+		releaseBundleCmd: releaseBundleCmd{
+			serverDetails:        &config.ServerDetails{},
+			releaseBundleName:    "testRelease",
+			releaseBundleVersion: "1.0.0",
+		},
+	}
+
+	servicesManager, _, _, err := rbp.getPromotionPrerequisites()
+
+	assert.EqualError(t, err, "invalid promotion type 'mvoe'. Allowed values are: copy, move")
+	assert.Nil(t, servicesManager)
+}
+
 func TestBuildRepoKey(t *testing.T) {
 	repoKey := buildRepoKey("example-project")
 	assert.Equal(t, "example-project-release-bundles-v2", repoKey)
@@ -136,6 +136,7 @@ func (rbc *ReleaseBundleCreateCommand) Run() error {
 
 	var isReleaseBundleCreationWithMultiSourcesSupported bool
 	if err = ValidateFeatureSupportedVersion(rbc.serverDetails, minArtifactoryVersionForMultiSourceAndPackagesSupport); err != nil {
+		log.Debug("Release bundle creation from multiple sources and packages is not supported:", err.Error())
 		isReleaseBundleCreationWithMultiSourcesSupported = false
 	} else {
 		isReleaseBundleCreationWithMultiSourcesSupported = true
@@ -532,6 +533,9 @@ func validateFile(file spec.File, packageAndMultiSourceSupported bool) (services
 			"'aql', 'build', 'includeDeps', 'bundle', 'project', 'pattern', 'exclusions', 'props', 'excludeProps' and 'recursive'")
 	}
 	if !packageAndMultiSourceSupported {
+		if isPackage {
+			return "", errorutils.CheckErrorf("creation source 'package' requires Artifactory version %s or above", minArtifactoryVersionForMultiSourceAndPackagesSupport)
+		}
 		if coreutils.SumTrueValues([]bool{isAql, isBuild, isBundle, isPattern}) != 1 {
 			return "", errorutils.CheckErrorf("exactly one creation source should be defined per file (aql, builds, release bundles or pattern (artifacts))")
 		}
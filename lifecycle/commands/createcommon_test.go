@@ -148,3 +148,17 @@ func TestValidateFileForPackageAndMultipleSourceSupportedVer(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateFile_PackageOnUnsupportedVersion(t *testing.T) {
+	files := []spec.File{
+		{Build: "name/number"},
+		{Package: "abc", Version: "1.0.0", Type: "npm", RepoKey: "npm-local"},
+	}
+
+	_, err := validateAndIdentifyRbCreationSpec(files, false)
+	assert.EqualError(t, err, "creation source 'package' requires Artifactory version "+minArtifactoryVersionForMultiSourceAndPackagesSupport+" or above")
+
+	sourceTypes, err := validateAndIdentifyRbCreationSpec(files, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []services.SourceType{services.Builds, services.Packages}, sourceTypes)
+}
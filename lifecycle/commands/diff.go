@@ -0,0 +1,253 @@
+package commands
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ReleaseBundleEntry is an artifact of a release bundle version.
+type ReleaseBundleEntry struct {
+	Path        string `json:"path"`
+	Checksum    string `json:"checksum"`
+	PackageType string `json:"packageType,omitempty"`
+}
+
+// ReleaseBundleChangedEntry is an artifact which has the same path in both release bundle versions, but different checksums.
+type ReleaseBundleChangedEntry struct {
+	Path         string `json:"path"`
+	PackageType  string `json:"packageType,omitempty"`
+	FromChecksum string `json:"fromChecksum"`
+	ToChecksum   string `json:"toChecksum"`
+}
+
+// ReleaseBundleDiff holds the differences between the contents of two release bundle versions.
+type ReleaseBundleDiff struct {
+	Added   []ReleaseBundleEntry        `json:"added"`
+	Removed []ReleaseBundleEntry        `json:"removed"`
+	Changed []ReleaseBundleChangedEntry `json:"changed"`
+}
+
+// The signed manifest of a release bundle version is a DSSE envelope, whose payload lists the artifacts of the version.
+type releaseBundleManifestEnvelope struct {
+	Payload string `json:"payload"`
+}
+
+type releaseBundleManifest struct {
+	Artifacts []struct {
+		Path        string `json:"path"`
+		Checksum    string `json:"checksum"`
+		PackageType string `json:"package_type"`
+	} `json:"artifacts"`
+}
+
+// ReleaseBundleDiffCommand compares the contents of two release bundle versions, of the same release bundle or of
+// different ones, by the paths and checksums of their artifacts.
+type ReleaseBundleDiffCommand struct {
+	releaseBundleCmd
+	otherReleaseBundleName    string
+	otherReleaseBundleVersion string
+	format                    string
+}
+
+func NewReleaseBundleDiffCommand() *ReleaseBundleDiffCommand {
+	return &ReleaseBundleDiffCommand{}
+}
+
+func (rbd *ReleaseBundleDiffCommand) SetServerDetails(serverDetails *config.ServerDetails) *ReleaseBundleDiffCommand {
+	rbd.serverDetails = serverDetails
+	return rbd
+}
+
+func (rbd *ReleaseBundleDiffCommand) SetReleaseBundleName(releaseBundleName string) *ReleaseBundleDiffCommand {
+	rbd.releaseBundleName = releaseBundleName
+	return rbd
+}
+
+func (rbd *ReleaseBundleDiffCommand) SetReleaseBundleVersion(releaseBundleVersion string) *ReleaseBundleDiffCommand {
+	rbd.releaseBundleVersion = releaseBundleVersion
+	return rbd
+}
+
+func (rbd *ReleaseBundleDiffCommand) SetReleaseBundleProject(rbProjectKey string) *ReleaseBundleDiffCommand {
+	rbd.rbProjectKey = rbProjectKey
+	return rbd
+}
+
+// SetOtherReleaseBundleName sets the name of the release bundle to compare with. When empty, the versions of the same
+// release bundle are compared.
+func (rbd *ReleaseBundleDiffCommand) SetOtherReleaseBundleName(otherReleaseBundleName string) *ReleaseBundleDiffCommand {
+	rbd.otherReleaseBundleName = otherReleaseBundleName
+	return rbd
+}
+
+func (rbd *ReleaseBundleDiffCommand) SetOtherReleaseBundleVersion(otherReleaseBundleVersion string) *ReleaseBundleDiffCommand {
+	rbd.otherReleaseBundleVersion = otherReleaseBundleVersion
+	return rbd
+}
+
+func (rbd *ReleaseBundleDiffCommand) SetFormat(format string) *ReleaseBundleDiffCommand {
+	rbd.format = format
+	return rbd
+}
+
+func (rbd *ReleaseBundleDiffCommand) CommandName() string {
+	return "rb_diff"
+}
+
+func (rbd *ReleaseBundleDiffCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rbd.serverDetails, nil
+}
+
+func (rbd *ReleaseBundleDiffCommand) Run() error {
+	if rbd.format != "" && rbd.format != "table" && rbd.format != "json" {
+		return errorutils.CheckErrorf("unsupported format '%s'. Acceptable values are: table and json", rbd.format)
+	}
+	otherReleaseBundleName := rbd.otherReleaseBundleName
+	if otherReleaseBundleName == "" {
+		otherReleaseBundleName = rbd.releaseBundleName
+	}
+	fromEntries, err := rbd.getReleaseBundleEntries(rbd.releaseBundleName, rbd.releaseBundleVersion)
+	if err != nil {
+		return err
+	}
+	toEntries, err := rbd.getReleaseBundleEntries(otherReleaseBundleName, rbd.otherReleaseBundleVersion)
+	if err != nil {
+		return err
+	}
+	diff := DiffReleaseBundleContents(fromEntries, toEntries)
+	if rbd.format == "json" {
+		content, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+		return nil
+	}
+	log.Info(fmt.Sprintf("Comparing %s/%s to %s/%s: %d added, %d removed, %d changed.", rbd.releaseBundleName, rbd.releaseBundleVersion,
+		otherReleaseBundleName, rbd.otherReleaseBundleVersion, len(diff.Added), len(diff.Removed), len(diff.Changed)))
+	if len(diff.Added)+len(diff.Removed)+len(diff.Changed) == 0 {
+		log.Info("The release bundle versions have the same contents.")
+		return nil
+	}
+	return printReleaseBundleDiff(diff)
+}
+
+// getReleaseBundleEntries returns the artifacts listed in the manifest of a release bundle version, after verifying the
+// version exists.
+func (rbd *ReleaseBundleDiffCommand) getReleaseBundleEntries(name, version string) ([]ReleaseBundleEntry, error) {
+	rbc := releaseBundleCmd{
+		serverDetails:        rbd.serverDetails,
+		releaseBundleName:    name,
+		releaseBundleVersion: version,
+		rbProjectKey:         rbd.rbProjectKey,
+	}
+	lcServicesManager, rbDetails, queryParams, err := rbc.initPrerequisites()
+	if err != nil {
+		return nil, err
+	}
+	exists, err := lcServicesManager.IsReleaseBundleExist(rbDetails.ReleaseBundleName, rbDetails.ReleaseBundleVersion, queryParams.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errorutils.CheckErrorf("release bundle %s/%s doesn't exist", name, version)
+	}
+	artifactoryServiceManager, err := createArtifactoryServiceManager(rbd.serverDetails)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := artifactoryServiceManager.ReadRemoteFile(buildManifestPath(rbd.rbProjectKey, name, version))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return parseReleaseBundleManifest(content)
+}
+
+func parseReleaseBundleManifest(content []byte) ([]ReleaseBundleEntry, error) {
+	var envelope releaseBundleManifestEnvelope
+	if err := json.Unmarshal(content, &envelope); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the release bundle manifest: %s", err.Error())
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to decode the release bundle manifest payload: %s", err.Error())
+	}
+	var manifest releaseBundleManifest
+	if err = json.Unmarshal(payload, &manifest); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the release bundle manifest payload: %s", err.Error())
+	}
+	entries := make([]ReleaseBundleEntry, 0, len(manifest.Artifacts))
+	for _, artifact := range manifest.Artifacts {
+		entries = append(entries, ReleaseBundleEntry{Path: artifact.Path, Checksum: artifact.Checksum, PackageType: artifact.PackageType})
+	}
+	return entries, nil
+}
+
+// DiffReleaseBundleContents returns the artifacts added, removed and changed from the first release bundle version to
+// the second one, sorted by path.
+func DiffReleaseBundleContents(from, to []ReleaseBundleEntry) ReleaseBundleDiff {
+	diff := ReleaseBundleDiff{Added: []ReleaseBundleEntry{}, Removed: []ReleaseBundleEntry{}, Changed: []ReleaseBundleChangedEntry{}}
+	fromByPath := make(map[string]ReleaseBundleEntry, len(from))
+	for _, entry := range from {
+		fromByPath[entry.Path] = entry
+	}
+	toByPath := make(map[string]ReleaseBundleEntry, len(to))
+	for _, entry := range to {
+		toByPath[entry.Path] = entry
+		fromEntry, ok := fromByPath[entry.Path]
+		switch {
+		case !ok:
+			diff.Added = append(diff.Added, entry)
+		case fromEntry.Checksum != entry.Checksum:
+			diff.Changed = append(diff.Changed, ReleaseBundleChangedEntry{Path: entry.Path, PackageType: entry.PackageType, FromChecksum: fromEntry.Checksum, ToChecksum: entry.Checksum})
+		}
+	}
+	for _, entry := range from {
+		if _, ok := toByPath[entry.Path]; !ok {
+			diff.Removed = append(diff.Removed, entry)
+		}
+	}
+	sort.Slice(diff.Added, func(i, j int) bool { return diff.Added[i].Path < diff.Added[j].Path })
+	sort.Slice(diff.Removed, func(i, j int) bool { return diff.Removed[i].Path < diff.Removed[j].Path })
+	sort.Slice(diff.Changed, func(i, j int) bool { return diff.Changed[i].Path < diff.Changed[j].Path })
+	return diff
+}
+
+func printReleaseBundleDiff(diff ReleaseBundleDiff) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(writer, "CHANGE\tPATH\tPACKAGE TYPE\tCHECKSUM"); err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, entry := range diff.Added {
+		if _, err := fmt.Fprintf(writer, "added\t%s\t%s\t%s\n", entry.Path, entry.PackageType, entry.Checksum); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	for _, entry := range diff.Removed {
+		if _, err := fmt.Fprintf(writer, "removed\t%s\t%s\t%s\n", entry.Path, entry.PackageType, entry.Checksum); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	for _, entry := range diff.Changed {
+		if _, err := fmt.Fprintf(writer, "changed\t%s\t%s\t%s -> %s\n", entry.Path, entry.PackageType, entry.FromChecksum, entry.ToChecksum); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return errorutils.CheckError(writer.Flush())
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffReleaseBundleContents(t *testing.T) {
+	from := []ReleaseBundleEntry{
+		{Path: "maven-local/org/app/1.0/app-1.0.jar", Checksum: "sha-app", PackageType: "maven"},
+		{Path: "generic-local/notes.txt", Checksum: "sha-notes-1"},
+		{Path: "npm-local/lib/-/lib-1.0.0.tgz", Checksum: "sha-lib", PackageType: "npm"},
+	}
+	to := []ReleaseBundleEntry{
+		{Path: "npm-local/lib/-/lib-1.0.0.tgz", Checksum: "sha-lib", PackageType: "npm"},
+		{Path: "generic-local/notes.txt", Checksum: "sha-notes-2"},
+		{Path: "docker-local/app/2.0/manifest.json", Checksum: "sha-image", PackageType: "docker"},
+	}
+	diff := DiffReleaseBundleContents(from, to)
+	assert.Equal(t, []ReleaseBundleEntry{{Path: "docker-local/app/2.0/manifest.json", Checksum: "sha-image", PackageType: "docker"}}, diff.Added)
+	assert.Equal(t, []ReleaseBundleEntry{{Path: "maven-local/org/app/1.0/app-1.0.jar", Checksum: "sha-app", PackageType: "maven"}}, diff.Removed)
+	assert.Equal(t, []ReleaseBundleChangedEntry{{Path: "generic-local/notes.txt", FromChecksum: "sha-notes-1", ToChecksum: "sha-notes-2"}}, diff.Changed)
+
+	diff = DiffReleaseBundleContents(from, from)
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Changed)
+}
+
+func TestParseReleaseBundleManifest(t *testing.T) {
+	payload := base64.StdEncoding.EncodeToString([]byte(`{"artifacts": [{"path": "npm-local/lib/-/lib-1.0.0.tgz", "checksum": "sha-lib", "package_type": "npm"}]}`))
+	entries, err := parseReleaseBundleManifest([]byte(`{"payload": "` + payload + `", "payloadType": "application/json"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []ReleaseBundleEntry{{Path: "npm-local/lib/-/lib-1.0.0.tgz", Checksum: "sha-lib", PackageType: "npm"}}, entries)
+
+	_, err = parseReleaseBundleManifest([]byte("not json"))
+	assert.ErrorContains(t, err, "failed to parse the release bundle manifest")
+}
+
+func TestReleaseBundleDiffCommand_InvalidFormat(t *testing.T) {
+	err := NewReleaseBundleDiffCommand().SetReleaseBundleName("rb-name").SetReleaseBundleVersion("1.0.0").SetOtherReleaseBundleVersion("2.0.0").SetFormat("text").Run()
+	assert.EqualError(t, err, "unsupported format 'text'. Acceptable values are: table and json")
+}
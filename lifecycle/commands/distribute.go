@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"fmt"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/lifecycle/services"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type ReleaseBundleDistributeCommand struct {
@@ -14,6 +16,7 @@ type ReleaseBundleDistributeCommand struct {
 	pathMappingPattern string
 	pathMappingTarget  string
 	maxWaitMinutes     int
+	edgeStatus         bool
 }
 
 func NewReleaseBundleDistributeCommand() *ReleaseBundleDistributeCommand {
@@ -75,6 +78,12 @@ func (rbd *ReleaseBundleDistributeCommand) SetMaxWaitMinutes(maxWaitMinutes int)
 	return rbd
 }
 
+// SetEdgeStatus sets whether to wait for the distribution and report its status on each edge, up to the max wait minutes.
+func (rbd *ReleaseBundleDistributeCommand) SetEdgeStatus(edgeStatus bool) *ReleaseBundleDistributeCommand {
+	rbd.edgeStatus = edgeStatus
+	return rbd
+}
+
 func (rbd *ReleaseBundleDistributeCommand) Run() error {
 	if err := validateArtifactoryVersionSupported(rbd.serverDetails); err != nil {
 		return err
@@ -90,8 +99,10 @@ func (rbd *ReleaseBundleDistributeCommand) Run() error {
 		Target:  rbd.pathMappingTarget,
 	}
 
+	reportEdgeStatus := rbd.edgeStatus && !rbd.dryRun
 	distributeParams := services.DistributeReleaseBundleParams{
-		Sync:              rbd.sync,
+		// The status of the edges is polled after triggering the distribution, instead of the overall status.
+		Sync:              rbd.sync && !reportEdgeStatus,
 		AutoCreateRepo:    rbd.autoCreateRepo,
 		MaxWaitMinutes:    rbd.maxWaitMinutes,
 		DistributionRules: getAggregatedDistRules(rbd.distributionRules),
@@ -99,7 +110,24 @@ func (rbd *ReleaseBundleDistributeCommand) Run() error {
 		ProjectKey:        rbd.rbProjectKey,
 	}
 
-	return servicesManager.DistributeReleaseBundle(rbDetails, distributeParams)
+	if !reportEdgeStatus {
+		return servicesManager.DistributeReleaseBundle(rbDetails, distributeParams)
+	}
+	// The tracker is created before triggering the distribution, so an invalid max wait fails the command without distributing.
+	statusTracker, err := newDistributionStatusTracker(servicesManager, rbd.serverDetails, rbDetails, rbd.rbProjectKey, rbd.maxWaitMinutes)
+	if err != nil {
+		return err
+	}
+	trackerId, err := statusTracker.distribute(distributeParams)
+	if err != nil {
+		return err
+	}
+	edgeStatuses, err := statusTracker.waitForEdges(trackerId)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Distribution completed on all %d edges.", len(edgeStatuses)))
+	return nil
 }
 
 func (rbd *ReleaseBundleDistributeCommand) ServerDetails() (*config.ServerDetails, error) {
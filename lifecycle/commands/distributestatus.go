@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/lifecycle"
+	"github.com/jfrog/jfrog-client-go/lifecycle/services"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+const distributionTrackersApi = "api/v2/distribution/trackers"
+
+// The interval between polls of the distribution status of the edges.
+var edgeStatusPollingInterval = services.DefaultSyncSleepInterval
+
+// edgeDistributionStatus is the distribution status of a release bundle version on a single edge.
+type edgeDistributionStatus struct {
+	name             string
+	status           string
+	distributedFiles string
+	totalFiles       string
+	errorMessage     string
+}
+
+// distributionStatusTracker polls the tracker of a distribution through the lifecycle services manager, and reports
+// the status of each edge as it changes.
+type distributionStatusTracker struct {
+	servicesManager *lifecycle.LifecycleServicesManager
+	lcDetails       auth.ServiceDetails
+	rbDetails       services.ReleaseBundleDetails
+	projectKey      string
+	maxWait         time.Duration
+	// The last reported status of each edge, so only changes are reported.
+	reportedStatuses map[string]string
+}
+
+func newDistributionStatusTracker(servicesManager *lifecycle.LifecycleServicesManager, serverDetails *config.ServerDetails,
+	rbDetails services.ReleaseBundleDetails, projectKey string, maxWaitMinutes int) (*distributionStatusTracker, error) {
+	lcDetails, err := serverDetails.CreateLifecycleAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	if maxWaitMinutes < 1 {
+		return nil, errorutils.CheckErrorf("the max wait minutes must be at least 1 to report the status of the edges, but %d was received", maxWaitMinutes)
+	}
+	return &distributionStatusTracker{
+		servicesManager:  servicesManager,
+		lcDetails:        lcDetails,
+		rbDetails:        rbDetails,
+		projectKey:       projectKey,
+		maxWait:          time.Duration(maxWaitMinutes) * time.Minute,
+		reportedStatuses: make(map[string]string),
+	}, nil
+}
+
+// distribute triggers the distribution of the release bundle version like the services manager does, and returns the
+// tracker of the distribution, which the services manager doesn't expose.
+func (dst *distributionStatusTracker) distribute(distributeParams services.DistributeReleaseBundleParams) (json.Number, error) {
+	distributeService := services.NewDistributeReleaseBundleService(dst.servicesManager.Client())
+	distributeService.LcDetails = dst.lcDetails
+	distributeService.DistributeParams = distribution.DistributionParams{
+		Name:              dst.rbDetails.ReleaseBundleName,
+		Version:           dst.rbDetails.ReleaseBundleVersion,
+		DistributionRules: distributeParams.DistributionRules,
+	}
+	distributeService.AutoCreateRepo = distributeParams.AutoCreateRepo
+	distributeService.ProjectKey = dst.projectKey
+	distributeService.Modifications.PathMappings = []utils.PathMapping{}
+	for _, pathMapping := range distributeParams.PathMappings {
+		distributeService.Modifications.PathMappings = append(distributeService.Modifications.PathMappings,
+			distribution.CreatePathMappingsFromPatternAndTarget(pathMapping.Pattern, pathMapping.Target)...)
+	}
+	trackerId, err := distribution.DoDistribute(distributeService)
+	if err != nil {
+		return "", err
+	}
+	if trackerId == "" {
+		return "", errorutils.CheckErrorf("the distribution of release bundle '%s/%s' was triggered, but its tracker ID wasn't received", dst.rbDetails.ReleaseBundleName, dst.rbDetails.ReleaseBundleVersion)
+	}
+	return trackerId, nil
+}
+
+// waitForEdges waits for the distribution of the tracker to complete or fail on all the edges, and returns the final
+// status of each edge. The error lists the edges the distribution failed on.
+func (dst *distributionStatusTracker) waitForEdges(trackerId json.Number) ([]edgeDistributionStatus, error) {
+	var statusResponse *distribution.DistributionStatusResponse
+	pollingExecutor := &httputils.PollingExecutor{
+		Timeout:         dst.maxWait,
+		PollingInterval: edgeStatusPollingInterval,
+		MsgPrefix:       fmt.Sprintf("Distributing %s/%s...", dst.rbDetails.ReleaseBundleName, dst.rbDetails.ReleaseBundleVersion),
+		PollingAction: func() (shouldStop bool, responseBody []byte, err error) {
+			if statusResponse, err = dst.getDistributionStatus(trackerId); err != nil {
+				return true, nil, err
+			}
+			dst.reportChanges(statusResponse.Sites)
+			switch statusResponse.Status {
+			case distribution.Completed, distribution.Failed:
+				return true, nil, nil
+			default:
+				return false, nil, nil
+			}
+		},
+	}
+	if _, err := pollingExecutor.Execute(); err != nil {
+		if statusResponse != nil {
+			return toEdgeStatuses(statusResponse.Sites), errorutils.CheckErrorf("the distribution didn't complete within %s, its status is '%s': %s", dst.maxWait, statusResponse.Status, err.Error())
+		}
+		return nil, err
+	}
+	edgeStatuses := toEdgeStatuses(statusResponse.Sites)
+	var failedEdges []string
+	for _, edgeStatus := range edgeStatuses {
+		if edgeStatus.status != string(distribution.Completed) {
+			failedEdges = append(failedEdges, formatEdgeStatus(edgeStatus))
+		}
+	}
+	if statusResponse.Status != distribution.Completed || len(failedEdges) > 0 {
+		return edgeStatuses, errorutils.CheckErrorf("the distribution failed on %d of %d edges:\n%s", len(failedEdges), len(edgeStatuses), strings.Join(failedEdges, "\n"))
+	}
+	return edgeStatuses, nil
+}
+
+func (dst *distributionStatusTracker) getDistributionStatus(trackerId json.Number) (*distribution.DistributionStatusResponse, error) {
+	body, err := dst.sendGet(path.Join(distributionTrackersApi, dst.rbDetails.ReleaseBundleName, dst.rbDetails.ReleaseBundleVersion, trackerId.String()))
+	if err != nil {
+		return nil, err
+	}
+	statusResponse := &distribution.DistributionStatusResponse{}
+	if err = json.Unmarshal(body, statusResponse); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the distribution status: %s", err.Error())
+	}
+	return statusResponse, nil
+}
+
+func (dst *distributionStatusTracker) sendGet(restApi string) ([]byte, error) {
+	requestFullUrl, err := clientUtils.BuildUrl(dst.lcDetails.GetUrl(), restApi, distribution.GetProjectQueryParam(dst.projectKey))
+	if err != nil {
+		return nil, err
+	}
+	httpClientDetails := dst.lcDetails.CreateHttpClientDetails()
+	resp, body, _, err := dst.servicesManager.Client().SendGet(requestFullUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// reportChanges logs the edges whose status or progress changed since the last poll.
+func (dst *distributionStatusTracker) reportChanges(sites []distribution.DistributionSiteStatus) {
+	for _, edgeStatus := range toEdgeStatuses(sites) {
+		line := formatEdgeStatus(edgeStatus)
+		if dst.reportedStatuses[edgeStatus.name] == line {
+			continue
+		}
+		dst.reportedStatuses[edgeStatus.name] = line
+		log.Info(line)
+	}
+}
+
+func toEdgeStatuses(sites []distribution.DistributionSiteStatus) (edgeStatuses []edgeDistributionStatus) {
+	for _, site := range sites {
+		edgeStatus := edgeDistributionStatus{
+			name:             site.TargetArtifactory.Name,
+			status:           string(site.Status),
+			distributedFiles: site.DistributedFiles.String(),
+			totalFiles:       site.TotalFiles.String(),
+			errorMessage:     site.Error,
+		}
+		if edgeStatus.errorMessage == "" && len(site.FileErrors) > 0 {
+			edgeStatus.errorMessage = strings.Join(site.FileErrors, "; ")
+		}
+		edgeStatuses = append(edgeStatuses, edgeStatus)
+	}
+	return
+}
+
+func formatEdgeStatus(edgeStatus edgeDistributionStatus) string {
+	line := fmt.Sprintf("Edge '%s': %s", edgeStatus.name, edgeStatus.status)
+	if edgeStatus.totalFiles != "" {
+		line += fmt.Sprintf(" (%s/%s files)", valueOrZero(edgeStatus.distributedFiles), edgeStatus.totalFiles)
+	}
+	if edgeStatus.errorMessage != "" {
+		line += ": " + edgeStatus.errorMessage
+	}
+	return line
+}
+
+func valueOrZero(value string) string {
+	if value == "" {
+		return "0"
+	}
+	return value
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"encoding/json"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/lifecycle/services"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestWaitForEdges(t *testing.T) {
+	testRuns := []struct {
+		name             string
+		statusResponses  []string
+		expectedStatuses []edgeDistributionStatus
+		expectedError    string
+	}{
+		{
+			name: "completed",
+			statusResponses: []string{
+				`{"status": "In progress", "sites": [{"status": "In progress", "target_artifactory": {"name": "edge-1"}, "total_files": 2, "distributed_files": 1}]}`,
+				`{"status": "Completed", "sites": [{"status": "Completed", "target_artifactory": {"name": "edge-1"}, "total_files": 2, "distributed_files": 2}]}`,
+			},
+			expectedStatuses: []edgeDistributionStatus{{name: "edge-1", status: "Completed", distributedFiles: "2", totalFiles: "2"}},
+		},
+		{
+			name: "failed on an edge",
+			statusResponses: []string{
+				`{"status": "Failed", "sites": [
+					{"status": "Completed", "target_artifactory": {"name": "edge-1"}},
+					{"status": "Failed", "target_artifactory": {"name": "edge-2"}, "general_error": "edge unreachable"}
+				]}`,
+			},
+			expectedStatuses: []edgeDistributionStatus{
+				{name: "edge-1", status: "Completed"},
+				{name: "edge-2", status: "Failed", errorMessage: "edge unreachable"},
+			},
+			expectedError: "the distribution failed on 1 of 2 edges:\nEdge 'edge-2': Failed: edge unreachable",
+		},
+	}
+
+	previousInterval := edgeStatusPollingInterval
+	edgeStatusPollingInterval = time.Millisecond
+	defer func() { edgeStatusPollingInterval = previousInterval }()
+
+	for _, test := range testRuns {
+		t.Run(test.name, func(t *testing.T) {
+			var statusRequests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "myProject", r.URL.Query().Get("project"))
+				switch r.URL.Path {
+				case "/" + distributionTrackersApi + "/bundle/1.0.0/8":
+					_, _ = w.Write([]byte(test.statusResponses[min(statusRequests, len(test.statusResponses)-1)]))
+					statusRequests++
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			serverDetails := &config.ServerDetails{LifecycleUrl: server.URL + "/"}
+			servicesManager, err := utils.CreateLifecycleServiceManager(serverDetails, false)
+			require.NoError(t, err)
+			rbDetails := services.ReleaseBundleDetails{ReleaseBundleName: "bundle", ReleaseBundleVersion: "1.0.0"}
+			statusTracker, err := newDistributionStatusTracker(servicesManager, serverDetails, rbDetails, "myProject", 1)
+			require.NoError(t, err)
+
+			edgeStatuses, err := statusTracker.waitForEdges("8")
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedStatuses, edgeStatuses)
+			assert.Equal(t, len(test.statusResponses), statusRequests)
+		})
+	}
+}
+
+func TestDistribute(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v2/distribution/distribute/bundle/1.0.0", r.URL.Path)
+		assert.Equal(t, "myProject", r.URL.Query().Get("project"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var distributeBody services.ReleaseBundleDistributeBody
+		require.NoError(t, json.Unmarshal(body, &distributeBody))
+		assert.Equal(t, []distribution.DistributionRulesBody{{SiteName: "edge-*"}}, distributeBody.DistributionRules)
+		assert.True(t, distributeBody.AutoCreateRepo)
+		_, _ = w.Write([]byte(`{"id": 8}`))
+	}))
+	defer server.Close()
+
+	serverDetails := &config.ServerDetails{LifecycleUrl: server.URL + "/"}
+	servicesManager, err := utils.CreateLifecycleServiceManager(serverDetails, false)
+	require.NoError(t, err)
+	rbDetails := services.ReleaseBundleDetails{ReleaseBundleName: "bundle", ReleaseBundleVersion: "1.0.0"}
+	statusTracker, err := newDistributionStatusTracker(servicesManager, serverDetails, rbDetails, "myProject", 1)
+	require.NoError(t, err)
+
+	trackerId, err := statusTracker.distribute(services.DistributeReleaseBundleParams{
+		AutoCreateRepo:    true,
+		DistributionRules: []*distribution.DistributionCommonParams{{SiteName: "edge-*"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("8"), trackerId)
+}
+
+func TestNewDistributionStatusTrackerInvalidMaxWait(t *testing.T) {
+	serverDetails := &config.ServerDetails{LifecycleUrl: "http://localhost/"}
+	rbDetails := services.ReleaseBundleDetails{ReleaseBundleName: "bundle", ReleaseBundleVersion: "1.0.0"}
+	_, err := newDistributionStatusTracker(nil, serverDetails, rbDetails, "", 0)
+	assert.EqualError(t, err, "the max wait minutes must be at least 1 to report the status of the edges, but 0 was received")
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// ReleaseBundlePaths holds the location of a release bundle version in Artifactory.
+type ReleaseBundlePaths struct {
+	RepoKey      string `json:"repoKey"`
+	ManifestPath string `json:"manifestPath"`
+}
+
+// ReleaseBundlePathsCommand prints the repository and manifest path of a release bundle version. The paths are computed
+// locally, so the command doesn't access the server.
+type ReleaseBundlePathsCommand struct {
+	releaseBundleCmd
+	format string
+}
+
+func NewReleaseBundlePathsCommand() *ReleaseBundlePathsCommand {
+	return &ReleaseBundlePathsCommand{}
+}
+
+func (rbp *ReleaseBundlePathsCommand) SetReleaseBundleName(releaseBundleName string) *ReleaseBundlePathsCommand {
+	rbp.releaseBundleName = releaseBundleName
+	return rbp
+}
+
+func (rbp *ReleaseBundlePathsCommand) SetReleaseBundleVersion(releaseBundleVersion string) *ReleaseBundlePathsCommand {
+	rbp.releaseBundleVersion = releaseBundleVersion
+	return rbp
+}
+
+func (rbp *ReleaseBundlePathsCommand) SetReleaseBundleProject(rbProjectKey string) *ReleaseBundlePathsCommand {
+	rbp.rbProjectKey = rbProjectKey
+	return rbp
+}
+
+func (rbp *ReleaseBundlePathsCommand) SetFormat(format string) *ReleaseBundlePathsCommand {
+	rbp.format = format
+	return rbp
+}
+
+func (rbp *ReleaseBundlePathsCommand) CommandName() string {
+	return "rb_paths"
+}
+
+func (rbp *ReleaseBundlePathsCommand) ServerDetails() (*config.ServerDetails, error) {
+	return nil, nil
+}
+
+func (rbp *ReleaseBundlePathsCommand) Run() error {
+	paths := GetReleaseBundlePaths(rbp.rbProjectKey, rbp.releaseBundleName, rbp.releaseBundleVersion)
+	switch rbp.format {
+	case "", "text":
+		fmt.Printf("Repository:    %s\n", paths.RepoKey)
+		fmt.Printf("Manifest path: %s\n", paths.ManifestPath)
+	case "json":
+		content, err := json.MarshalIndent(paths, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		fmt.Println(string(content))
+	default:
+		return errorutils.CheckErrorf("unsupported format '%s'. Acceptable values are: text and json", rbp.format)
+	}
+	return nil
+}
+
+// GetReleaseBundlePaths returns the release-bundles-v2 repository key and the manifest path of a release bundle version.
+// An empty project key or the 'default' project refer to the repository of the default project.
+func GetReleaseBundlePaths(projectKey, name, version string) ReleaseBundlePaths {
+	return ReleaseBundlePaths{
+		RepoKey:      buildRepoKey(projectKey),
+		ManifestPath: buildManifestPath(projectKey, name, version),
+	}
+}
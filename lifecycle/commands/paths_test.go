@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReleaseBundlePaths(t *testing.T) {
+	testCases := []struct {
+		testName   string
+		projectKey string
+		expected   ReleaseBundlePaths
+	}{
+		{"no project", "", ReleaseBundlePaths{RepoKey: "release-bundles-v2", ManifestPath: "release-bundles-v2/rb-name/1.0.0/release-bundle.json.evd"}},
+		{"default project", "default", ReleaseBundlePaths{RepoKey: "release-bundles-v2", ManifestPath: "release-bundles-v2/rb-name/1.0.0/release-bundle.json.evd"}},
+		{"project", "proj", ReleaseBundlePaths{RepoKey: "proj-release-bundles-v2", ManifestPath: "proj-release-bundles-v2/rb-name/1.0.0/release-bundle.json.evd"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, GetReleaseBundlePaths(testCase.projectKey, "rb-name", "1.0.0"))
+		})
+	}
+}
+
+func TestReleaseBundlePathsCommand_InvalidFormat(t *testing.T) {
+	err := NewReleaseBundlePathsCommand().SetReleaseBundleName("rb-name").SetReleaseBundleVersion("1.0.0").SetFormat("table").Run()
+	assert.EqualError(t, err, "unsupported format 'table'. Acceptable values are: text and json")
+}
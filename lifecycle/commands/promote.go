@@ -2,12 +2,23 @@ package commands
 
 import (
 	"encoding/json"
+	"slices"
+	"strings"
+
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/lifecycle/services"
 	"github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
+const (
+	promotionTypeCopy = "copy"
+	promotionTypeMove = "move"
+)
+
+var validPromotionTypes = []string{promotionTypeCopy, promotionTypeMove}
+
 type ReleaseBundlePromoteCommand struct {
 	releaseBundleCmd
 	signingKeyName       string
@@ -15,6 +26,9 @@ type ReleaseBundlePromoteCommand struct {
 	includeReposPatterns []string
 	excludeReposPatterns []string
 	promotionType        string
+	// When set, the included and excluded repositories are read from this spec file, in which specVars are replaced.
+	specPath string
+	specVars string
 }
 
 func NewReleaseBundlePromoteCommand() *ReleaseBundlePromoteCommand {
@@ -66,11 +80,55 @@ func (rbp *ReleaseBundlePromoteCommand) SetExcludeReposPatterns(excludeReposPatt
 	return rbp
 }
 
+// SetSpec sets the promotion spec file from which the included and excluded repositories are read, and the variables
+// replaced in it.
+func (rbp *ReleaseBundlePromoteCommand) SetSpec(specPath, specVars string) *ReleaseBundlePromoteCommand {
+	rbp.specPath = specPath
+	rbp.specVars = specVars
+	return rbp
+}
+
 func (rbp *ReleaseBundlePromoteCommand) SetPromotionType(promotionType string) *ReleaseBundlePromoteCommand {
 	rbp.promotionType = promotionType
 	return rbp
 }
 
+// loadSpec sets the included and excluded repositories from the promotion spec, if one is set. The spec can't be
+// combined with repositories which are set directly.
+func (rbp *ReleaseBundlePromoteCommand) loadSpec() error {
+	if rbp.specPath == "" {
+		return nil
+	}
+	if len(rbp.includeReposPatterns) > 0 || len(rbp.excludeReposPatterns) > 0 {
+		return errorutils.CheckErrorf("the promotion spec can't be used together with included or excluded repositories")
+	}
+	promotionSpec, err := LoadPromotionSpec(rbp.specPath, rbp.specVars)
+	if err != nil {
+		return err
+	}
+	rbp.includeReposPatterns = promotionSpec.IncludedRepositoryKeys
+	rbp.excludeReposPatterns = promotionSpec.ExcludedRepositoryKeys
+	return nil
+}
+
+// reportRepoFilters reports which repositories the content is promoted from, when they are filtered.
+func (rbp *ReleaseBundlePromoteCommand) reportRepoFilters() {
+	if len(rbp.includeReposPatterns) > 0 {
+		log.Info("Promoting only the content of the repositories:", strings.Join(rbp.includeReposPatterns, ", "))
+	}
+	if len(rbp.excludeReposPatterns) > 0 {
+		log.Info("Excluding from the promotion the content of the repositories:", strings.Join(rbp.excludeReposPatterns, ", "))
+	}
+}
+
+// An empty promotion type is valid, and lets the server apply its default.
+func validatePromotionType(promotionType string) error {
+	if promotionType == "" || slices.Contains(validPromotionTypes, promotionType) {
+		return nil
+	}
+	return errorutils.CheckErrorf("invalid promotion type '%s'. Allowed values are: %s", promotionType, strings.Join(validPromotionTypes, ", "))
+}
+
 func (rbp *ReleaseBundlePromoteCommand) CommandName() string {
 	return "rb_promote"
 }
@@ -83,6 +141,15 @@ func (rbp *ReleaseBundlePromoteCommand) Run() error {
 	if err := validateArtifactoryVersionSupported(rbp.serverDetails); err != nil {
 		return err
 	}
+	if err := rbp.loadSpec(); err != nil {
+		return err
+	}
+	if len(rbp.includeReposPatterns) > 0 || len(rbp.excludeReposPatterns) > 0 {
+		if err := ValidateFeatureSupportedVersion(rbp.serverDetails, minArtifactoryVersionForPromotionRepoFilters); err != nil {
+			return err
+		}
+	}
+	rbp.reportRepoFilters()
 
 	servicesManager, rbDetails, queryParams, err := rbp.getPromotionPrerequisites()
 
@@ -0,0 +1,72 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Filtering the repositories of a promotion by included and excluded repositories is supported from this version.
+const minArtifactoryVersionForPromotionRepoFilters = "7.73.0"
+
+// PromotionSpec selects the repositories whose content is promoted. It is read from a JSON file, in which ${key}
+// variables are replaced by the values passed with --spec-vars, so one spec can serve several promotions. For example:
+//
+//	{"includedRepositoryKeys": ["${team}-docker-prod"], "excludedRepositoryKeys": ["${team}-generic-tmp"]}
+type PromotionSpec struct {
+	IncludedRepositoryKeys []string `json:"includedRepositoryKeys,omitempty"`
+	ExcludedRepositoryKeys []string `json:"excludedRepositoryKeys,omitempty"`
+}
+
+// LoadPromotionSpec reads the promotion spec file, after replacing the variables in it.
+func LoadPromotionSpec(specPath, vars string) (*PromotionSpec, error) {
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if vars != "" {
+		content = coreutils.ReplaceVars(content, coreutils.SpecVarsStringToMap(vars))
+	}
+	promotionSpec := new(PromotionSpec)
+	if err = json.Unmarshal(content, promotionSpec); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the promotion spec '%s': %s", specPath, err.Error())
+	}
+	if err = promotionSpec.validate(); err != nil {
+		return nil, errorutils.CheckErrorf("invalid promotion spec '%s': %s", specPath, err.Error())
+	}
+	return promotionSpec, nil
+}
+
+// validate fails on empty repository keys, on keys with variables which weren't provided, and on repositories which
+// are both included and excluded.
+func (ps *PromotionSpec) validate() error {
+	included := make(map[string]bool, len(ps.IncludedRepositoryKeys))
+	for _, repoKey := range ps.IncludedRepositoryKeys {
+		if err := validatePromotionRepoKey("includedRepositoryKeys", repoKey); err != nil {
+			return err
+		}
+		included[repoKey] = true
+	}
+	for _, repoKey := range ps.ExcludedRepositoryKeys {
+		if err := validatePromotionRepoKey("excludedRepositoryKeys", repoKey); err != nil {
+			return err
+		}
+		if included[repoKey] {
+			return errorutils.CheckErrorf("repository '%s' is both included and excluded", repoKey)
+		}
+	}
+	return nil
+}
+
+func validatePromotionRepoKey(field, repoKey string) error {
+	if strings.TrimSpace(repoKey) == "" {
+		return errorutils.CheckErrorf("%s contains an empty repository key", field)
+	}
+	if strings.Contains(repoKey, "${") {
+		return errorutils.CheckErrorf("%s contains the repository key '%s', whose variables weren't provided with --spec-vars", field, repoKey)
+	}
+	return nil
+}
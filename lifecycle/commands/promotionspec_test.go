@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePromotionSpec(t *testing.T, content string) string {
+	specPath := filepath.Join(t.TempDir(), "promotion-spec.json")
+	require.NoError(t, os.WriteFile(specPath, []byte(content), 0600))
+	return specPath
+}
+
+func TestLoadPromotionSpec(t *testing.T) {
+	specPath := writePromotionSpec(t, `{"includedRepositoryKeys": ["${team}-docker-prod", "${team}-npm-prod"], "excludedRepositoryKeys": ["${team}-generic-tmp"]}`)
+	promotionSpec, err := LoadPromotionSpec(specPath, "team=web")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"web-docker-prod", "web-npm-prod"}, promotionSpec.IncludedRepositoryKeys)
+	assert.Equal(t, []string{"web-generic-tmp"}, promotionSpec.ExcludedRepositoryKeys)
+
+	_, err = LoadPromotionSpec(specPath, "")
+	assert.ErrorContains(t, err, "variables weren't provided with --spec-vars")
+}
+
+func TestLoadPromotionSpecInvalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectedError string
+	}{
+		{name: "Malformed", content: `{"includedRepositoryKeys": "docker-prod"}`, expectedError: "failed to parse the promotion spec"},
+		{name: "Empty key", content: `{"excludedRepositoryKeys": [" "]}`, expectedError: "excludedRepositoryKeys contains an empty repository key"},
+		{name: "Included and excluded", content: `{"includedRepositoryKeys": ["docker-prod"], "excludedRepositoryKeys": ["docker-prod"]}`, expectedError: "repository 'docker-prod' is both included and excluded"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadPromotionSpec(writePromotionSpec(t, tt.content), "")
+			assert.ErrorContains(t, err, tt.expectedError)
+		})
+	}
+}
+
+func TestPromoteLoadSpec(t *testing.T) {
+	specPath := writePromotionSpec(t, `{"includedRepositoryKeys": ["docker-prod"]}`)
+	promoteCmd := NewReleaseBundlePromoteCommand().SetSpec(specPath, "")
+	require.NoError(t, promoteCmd.loadSpec())
+	assert.Equal(t, []string{"docker-prod"}, promoteCmd.includeReposPatterns)
+
+	promoteCmd = NewReleaseBundlePromoteCommand().SetSpec(specPath, "").SetExcludeReposPatterns([]string{"npm-prod"})
+	assert.ErrorContains(t, promoteCmd.loadSpec(), "can't be used together")
+}
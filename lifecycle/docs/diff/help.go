@@ -0,0 +1,17 @@
+package diff
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rbdiff [command options] <release bundle name> <release bundle version> <other release bundle version>"}
+
+func GetDescription() string {
+	return "Compare the contents of two release bundle versions, listing the added, removed and changed artifacts"
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "release bundle name", Description: "Name of the Release Bundle."},
+		{Name: "release bundle version", Description: "Version of the Release Bundle to compare from."},
+		{Name: "other release bundle version", Description: "Version of the Release Bundle to compare to. Use --other-name to compare to a version of another Release Bundle."},
+	}
+}
@@ -0,0 +1,16 @@
+package paths
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rbpaths [command options] <release bundle name> <release bundle version>"}
+
+func GetDescription() string {
+	return "Print the repository and manifest path of a release bundle version"
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "release bundle name", Description: "Name of the Release Bundle."},
+		{Name: "release bundle version", Description: "Version of the Release Bundle."},
+	}
+}